@@ -0,0 +1,39 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity"
+	gravitykeeper "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+)
+
+// NewAnteHandler returns the sdk's default AnteHandler with the gravity OrchestratorMsgDecorator added,
+// valid orchestrator messages are checked before the fee decorators so that their fees can be waived.
+// Unlike the sdk's version ValidateBasic runs before the mempool fee check, this is required as the
+// orchestrator checks rely on messages passing ValidateBasic.
+func NewAnteHandler(
+	ak ante.AccountKeeper, bankKeeper authtypes.BankKeeper, gravityKeeper gravitykeeper.Keeper,
+	sigGasConsumer ante.SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		ante.NewValidateBasicDecorator(),
+		gravity.NewOrchestratorMsgDecorator(gravityKeeper),
+		gravity.NewFeeWaiverDecorator(ante.NewMempoolFeeDecorator()),
+		ante.TxTimeoutHeightDecorator{},
+		ante.NewValidateMemoDecorator(ak),
+		ante.NewConsumeGasForTxSizeDecorator(ak),
+		ante.NewRejectFeeGranterDecorator(),
+		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(ak),
+		gravity.NewFeeWaiverDecorator(ante.NewDeductFeeDecorator(ak, bankKeeper)),
+		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		ante.NewSigVerificationDecorator(ak, signModeHandler),
+		ante.NewIncrementSequenceDecorator(ak),
+	)
+}
@@ -91,12 +91,17 @@ import (
 
 	gravityparams "github.com/althea-net/cosmos-gravity-bridge/module/app/params"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity"
+	gravityclient "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
 const appName = "app"
 
+// GravityStoreUpgradeName is the name of the upgrade that migrates the gravity store to
+// gravitytypes.ConsensusVersion
+const GravityStoreUpgradeName = "gravity-store-v14"
+
 var (
 	// DefaultNodeHome sets the folder where the applcation data and configuration will be stored
 	DefaultNodeHome string
@@ -117,6 +122,11 @@ var (
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			gravityclient.ERC20WhitelistProposalHandler,
+			gravityclient.ERC20RemapProposalHandler,
+			gravityclient.TokenPolicyProposalHandler,
+			gravityclient.ERC20MetadataProposalHandler,
+			gravityclient.TokenScalingProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -132,19 +142,22 @@ var (
 	// module account permissions
 	// NOTE: We believe that this is giving various modules access to functions of the supply module? We will probably need to use this.
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:        nil,
+		distrtypes.ModuleName:             nil,
+		minttypes.ModuleName:              {authtypes.Minter},
+		stakingtypes.BondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:               {authtypes.Burner},
+		ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+		gravitytypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
+		gravitytypes.ValsetRewardPoolName: {authtypes.Burner},
+		gravitytypes.DustPoolName:         nil,
 	}
 
 	// module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{
-		distrtypes.ModuleName: true,
+		distrtypes.ModuleName:             true,
+		gravitytypes.ValsetRewardPoolName: true,
 	}
 
 	// verify app interface at compile time
@@ -328,6 +341,15 @@ func NewGravityApp(
 		homePath,
 	)
 
+	app.gravityKeeper = keeper.NewKeeper(
+		appCodec,
+		keys[gravitytypes.StoreKey],
+		app.GetSubspace(gravitytypes.ModuleName),
+		stakingKeeper,
+		app.bankKeeper,
+		app.slashingKeeper,
+	)
+
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
@@ -349,7 +371,8 @@ func NewGravityApp(
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(gravitytypes.RouterKey, gravity.NewGravityProposalHandler(app.gravityKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -380,15 +403,6 @@ func NewGravityApp(
 	)
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.gravityKeeper = keeper.NewKeeper(
-		appCodec,
-		keys[gravitytypes.StoreKey],
-		app.GetSubspace(gravitytypes.ModuleName),
-		stakingKeeper,
-		app.bankKeeper,
-		app.slashingKeeper,
-	)
-
 	var skipGenesisInvariants = cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
 
 	app.mm = module.NewManager(
@@ -458,6 +472,7 @@ func NewGravityApp(
 		gravity.NewAppModule(
 			app.gravityKeeper,
 			app.bankKeeper,
+			app.accountKeeper,
 		),
 	)
 
@@ -497,6 +512,12 @@ func NewGravityApp(
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
 
+	app.upgradeKeeper.SetUpgradeHandler(GravityStoreUpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		if err := keeper.NewMigrator(app.gravityKeeper).RunMigrations(ctx); err != nil {
+			panic(err)
+		}
+	})
+
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts),
 		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
@@ -510,6 +531,7 @@ func NewGravityApp(
 		evidence.NewAppModule(app.evidenceKeeper),
 		ibc.NewAppModule(app.ibcKeeper),
 		transferModule,
+		gravity.NewAppModule(app.gravityKeeper, app.bankKeeper, app.accountKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -521,9 +543,10 @@ func NewGravityApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(
-		ante.NewAnteHandler(
+		NewAnteHandler(
 			app.accountKeeper,
 			app.bankKeeper,
+			app.gravityKeeper,
 			ante.DefaultSigVerificationGasConsumer,
 			encodingConfig.TxConfig.SignModeHandler(),
 		),
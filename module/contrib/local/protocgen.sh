@@ -8,7 +8,8 @@ for dir in $proto_dirs; do
   -I "proto" \
   -I "third_party/proto" \
   --gocosmos_out=plugins=interfacetype+grpc,\
-Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:. \
+Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types,\
+Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types:. \
   $(find "${dir}" -maxdepth 1 -name '*.proto')
 
   # # command to generate gRPC gateway (*.pb.gw.go in respective modules) files
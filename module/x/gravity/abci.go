@@ -1,13 +1,15 @@
 package gravity
 
 import (
-	"sort"
-
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// maxAttestationsPrunedPerBlock is the number of event nonces pruneAttestations will delete
+// the attestations for in a single block
+const maxAttestationsPrunedPerBlock = 100
+
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
@@ -15,28 +17,53 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	attestationTally(ctx, k)
 	cleanupTimedOutBatches(ctx, k)
 	cleanupTimedOutLogicCalls(ctx, k)
-	createValsets(ctx, k)
+	createValsets(ctx, k, params)
 	pruneValsets(ctx, k, params)
-	pruneAttestations(ctx, k)
+	pruneAttestations(ctx, k, params)
 }
 
-func createValsets(ctx sdk.Context, k keeper.Keeper) {
+func createValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// Auto ValsetRequest Creation.
 	// WARNING: do not use k.GetLastObservedValset in this function, it *will* result in losing control of the bridge
-	// 1. If there are no valset requests, create a new one.
-	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
-	//      This will make sure the unbonding validator has to provide an attestation to a new Valset
-	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of CurrentValset and latest valset request is > 5%
-
 	// get the last valsets to compare against
 	latestValset := k.GetLatestValset(ctx)
-	lastUnbondingHeight := k.GetLastUnBondingBlockHeight(ctx)
+	if reason := valsetRequestReason(ctx, k, params, latestValset); reason != "" {
+		// put in a new validator set request to be signed and submitted to Ethereum
+		k.SetValsetRequestWithReason(ctx, reason)
+	}
+}
 
-	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || (types.BridgeValidators(k.GetCurrentValset(ctx).Members).PowerDiff(latestValset.Members) > 0.05) {
-		// if the conditions are true, put in a new validator set request to be signed and submitted to Ethereum
-		k.SetValsetRequest(ctx)
+// valsetRequestReason returns why a new valset should be requested, or an empty string if it shouldn't be
+// 1. If there are no valset requests, create a new one.
+// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
+//      This will make sure the unbonding validator has to provide an attestation to a new Valset
+//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
+// 3. If power change between validators of CurrentValset and latest valset request is > ValsetPowerDiffThreshold
+// 4. If the latest valset request is ValsetMaxAge blocks old
+// 5. If ValsetOnEthAddressChange is set and a validator registered an Ethereum address since the latest valset request
+// The last three wait for ValsetMinInterval blocks to pass since the latest valset request
+func valsetRequestReason(ctx sdk.Context, k keeper.Keeper, params types.Params, latestValset *types.Valset) string {
+	height := uint64(ctx.BlockHeight())
+	if latestValset == nil {
+		return types.ValsetReasonNoValset
+	}
+	if k.GetLastUnBondingBlockHeight(ctx) == height {
+		return types.ValsetReasonUnbonding
+	}
+	if height < latestValset.Height+params.ValsetMinInterval {
+		return ""
+	}
+
+	if types.BridgeValidators(k.GetCurrentValset(ctx).Members).PowerDiffExceeds(latestValset.Members, params.ValsetPowerDiffThreshold) {
+		return types.ValsetReasonPowerChange
+	}
+	if params.ValsetMaxAge != 0 && height >= latestValset.Height+params.ValsetMaxAge {
+		return types.ValsetReasonMaxAge
 	}
+	if params.ValsetOnEthAddressChange && k.GetLastEthAddressChangeHeight(ctx) > latestValset.Height {
+		return types.ValsetReasonEthAddressChange
+	}
+	return ""
 }
 
 func pruneValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
@@ -71,47 +98,31 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 
 }
 
-// Iterate over all attestations currently being voted on in order of nonce and
+// Iterate over the attestations currently being voted on in order of nonce and
 // "Observe" those who have passed the threshold. Break the loop once we see
 // an attestation that has not passed the threshold
 func attestationTally(ctx sdk.Context, k keeper.Keeper) {
-	attmap := k.GetAttestationMapping(ctx)
-	// We make a slice with all the event nonces that are in the attestation mapping
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Then we sort it
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// We check if the event nonce is exactly 1 higher than the last attestation that was
-			// observed. If it is not, we just move on to the next nonce. This will skip over all
-			// attestations that have already been observed.
-			//
-			// Once we hit an event nonce that is one higher than the last observed event, we stop
-			// skipping over this conditional and start calling tryAttestation (counting votes)
-			// Once an attestation at a given event nonce has enough votes and becomes observed,
-			// every other attestation at that nonce will be skipped, since the lastObservedEventNonce
-			// will be incremented.
-			//
-			// Then we go to the next event nonce in the attestation mapping, if there is one. This
-			// nonce will once again be one higher than the lastObservedEventNonce.
-			// If there is an attestation at this event nonce which has enough votes to be observed,
-			// we skip the other attestations and move on to the next nonce again.
-			// If no attestation becomes observed, when we get to the next nonce, every attestation in
-			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryAttestation(ctx, &att)
+	// Attestations are keyed by event nonce, so rather than reading every attestation in the store
+	// we only read the attestations at the nonce one higher than the last observed event. Earlier
+	// nonces have already been observed, and later nonces can't be observed until this one is.
+	for {
+		nonce := k.GetLastObservedEventNonce(ctx) + 1
+		// There can be multiple attestations at one event nonce when validators disagree about what
+		// event happened at that nonce. They are ordered by claim hash, this order is not important.
+		// Once an attestation at this nonce has enough votes and becomes observed the lastObservedEventNonce
+		// is incremented and every other attestation at this nonce is skipped
+		for _, att := range k.GetAttestationsByNonce(ctx, nonce) {
+			att := att
+			k.TryAttestation(ctx, &att)
+			if att.Observed {
+				break
 			}
 		}
+		// If no attestation at this nonce was observed there is no point looking at the next one,
+		// it will be looked at again in a future block once more votes are in.
+		if k.GetLastObservedEventNonce(ctx) < nonce {
+			return
+		}
 	}
 }
 
@@ -172,20 +183,28 @@ func ValsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			consAddr, _ := val.GetConsAddr()
 			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
 
+			// validators left out of a capped valset don't have to confirm it, ones without an
+			// Ethereum address are never in one and still have to
+			ethAddress, foundEthAddress := k.GetEthAddressByValidator(ctx, val.GetOperator())
+			member := !foundEthAddress || vs.HasMember(*ethAddress)
+
 			//  Slash validator ONLY if he joined before valset is created
-			if exist && uint64(valSigningInfo.StartHeight) < vs.Height {
+			if exist && uint64(valSigningInfo.StartHeight) < vs.Height && member {
 				// Check if validator has confirmed valset or not
 				found := false
 				for _, conf := range confirms {
 					// problem site for delegate key rotation, see issue #344
-					ethAddress, foundEthAddress := k.GetEthAddressByValidator(ctx, val.GetOperator())
-					if foundEthAddress && conf.EthAddress == ethAddress {
+					// confirms stored before addresses were checksummed may be in any casing
+					if confAddress, err := types.NewEthAddress(conf.EthAddress); err == nil && foundEthAddress && *confAddress == *ethAddress {
 						found = true
 						break
 					}
 				}
+				k.RecordValsetConfirm(ctx, val.GetOperator(), !found)
 				// slash validators for not confirming valsets
-				if !found {
+				if params.GraduatedBridgeSlashing {
+					k.HandleBridgeDowntime(ctx, val, params)
+				} else if !found {
 					cons, _ := val.GetConsAddr()
 					k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionValset)
 					if !val.IsJailed() {
@@ -215,8 +234,11 @@ func ValsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 				valConsAddr, _ := validator.GetConsAddr()
 				valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, valConsAddr)
 
+				ethAddress, foundEthAddress := k.GetEthAddressByValidator(ctx, validator.GetOperator())
+				member := !foundEthAddress || vs.HasMember(*ethAddress)
+
 				// Only slash validators who joined after valset is created and they are unbonding and UNBOND_SLASHING_WINDOW didn't passed
-				if exist && valSigningInfo.StartHeight < int64(vs.Height) && validator.IsUnbonding() && vs.Height < uint64(validator.UnbondingHeight)+params.UnbondSlashingValsetsWindow {
+				if exist && valSigningInfo.StartHeight < int64(vs.Height) && validator.IsUnbonding() && vs.Height < uint64(validator.UnbondingHeight)+params.UnbondSlashingValsetsWindow && member {
 					// Check if validator has confirmed valset or not
 					found := false
 					for _, conf := range confirms {
@@ -229,8 +251,11 @@ func ValsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 						}
 					}
 
+					k.RecordValsetConfirm(ctx, validator.GetOperator(), !found)
 					// slash validators for not confirming valsets
-					if !found {
+					if params.GraduatedBridgeSlashing {
+						k.HandleBridgeDowntime(ctx, validator, params)
+					} else if !found {
 						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(), params.SlashFractionValset)
 						if !validator.IsJailed() {
 							k.StakingKeeper.Jail(ctx, valConsAddr)
@@ -282,7 +307,10 @@ func BatchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 					break
 				}
 			}
-			if !found {
+			k.RecordBatchConfirm(ctx, val.GetOperator(), !found)
+			if params.GraduatedBridgeSlashing {
+				k.HandleBridgeDowntime(ctx, val, params)
+			} else if !found {
 				cons, _ := val.GetConsAddr()
 				k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionBatch)
 				if !val.IsJailed() {
@@ -333,7 +361,10 @@ func LogicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 					break
 				}
 			}
-			if !found {
+			k.RecordLogicCallConfirm(ctx, val.GetOperator(), !found)
+			if params.GraduatedBridgeSlashing {
+				k.HandleBridgeDowntime(ctx, val, params)
+			} else if !found {
 				cons, _ := val.GetConsAddr()
 				k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionLogicCall)
 				if !val.IsJailed() {
@@ -346,33 +377,44 @@ func LogicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
-// Iterate over all attestations currently being voted on in order of nonce
-// and prune those that are older than the current nonce and no longer have any
-// use. This could be combined with create attestation and save some computation
+// Iterate over all attestations older than the last observed nonce and prune those that have
+// been retained for AttestationRetentionBlocks. This could be combined with create attestation and save some computation
 // but (A) pruning keeps the iteration small in the first place and (B) there is
 // already enough nuance in the other handler that it's best not to complicate it further
-func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
-	attmap := k.GetAttestationMapping(ctx)
-	// We make a slice with all the event nonces that are in the attestation mapping
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Then we sort it
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// we delete all attestations earlier than the current event nonce
-			if nonce < uint64(k.GetLastObservedEventNonce(ctx)) {
-				k.DeleteAttestation(ctx, att)
+func pruneAttestations(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	lastObserved := k.GetLastObservedEventNonce(ctx)
+	retention := params.AttestationRetentionBlocks
+	height := uint64(ctx.BlockHeight())
+
+	// Attestations are keyed by event nonce and observed in nonce order, so the oldest attestations
+	// are always at the start of the store. We prune one event nonce at a time, so that the attestations
+	// validators disagreed on are kept as long as the one that was observed, and stop once we reach a
+	// nonce that is still retained. At most maxAttestationsPrunedPerBlock nonces are pruned each block
+	// so that a large backlog, for example after the retention is lowered, is spread over several blocks
+	for i := 0; i < maxAttestationsPrunedPerBlock; i++ {
+		// the last observed nonce is never pruned, it's the one new claims are checked against
+		nonce, found := firstAttestationNonce(ctx, k, lastObserved)
+		if !found {
+			return
+		}
+		atts := k.GetAttestationsByNonce(ctx, nonce)
+		for _, att := range atts {
+			if att.Observed && att.ObservedHeight+retention > height {
+				return
 			}
 		}
+		for _, att := range atts {
+			k.DeleteAttestation(ctx, att)
+		}
 	}
 }
+
+// firstAttestationNonce returns the lowest event nonce below end that has any attestations
+func firstAttestationNonce(ctx sdk.Context, k keeper.Keeper, end uint64) (nonce uint64, found bool) {
+	k.IterateAttestationsByNonceRange(ctx, 0, end, func(key []byte, _ types.Attestation) bool {
+		nonce = types.UInt64FromBytes(key[:8])
+		found = true
+		return true
+	})
+	return
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -47,6 +48,49 @@ func TestValsetCreationUponUnbonding(t *testing.T) {
 	assert.NotEqual(t, currentValsetNonce, pk.GetLatestValsetNonce(ctx))
 }
 
+func TestValsetCreationPolicy(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.ValsetMaxAge = 10
+	params.ValsetMinInterval = 5
+	params.ValsetOnEthAddressChange = true
+	pk.SetParams(ctx, params)
+
+	height := ctx.BlockHeight()
+	createValset := func(blocks int64) string {
+		ctx = ctx.WithBlockHeight(height + blocks).WithEventManager(sdk.NewEventManager())
+		createValsets(ctx, pk, params)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeMultisigUpdateRequest {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyValsetReason {
+					return string(attr.Value)
+				}
+			}
+		}
+		return ""
+	}
+
+	assert.Equal(t, types.ValsetReasonNoValset, createValset(0))
+	assert.Equal(t, "", createValset(1))
+
+	// an Ethereum address registered after the valset is held back by the min interval
+	pk.SetLastEthAddressChangeHeight(ctx, uint64(height+2))
+	assert.Equal(t, "", createValset(2))
+	assert.Equal(t, types.ValsetReasonEthAddressChange, createValset(5))
+	assert.Equal(t, "", createValset(14))
+	assert.Equal(t, types.ValsetReasonMaxAge, createValset(15))
+
+	// a change of power above the threshold triggers a valset once the min interval passed
+	input.StakingKeeper.Jail(ctx, sdk.ConsAddress(keeper.ConsPubKeys[0].Address()))
+	assert.Equal(t, "", createValset(16))
+	assert.Equal(t, types.ValsetReasonPowerChange, createValset(20))
+	assert.Len(t, pk.GetValsets(ctx), 4)
+}
+
 func TestValsetSlashing_ValsetCreated_Before_ValidatorBonded(t *testing.T) {
 	//	Don't slash validators if valset is created before he is bonded.
 
@@ -101,6 +145,37 @@ func TestValsetSlashing_ValsetCreated_After_ValidatorBonded(t *testing.T) {
 	val = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1])
 	require.False(t, val.IsJailed())
 
+	// both have the valset recorded in their bridge signing info
+	info, found := pk.GetValidatorBridgeSigningInfo(ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	assert.Equal(t, uint64(1), info.Valsets.MissedCounter)
+	info, found = pk.GetValidatorBridgeSigningInfo(ctx, keeper.ValAddrs[1])
+	require.True(t, found)
+	assert.Equal(t, uint64(0), info.Valsets.MissedCounter)
+	assert.Equal(t, uint64(1), info.Valsets.IndexOffset)
+}
+
+func TestValsetSlashing_CappedValset(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.MaxBridgeValidators = 2
+	params.MinBridgePowerShare = sdk.ZeroDec()
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
+	vs := pk.GetCurrentValset(ctx)
+	require.Len(t, vs.Members, 2)
+	vs.Height = uint64(ctx.BlockHeight()) - (params.SignedValsetsWindow + 1)
+	vs.Nonce = pk.GetLatestValsetNonce(ctx) + 1
+	pk.StoreValsetUnsafe(ctx, vs)
+
+	// nobody confirms the valset, only its members are slashed for it
+	EndBlocker(ctx, pk)
+	for i, valAddr := range keeper.ValAddrs {
+		val := input.StakingKeeper.Validator(ctx, valAddr)
+		assert.Equal(t, vs.HasMember(types.MustNewEthAddress(keeper.EthAddrs[i].String())), val.IsJailed())
+	}
 }
 
 func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T) {
@@ -167,6 +242,43 @@ func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T)
 	// check if tokens shouldn't be slashed for val2.
 }
 
+func TestGraduatedValsetSlashing_UnbondingValidator(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.GraduatedBridgeSlashing = true
+	pk.SetParams(ctx, params)
+
+	valsetRequestHeight := ctx.BlockHeight() + 1
+	ctx = ctx.WithBlockHeight(valsetRequestHeight)
+	vs := pk.GetCurrentValset(ctx)
+	vs.Height = uint64(valsetRequestHeight)
+	vs.Nonce = pk.GetLatestValsetNonce(ctx) + 1
+	pk.StoreValsetUnsafe(ctx, vs)
+
+	// the first validator starts unbonding without confirming the valset
+	input.Context = ctx.WithBlockHeight(valsetRequestHeight + 1)
+	sh := staking.NewHandler(input.StakingKeeper)
+	sh(input.Context, keeper.NewTestMsgUnDelegateValidator(keeper.ValAddrs[0], keeper.StakingAmount))
+	for i, val := range keeper.AccAddrs[1:] {
+		conf := types.NewMsgValsetConfirm(vs.Nonce, keeper.EthAddrs[i+1].String(), val, "dummysig")
+		pk.SetValsetConfirm(ctx, *conf)
+	}
+	staking.EndBlocker(input.Context, input.StakingKeeper)
+	tokens := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+
+	ctx = ctx.WithBlockHeight(valsetRequestHeight + int64(params.SignedValsetsWindow) + 1)
+	EndBlocker(ctx, pk)
+
+	// a single missed valset is recorded instead of slashed
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	assert.Equal(t, tokens, val.GetTokens())
+	info, found := pk.GetValidatorBridgeSigningInfo(ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	assert.Equal(t, uint64(1), info.Valsets.MissedCounter)
+	assert.Equal(t, uint64(0), info.DowntimeJailings)
+}
+
 func TestBatchSlashing(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -220,6 +332,65 @@ func TestBatchSlashing(t *testing.T) {
 
 }
 
+func TestGraduatedBatchSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.GraduatedBridgeSlashing = true
+	params.BridgeSigningWindow = 4
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	pk.SetParams(ctx, params)
+	for i := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+
+	// the first validator misses every batch, the others confirm all of them
+	storeBatch := func(nonce uint64) {
+		batch := &types.OutgoingTxBatch{
+			BatchNonce:    nonce,
+			TokenContract: keeper.TokenContractAddrs[0],
+			Block:         uint64(ctx.BlockHeight()),
+		}
+		pk.StoreBatchUnsafe(ctx, batch)
+		for i, val := range keeper.AccAddrs[1:] {
+			pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+				Nonce:         nonce,
+				TokenContract: batch.TokenContract,
+				EthSigner:     keeper.EthAddrs[i+1].String(),
+				Orchestrator:  val.String(),
+			})
+		}
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+	storeBatch(1)
+	storeBatch(2)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow))
+	EndBlocker(ctx, pk)
+
+	// two misses out of a window of four are still enough confirms
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.False(t, val.IsJailed())
+	info, found := pk.GetValidatorBridgeSigningInfo(ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	assert.Equal(t, uint64(2), info.Batches.MissedCounter)
+
+	storeBatch(3)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow))
+	EndBlocker(ctx, pk)
+
+	val = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	require.True(t, val.IsJailed())
+	info, _ = pk.GetValidatorBridgeSigningInfo(ctx, keeper.ValAddrs[0])
+	assert.Equal(t, uint64(1), info.DowntimeJailings)
+	assert.Equal(t, uint64(0), info.Batches.MissedCounter)
+	assert.Equal(t, uint64(3), info.Batches.TotalMissed)
+	consAddr, _ := val.GetConsAddr()
+	signingInfo, found := input.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	assert.Equal(t, ctx.BlockTime().Add(params.BridgeJailDuration), signingInfo.JailedUntil)
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).IsJailed())
+}
+
 func TestValsetEmission(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -239,6 +410,100 @@ func TestValsetEmission(t *testing.T) {
 	require.True(t, len(valsets) == 2)
 }
 
+func TestAttestationTallyAndPrune(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	for i := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+	vote := func(nonce uint64, val int) { attestDeposit(t, ctx, pk, nonce, val) }
+
+	// nonces 1 and 3 have enough votes, nonce 2 only has one
+	for val := 0; val < 4; val++ {
+		vote(1, val)
+	}
+	vote(2, 0)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(1), pk.GetLastObservedEventNonce(ctx))
+
+	for val := 1; val < 4; val++ {
+		vote(2, val)
+	}
+	for val := 0; val < 4; val++ {
+		vote(3, val)
+	}
+	EndBlocker(ctx, pk)
+
+	// both nonces are observed in the same block and everything before the last one is pruned
+	assert.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+	assert.Empty(t, pk.GetAttestationsByNonce(ctx, 1))
+	assert.Empty(t, pk.GetAttestationsByNonce(ctx, 2))
+	require.Len(t, pk.GetAttestationsByNonce(ctx, 3), 1)
+	assert.True(t, pk.GetAttestationsByNonce(ctx, 3)[0].Observed)
+
+	// the validator that never voted missed every observed claim
+	info, found := pk.GetValidatorBridgeSigningInfo(ctx, keeper.ValAddrs[4])
+	require.True(t, found)
+	assert.Equal(t, uint64(3), info.Claims.MissedCounter)
+	info, found = pk.GetValidatorBridgeSigningInfo(ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	assert.Equal(t, uint64(0), info.Claims.MissedCounter)
+	assert.Equal(t, uint64(3), info.Claims.IndexOffset)
+}
+
+func TestAttestationRetention(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.AttestationRetentionBlocks = 10
+	pk.SetParams(ctx, params)
+	for i := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		for val := 0; val < 4; val++ {
+			attestDeposit(t, ctx, pk, nonce, val)
+		}
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		EndBlocker(ctx, pk)
+	}
+	observedAt := ctx.BlockHeight() - 3
+	require.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+
+	// nothing has been retained long enough yet, so older attestations can still be queried
+	require.Len(t, pk.GetAttestationsByNonce(ctx, 1), 1)
+	assert.Equal(t, uint64(observedAt+1), pk.GetAttestationsByNonce(ctx, 1)[0].ObservedHeight)
+
+	// the first attestation expires, but the second was observed a block later
+	ctx = ctx.WithBlockHeight(observedAt + 11)
+	EndBlocker(ctx, pk)
+	assert.Empty(t, pk.GetAttestationsByNonce(ctx, 1))
+	assert.Len(t, pk.GetAttestationsByNonce(ctx, 2), 1)
+
+	// the last observed attestation is never pruned
+	ctx = ctx.WithBlockHeight(observedAt + 100)
+	EndBlocker(ctx, pk)
+	assert.Empty(t, pk.GetAttestationsByNonce(ctx, 2))
+	assert.Len(t, pk.GetAttestationsByNonce(ctx, 3), 1)
+}
+
+// attestDeposit submits a deposit claim at the given nonce from the orchestrator of keeper.ValAddrs[val]
+func attestDeposit(t *testing.T, ctx sdk.Context, pk keeper.Keeper, nonce uint64, val int) {
+	msg := &types.MsgSendToCosmosClaim{
+		EventNonce:     nonce,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: keeper.AccAddrs[4].String(),
+		Orchestrator:   keeper.AccAddrs[val].String(),
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	_, err = pk.Attest(ctx, msg, any)
+	require.NoError(t, err)
+}
+
 func TestValsetSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.GravityKeeper
@@ -258,7 +523,7 @@ func TestBatchTimeout(t *testing.T) {
 		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
 		allVouchers         = sdk.NewCoins(
-			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+			sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(99999)),
 		)
 	)
 
@@ -266,6 +531,7 @@ func TestBatchTimeout(t *testing.T) {
 	require.Greater(t, params.AverageEthereumBlockTime, uint64(0))
 
 	// mint some vouchers first
+	pk.RegisterDenomTrace(ctx, myTokenContractAddr)
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
@@ -273,8 +539,8 @@ func TestBatchTimeout(t *testing.T) {
 
 	// add some TX to the pool
 	for i, v := range []uint64{2, 3, 2, 1, 5, 6} {
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(v))
 		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 	}
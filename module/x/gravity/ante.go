@@ -0,0 +1,56 @@
+package gravity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+)
+
+// feeWaivedKey marks a tx context once the OrchestratorMsgDecorator has waived the tx's fees
+type feeWaivedKey struct{}
+
+// OrchestratorMsgDecorator checks the claims and confirms in a tx against the current state before they are
+// executed, so that in CheckTx orchestrator messages that would fail are rejected before they take up block space.
+// Orchestrators should not have to hold fee tokens just to do their bridge duties, so a tx made up only of valid
+// orchestrator messages has its fees waived, everything else pays fees as normal.
+type OrchestratorMsgDecorator struct {
+	k keeper.Keeper
+}
+
+// NewOrchestratorMsgDecorator returns a new OrchestratorMsgDecorator
+func NewOrchestratorMsgDecorator(k keeper.Keeper) OrchestratorMsgDecorator {
+	return OrchestratorMsgDecorator{k: k}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d OrchestratorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	orchestratorOnly, err := d.k.ValidateOrchestratorMsgs(ctx, tx.GetMsgs())
+	switch {
+	// a tx of only orchestrator messages would otherwise fail for free, so it is rejected in DeliverTx too, when
+	// mixed with other messages the handlers run these same checks and a tx that fails them simply pays its fees
+	case err != nil && (ctx.IsCheckTx() || orchestratorOnly):
+		return ctx, err
+	case err == nil && orchestratorOnly:
+		ctx = ctx.WithValue(feeWaivedKey{}, true)
+	}
+	return next(ctx, tx, simulate)
+}
+
+// FeeWaiverDecorator wraps one of the fee decorators so that it is skipped for txs the
+// OrchestratorMsgDecorator has waived fees for
+type FeeWaiverDecorator struct {
+	inner sdk.AnteDecorator
+}
+
+// NewFeeWaiverDecorator returns a new FeeWaiverDecorator wrapping a fee decorator
+func NewFeeWaiverDecorator(inner sdk.AnteDecorator) FeeWaiverDecorator {
+	return FeeWaiverDecorator{inner: inner}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d FeeWaiverDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if waived, _ := ctx.Value(feeWaivedKey{}).(bool); waived {
+		return next(ctx, tx, simulate)
+	}
+	return d.inner.AnteHandle(ctx, tx, simulate, next)
+}
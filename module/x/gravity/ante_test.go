@@ -0,0 +1,121 @@
+package gravity
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx testTx) ValidateBasic() error { return nil }
+
+// feeDecorator records whether fees were charged
+type feeDecorator struct {
+	charged *bool
+}
+
+func (d feeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.charged = true
+	return next(ctx, tx, simulate)
+}
+
+func TestOrchestratorMsgDecorator(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[0], keeper.AccAddrs[0])
+
+	var charged bool
+	anteHandler := sdk.ChainAnteDecorators(
+		NewOrchestratorMsgDecorator(pk),
+		NewFeeWaiverDecorator(feeDecorator{charged: &charged}),
+	)
+	claim := func(nonce uint64, orch sdk.AccAddress) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: keeper.AccAddrs[1].String(),
+			Orchestrator:   orch.String(),
+		}
+	}
+	run := func(ctx sdk.Context, msgs ...sdk.Msg) error {
+		charged = false
+		_, err := anteHandler(ctx, testTx{msgs: msgs}, false)
+		return err
+	}
+	// CheckTx runs against its own copy of the state
+	checkCtx, _ := ctx.CacheContext()
+	checkCtx = checkCtx.WithIsCheckTx(true)
+
+	// valid claims are free, and several can wait in the mempool at once
+	require.NoError(t, run(checkCtx, claim(1, keeper.AccAddrs[0])))
+	assert.False(t, charged)
+	require.NoError(t, run(checkCtx, claim(2, keeper.AccAddrs[0])))
+	assert.False(t, charged)
+
+	// claims that skip a nonce, or come from an unknown orchestrator, are rejected in CheckTx
+	require.Error(t, run(checkCtx, claim(4, keeper.AccAddrs[0])))
+	require.Error(t, run(checkCtx, claim(1, keeper.AccAddrs[1])))
+
+	// in DeliverTx they are rejected too rather than failing in the handler without paying fees
+	require.Error(t, run(ctx, claim(4, keeper.AccAddrs[0])))
+	assert.Equal(t, uint64(0), pk.GetLastEventNonceByValidator(ctx, keeper.ValAddrs[0]))
+
+	// anything mixed in with other messages pays fees, and is left to fail in the handler
+	send := types.NewMsgSendToEth(keeper.AccAddrs[0], keeper.EthAddrs[1].String(), sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("stake", 1))
+	require.NoError(t, run(ctx, claim(1, keeper.AccAddrs[0]), send))
+	assert.True(t, charged)
+	require.NoError(t, run(ctx, claim(4, keeper.AccAddrs[0]), send))
+	assert.True(t, charged)
+
+	// confirms are only free if what they sign exists and the signature is valid
+	valset := pk.SetValsetRequest(ctx)
+	privKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := ethCrypto.PubkeyToAddress(privKey.PublicKey).String()
+	pk.SetEthAddressForValidator(ctx, keeper.ValAddrs[0], types.MustNewEthAddress(ethAddr))
+	sig, err := types.NewEthereumSignature(valset.GetCheckpoint(pk.GetGravityID(ctx)), privKey)
+	require.NoError(t, err)
+	confirm := types.NewMsgValsetConfirm(valset.Nonce, ethAddr, keeper.AccAddrs[0], hex.EncodeToString(sig))
+	require.NoError(t, run(ctx, confirm))
+	assert.False(t, charged)
+	// only the handler stores the confirm in DeliverTx
+	assert.Nil(t, pk.GetValsetConfirm(ctx, valset.Nonce, keeper.AccAddrs[0]))
+
+	// a confirm waiting in the mempool rejects a second copy, as does a tx that repeats it
+	checkCtx, _ = ctx.CacheContext()
+	checkCtx = checkCtx.WithIsCheckTx(true)
+	require.Error(t, run(ctx, &types.MsgSubmitConfirms{
+		ValsetConfirms: []types.MsgValsetConfirm{*confirm, *confirm},
+		Orchestrator:   keeper.AccAddrs[0].String(),
+	}))
+	require.NoError(t, run(checkCtx, confirm))
+	require.Error(t, run(checkCtx, confirm))
+
+	missing := *confirm
+	missing.Nonce = valset.Nonce + 1
+	badSig := *confirm
+	badSig.Signature = hex.EncodeToString(make([]byte, len(sig)))
+	for _, msg := range []*types.MsgValsetConfirm{&missing, &badSig} {
+		require.Error(t, run(checkCtx, msg))
+		require.Error(t, run(ctx, msg))
+		require.Error(t, run(ctx, &types.MsgSubmitConfirms{
+			ValsetConfirms: []types.MsgValsetConfirm{*confirm, *msg},
+			Orchestrator:   keeper.AccAddrs[0].String(),
+		}))
+		require.NoError(t, run(ctx, msg, send))
+		assert.True(t, charged)
+	}
+}
@@ -24,6 +24,17 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetValsetConfirm(),
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
+		CmdGetAttestations(),
+		CmdGetValsets(),
+		CmdGetCheckpointInfo(),
+		CmdGetBridgeStatus(),
+		CmdGetBridgeSigningInfo(),
+		CmdGetBridgeSigningInfos(),
+		CmdGetRelayerStats(),
+		CmdGetERC20DeploymentApprovals(),
+		CmdGetTokenPolicy(),
+		CmdGetHeldDeposits(),
+		CmdGetDenomTrace(),
 		// CmdGetAllOutgoingTXBatchRequest(),
 		// CmdGetOutgoingTXBatchByNonceRequest(),
 		// CmdGetAllAttestationsRequest(),
@@ -202,3 +213,312 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestations [event nonce]",
+		Short: "Get the attestations at a particular event nonce, along with their votes and tallies",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttestationsRequest{
+				Nonce: nonce,
+			}
+
+			res, err := queryClient.Attestations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValsets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valsets [start nonce] [end nonce]",
+		Short: "Get the valsets with a nonce in a range, an end nonce of 0 leaves the range open",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValsetsRequest{
+				StartNonce: startNonce,
+				EndNonce:   endNonce,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Valsets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "valsets")
+	return cmd
+}
+
+func CmdGetCheckpointInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-info [hex checkpoint]",
+		Short: "Get whether an Ethereum signature checkpoint was produced by this chain, and from which valset, batch or logic call",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCheckpointInfoRequest{
+				Checkpoint: args[0],
+			}
+
+			res, err := queryClient.CheckpointInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-status",
+		Short: "Get the health of the bridge and of the orchestrator of every bonded validator",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BridgeStatus(cmd.Context(), &types.QueryBridgeStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeSigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-signing-info [validator address]",
+		Short: "Get how often a validator missed valset, batch and logic call confirms and claims",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorBridgeSigningInfoRequest{
+				ValidatorAddress: args[0],
+			}
+
+			res, err := queryClient.ValidatorBridgeSigningInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeSigningInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-signing-infos",
+		Short: "Get how often every validator missed valset, batch and logic call confirms and claims",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValidatorBridgeSigningInfosRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ValidatorBridgeSigningInfos(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bridge-signing-infos")
+	return cmd
+}
+
+func CmdGetRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-stats [ethereum relayer address]",
+		Short: "Get the batches, valsets and logic calls relayers were observed relaying and the fees and rewards they collected",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRelayerStatsRequest{
+				Pagination: pageReq,
+			}
+			if len(args) == 1 {
+				req.Relayer = args[0]
+			}
+
+			res, err := queryClient.RelayerStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayer-stats")
+	return cmd
+}
+
+func CmdGetERC20DeploymentApprovals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-deployment-approvals",
+		Short: "Get the denoms governance approved for ERC20 deployments",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ERC20DeploymentApprovals(cmd.Context(), &types.QueryERC20DeploymentApprovalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetTokenPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-policy",
+		Short: "Get the policy for which Ethereum originated tokens are bridged to Cosmos",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenPolicy(cmd.Context(), &types.QueryTokenPolicyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetHeldDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "held-deposits [cosmos receiver address]",
+		Short: "Get the deposits held by the token policy, optionally only those of one receiver",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHeldDepositsRequest{
+				Pagination: pageReq,
+			}
+			if len(args) == 1 {
+				req.CosmosReceiver = args[0]
+			}
+
+			res, err := queryClient.HeldDeposits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "held-deposits")
+	return cmd
+}
+
+func CmdGetDenomTrace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-trace [denom or erc20]",
+		Short: "Get the ERC20 behind a gravity voucher denom, or the voucher denom of an ERC20",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomTraceRequest{Denom: args[0]}
+			if types.ValidateEthAddress(args[0]) == nil {
+				req = &types.QueryDenomTraceRequest{Erc20: args[0]}
+			}
+
+			res, err := queryClient.DenomTrace(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
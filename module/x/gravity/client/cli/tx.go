@@ -3,14 +3,20 @@ package cli
 import (
 	"crypto/ecdsa"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 
@@ -30,6 +36,7 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdSendToEth(),
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
+		CmdReclaimHeldDeposit(),
 		GetUnsafeTestingCmd(),
 	}...)
 
@@ -163,6 +170,40 @@ func CmdRequestBatch() *cobra.Command {
 	return cmd
 }
 
+func CmdReclaimHeldDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reclaim-held-deposit [deposit_id] [return_fee]",
+		Short: "Reclaim a deposit the token policy held, it is released if the token is allowed by now and returned to Ethereum otherwise, paying the optional return fee out of the returned tokens",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "deposit id")
+			}
+			returnFee := sdk.ZeroInt()
+			if len(args) > 1 {
+				var ok bool
+				if returnFee, ok = sdk.NewIntFromString(args[1]); !ok {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "return fee %s", args[1])
+				}
+			}
+
+			msg := types.NewMsgReclaimHeldDeposit(cliCtx.GetFromAddress(), id, returnFee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdSetOrchestratorAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address]",
@@ -188,3 +229,280 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// ERC20WhitelistProposalJSON is the file format of an ERC20 whitelist proposal
+type ERC20WhitelistProposalJSON struct {
+	Title       string                          `json:"title"`
+	Description string                          `json:"description"`
+	Approvals   []types.ERC20DeploymentApproval `json:"approvals"`
+	Deposit     string                          `json:"deposit"`
+}
+
+// ERC20RemapProposalJSON is the file format of an ERC20 remap proposal
+type ERC20RemapProposalJSON struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Denom       string `json:"denom"`
+	ERC20       string `json:"erc20"`
+	Exponent    int64  `json:"exponent"`
+	Deposit     string `json:"deposit"`
+}
+
+// TokenPolicyProposalJSON is the file format of a token policy proposal
+type TokenPolicyProposalJSON struct {
+	Title          string   `json:"title"`
+	Description    string   `json:"description"`
+	Mode           string   `json:"mode"`
+	TokenContracts []string `json:"token_contracts"`
+	Deposit        string   `json:"deposit"`
+}
+
+// ERC20MetadataProposalJSON is the file format of an ERC20 metadata proposal
+type ERC20MetadataProposalJSON struct {
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	TokenContract string `json:"token_contract"`
+	Name          string `json:"name"`
+	Symbol        string `json:"symbol"`
+	Decimals      uint64 `json:"decimals"`
+	Deposit       string `json:"deposit"`
+}
+
+// TokenScalingProposalJSON is the file format of a token scaling proposal
+type TokenScalingProposalJSON struct {
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	TokenContract string `json:"token_contract"`
+	Exponent      int64  `json:"exponent"`
+	Deposit       string `json:"deposit"`
+}
+
+func CmdSubmitERC20WhitelistProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "erc20-whitelist [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal approving denoms for ERC20 deployments",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal approving which denoms may be bridged to Ethereum, optionally
+pinning the ERC20 address or deployer that is accepted for each of them. An ERC20 with other
+decimals than its denom is only accepted if the exponent, its decimals minus the denom decimals,
+is approved.
+
+Example:
+$ %s tx gov submit-proposal erc20-whitelist <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Approve stake for the bridge",
+  "description": "Only accept the audited deployment of the stake ERC20",
+  "approvals": [
+    {"denom": "stake", "erc20": "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"}
+  ],
+  "deposit": "1000stake"
+}
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			var proposal ERC20WhitelistProposalJSON
+			if err := readProposalFile(args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewERC20WhitelistProposal(proposal.Title, proposal.Description, proposal.Approvals)
+			return submitProposal(cmd, cliCtx, content, proposal.Deposit)
+		},
+	}
+}
+
+func CmdSubmitERC20RemapProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "erc20-remap [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal mapping a denom to the ERC20 its token was migrated to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal mapping a Cosmos originated denom to a new ERC20 after the
+token was migrated on Ethereum. The exponent is the decimals of the new ERC20 minus the decimals
+of the denom.
+
+Example:
+$ %s tx gov submit-proposal erc20-remap <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Migrate the stake ERC20",
+  "description": "Use the upgraded stake ERC20",
+  "denom": "stake",
+  "erc20": "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8",
+  "exponent": 0,
+  "deposit": "1000stake"
+}
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			var proposal ERC20RemapProposalJSON
+			if err := readProposalFile(args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewERC20RemapProposal(proposal.Title, proposal.Description, proposal.Denom, proposal.ERC20, proposal.Exponent)
+			return submitProposal(cmd, cliCtx, content, proposal.Deposit)
+		},
+	}
+}
+
+func CmdSubmitTokenPolicyProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-policy [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal replacing the policy for which Ethereum originated tokens are bridged",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal replacing the policy for which Ethereum originated tokens are
+bridged to Cosmos. The mode is one of TOKEN_POLICY_MODE_ALLOW_ALL, TOKEN_POLICY_MODE_ALLOW_LIST
+or TOKEN_POLICY_MODE_DENY_LIST, deposits of tokens the policy does not allow are held for their
+receiver to reclaim.
+
+Example:
+$ %s tx gov submit-proposal token-policy <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Deny a fee on transfer token",
+  "description": "Deposits of this token break the bridge accounting",
+  "mode": "TOKEN_POLICY_MODE_DENY_LIST",
+  "token_contracts": ["0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"],
+  "deposit": "1000stake"
+}
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			var proposal TokenPolicyProposalJSON
+			if err := readProposalFile(args[0], &proposal); err != nil {
+				return err
+			}
+			mode, ok := types.TokenPolicyMode_value[proposal.Mode]
+			if !ok {
+				return fmt.Errorf("unknown token policy mode %q", proposal.Mode)
+			}
+			policy := types.TokenPolicy{Mode: types.TokenPolicyMode(mode), TokenContracts: proposal.TokenContracts}
+			content := types.NewTokenPolicyProposal(proposal.Title, proposal.Description, policy)
+			return submitProposal(cmd, cliCtx, content, proposal.Deposit)
+		},
+	}
+}
+
+func CmdSubmitERC20MetadataProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "erc20-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal setting the denom metadata of the voucher of an Ethereum originated token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal setting the bank denom metadata of the gravity voucher of an
+Ethereum originated token, overriding the name, symbol and decimals reported by deposits. The token
+contract has to be spelled as in the voucher denom.
+
+Example:
+$ %s tx gov submit-proposal erc20-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Fix the DAI metadata",
+  "description": "Display DAI with its 18 decimals",
+  "token_contract": "0x6B175474E89094C44Da98b954EedeAC495271d0F",
+  "name": "Dai Stablecoin",
+  "symbol": "DAI",
+  "decimals": 18,
+  "deposit": "1000stake"
+}
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			var proposal ERC20MetadataProposalJSON
+			if err := readProposalFile(args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewERC20MetadataProposal(proposal.Title, proposal.Description, proposal.TokenContract, proposal.Name, proposal.Symbol, proposal.Decimals)
+			return submitProposal(cmd, cliCtx, content, proposal.Deposit)
+		},
+	}
+}
+
+func CmdSubmitTokenScalingProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-scaling [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal setting the decimal scaling of an Ethereum originated token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal setting the decimal scaling between an Ethereum originated ERC20 and
+its gravity voucher, one voucher unit is 10^exponent ERC20 units. A negative exponent gives the
+voucher more decimals than the ERC20. The scaling can only change while no vouchers of the token
+exist and none of its transfers are pending.
+
+Example:
+$ %s tx gov submit-proposal token-scaling <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Bridge DAI with 6 decimals",
+  "description": "Represent DAI with 6 decimals on Cosmos",
+  "token_contract": "0x6B175474E89094C44Da98b954EedeAC495271d0F",
+  "exponent": 12,
+  "deposit": "1000stake"
+}
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			var proposal TokenScalingProposalJSON
+			if err := readProposalFile(args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewTokenScalingProposal(proposal.Title, proposal.Description, proposal.TokenContract, proposal.Exponent)
+			return submitProposal(cmd, cliCtx, content, proposal.Deposit)
+		},
+	}
+}
+
+func readProposalFile(path string, proposal interface{}) error {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(contents, proposal)
+}
+
+func submitProposal(cmd *cobra.Command, cliCtx client.Context, content govtypes.Content, deposit string) error {
+	coins, err := sdk.ParseCoinsNormalized(deposit)
+	if err != nil {
+		return err
+	}
+	msg, err := govtypes.NewMsgSubmitProposal(content, coins, cliCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/cli"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/rest"
+)
+
+// ProposalHandlers for the governance proposals of the gravity module
+var (
+	ERC20WhitelistProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitERC20WhitelistProposal, rest.ERC20WhitelistProposalRESTHandler)
+	ERC20RemapProposalHandler     = govclient.NewProposalHandler(cli.CmdSubmitERC20RemapProposal, rest.ERC20RemapProposalRESTHandler)
+	TokenPolicyProposalHandler    = govclient.NewProposalHandler(cli.CmdSubmitTokenPolicyProposal, rest.TokenPolicyProposalRESTHandler)
+	ERC20MetadataProposalHandler  = govclient.NewProposalHandler(cli.CmdSubmitERC20MetadataProposal, rest.ERC20MetadataProposalRESTHandler)
+	TokenScalingProposalHandler   = govclient.NewProposalHandler(cli.CmdSubmitTokenScalingProposal, rest.TokenScalingProposalRESTHandler)
+)
@@ -0,0 +1,155 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+type erc20WhitelistProposalReq struct {
+	BaseReq     rest.BaseReq                    `json:"base_req"`
+	Title       string                          `json:"title"`
+	Description string                          `json:"description"`
+	Approvals   []types.ERC20DeploymentApproval `json:"approvals"`
+	Proposer    sdk.AccAddress                  `json:"proposer"`
+	Deposit     sdk.Coins                       `json:"deposit"`
+}
+
+type erc20RemapProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Denom       string         `json:"denom"`
+	ERC20       string         `json:"erc20"`
+	Exponent    int64          `json:"exponent"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
+type tokenPolicyProposalReq struct {
+	BaseReq     rest.BaseReq      `json:"base_req"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Policy      types.TokenPolicy `json:"policy"`
+	Proposer    sdk.AccAddress    `json:"proposer"`
+	Deposit     sdk.Coins         `json:"deposit"`
+}
+
+type erc20MetadataProposalReq struct {
+	BaseReq       rest.BaseReq   `json:"base_req"`
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	TokenContract string         `json:"token_contract"`
+	Name          string         `json:"name"`
+	Symbol        string         `json:"symbol"`
+	Decimals      uint64         `json:"decimals"`
+	Proposer      sdk.AccAddress `json:"proposer"`
+	Deposit       sdk.Coins      `json:"deposit"`
+}
+
+type tokenScalingProposalReq struct {
+	BaseReq       rest.BaseReq   `json:"base_req"`
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	TokenContract string         `json:"token_contract"`
+	Exponent      int64          `json:"exponent"`
+	Proposer      sdk.AccAddress `json:"proposer"`
+	Deposit       sdk.Coins      `json:"deposit"`
+}
+
+// ERC20WhitelistProposalRESTHandler exposes submitting ERC20 whitelist proposals with the gov REST routes
+func ERC20WhitelistProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "erc20_whitelist",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req erc20WhitelistProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewERC20WhitelistProposal(req.Title, req.Description, req.Approvals)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// ERC20RemapProposalRESTHandler exposes submitting ERC20 remap proposals with the gov REST routes
+func ERC20RemapProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "erc20_remap",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req erc20RemapProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewERC20RemapProposal(req.Title, req.Description, req.Denom, req.ERC20, req.Exponent)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// TokenPolicyProposalRESTHandler exposes submitting token policy proposals with the gov REST routes
+func TokenPolicyProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "token_policy",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req tokenPolicyProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewTokenPolicyProposal(req.Title, req.Description, req.Policy)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// ERC20MetadataProposalRESTHandler exposes submitting ERC20 metadata proposals with the gov REST routes
+func ERC20MetadataProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "erc20_metadata",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req erc20MetadataProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewERC20MetadataProposal(req.Title, req.Description, req.TokenContract, req.Name, req.Symbol, req.Decimals)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// TokenScalingProposalRESTHandler exposes submitting token scaling proposals with the gov REST routes
+func TokenScalingProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "token_scaling",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req tokenScalingProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewTokenScalingProposal(req.Title, req.Description, req.TokenContract, req.Exponent)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func writeProposalTx(w http.ResponseWriter, cliCtx client.Context, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+	tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+}
@@ -56,7 +56,7 @@ func initializeTestingVars(t *testing.T) *testingVars {
 	return &tv
 }
 
-func addDenomToERC20Relation(tv *testingVars) {
+func setDenomMetadata(tv *testingVars) {
 	tv.input.BankKeeper.SetDenomMetaData(tv.ctx, bank.Metadata{
 		Description: "The native staking token of the Cosmos Hub.",
 		DenomUnits: []*bank.DenomUnit{
@@ -67,6 +67,10 @@ func addDenomToERC20Relation(tv *testingVars) {
 		Base:    "uatom",
 		Display: "atom",
 	})
+}
+
+func addDenomToERC20Relation(tv *testingVars) {
+	setDenomMetadata(tv)
 
 	var (
 		myNonce = uint64(1)
@@ -100,7 +104,7 @@ func addDenomToERC20Relation(tv *testingVars) {
 	assert.True(tv.t, isCosmosOriginated)
 
 	assert.Equal(tv.t, tv.denom, gotDenom)
-	assert.Equal(tv.t, tv.erc20, gotERC20)
+	assert.Equal(tv.t, types.CanonicalEthAddress(tv.erc20), gotERC20)
 }
 
 func lockCoinsInModule(tv *testingVars) {
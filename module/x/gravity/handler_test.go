@@ -2,10 +2,12 @@ package gravity
 
 import (
 	"bytes"
+	"encoding/hex"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -18,7 +20,8 @@ func TestHandleMsgSendToEth(t *testing.T) {
 		userCosmosAddr, _               = sdk.AccAddressFromBech32("cosmos1990z7dqsvh8gthw9pa5sn4wuy2xrsd80mg5z6y")
 		blockTime                       = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
 		blockHeight           int64     = 200
-		denom                           = "gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		tokenContract                   = "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"
+		denom                           = types.GravityDenom(tokenContract)
 		startingCoinAmount, _           = sdk.NewIntFromString("150000000000000000000") // 150 ETH worth, required to reach above u64 limit (which is about 18 ETH)
 		sendAmount, _                   = sdk.NewIntFromString("50000000000000000000")  // 50 ETH
 		feeAmount, _                    = sdk.NewIntFromString("5000000000000000000")   // 5 ETH
@@ -32,6 +35,7 @@ func TestHandleMsgSendToEth(t *testing.T) {
 	input := keeper.CreateTestEnv(t)
 	ctx := input.Context
 	h := NewHandler(input.GravityKeeper)
+	input.GravityKeeper.RegisterDenomTrace(ctx, tokenContract)
 	input.BankKeeper.MintCoins(ctx, types.ModuleName, startingCoins)
 	input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userCosmosAddr, startingCoins)
 	balance1 := input.BankKeeper.GetAllBalances(ctx, userCosmosAddr)
@@ -118,7 +122,7 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 	require.NotNil(t, a)
 	// and vouchers added to the account
 	balance := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewCoin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountA)}, balance)
+	assert.Equal(t, sdk.Coins{sdk.NewCoin(types.GravityDenom(tokenETHAddr), amountA)}, balance)
 
 	// Test to reject duplicate deposit
 	// when
@@ -128,7 +132,7 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 	// then
 	require.Error(t, err)
 	balance = input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewCoin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountA)}, balance)
+	assert.Equal(t, sdk.Coins{sdk.NewCoin(types.GravityDenom(tokenETHAddr), amountA)}, balance)
 
 	// Test to reject skipped nonce
 	ethClaim = types.MsgSendToCosmosClaim{
@@ -147,7 +151,7 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 	// then
 	require.Error(t, err)
 	balance = input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewCoin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountA)}, balance)
+	assert.Equal(t, sdk.Coins{sdk.NewCoin(types.GravityDenom(tokenETHAddr), amountA)}, balance)
 
 	// Test to finally accept consecutive nonce
 	ethClaim = types.MsgSendToCosmosClaim{
@@ -167,7 +171,7 @@ func TestMsgSendToCosmosClaimSingleValidator(t *testing.T) {
 	// then
 	require.NoError(t, err)
 	balance = input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewCoin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountB)}, balance)
+	assert.Equal(t, sdk.Coins{sdk.NewCoin(types.GravityDenom(tokenETHAddr), amountB)}, balance)
 }
 
 func TestMsgSendToCosmosClaimsMultiValidator(t *testing.T) {
@@ -232,7 +236,7 @@ func TestMsgSendToCosmosClaimsMultiValidator(t *testing.T) {
 	require.NotNil(t, a1)
 	// and vouchers not yet added to the account
 	balance1 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.NotEqual(t, sdk.Coins{sdk.NewInt64Coin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}, balance1)
+	assert.NotEqual(t, sdk.Coins{sdk.NewInt64Coin(types.GravityDenom(tokenETHAddr), 12)}, balance1)
 
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
@@ -245,7 +249,7 @@ func TestMsgSendToCosmosClaimsMultiValidator(t *testing.T) {
 	require.NotNil(t, a2)
 	// and vouchers now added to the account
 	balance2 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}, balance2)
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin(types.GravityDenom(tokenETHAddr), 12)}, balance2)
 
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
@@ -258,7 +262,72 @@ func TestMsgSendToCosmosClaimsMultiValidator(t *testing.T) {
 	require.NotNil(t, a3)
 	// and no additional added to the account
 	balance3 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}, balance3)
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin(types.GravityDenom(tokenETHAddr), 12)}, balance3)
+}
+
+func TestExtendedClaims(t *testing.T) {
+	var (
+		orchestratorAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orchestratorAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		orchestratorAddr3, _ = sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+		valAddr1             = sdk.ValAddress(orchestratorAddr1)
+		valAddr2             = sdk.ValAddress(orchestratorAddr2)
+		valAddr3             = sdk.ValAddress(orchestratorAddr3)
+		tokenETHAddr         = "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"
+		relayer              = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.StakingKeeper = keeper.NewStakingKeeperMock(valAddr1, valAddr2, valAddr3)
+	k.SetOrchestratorValidator(ctx, valAddr1, orchestratorAddr1)
+	k.SetOrchestratorValidator(ctx, valAddr2, orchestratorAddr2)
+	k.SetOrchestratorValidator(ctx, valAddr3, orchestratorAddr3)
+	h := NewHandler(k)
+
+	claim := func(nonce uint64, orchestrator sdk.AccAddress, relayer string) *types.MsgBatchSendToEthClaim {
+		return &types.MsgBatchSendToEthClaim{
+			EventNonce:    nonce,
+			BatchNonce:    nonce,
+			TokenContract: tokenETHAddr,
+			Orchestrator:  orchestrator.String(),
+			Relayer:       relayer,
+		}
+	}
+
+	// an upgraded orchestrator reporting the relayer votes along with one that doesn't
+	_, err := h(ctx, claim(1, orchestratorAddr1, relayer))
+	require.NoError(t, err)
+	_, err = h(ctx, claim(1, orchestratorAddr2, ""))
+	require.NoError(t, err)
+	att := k.GetAttestation(ctx, 1, claim(1, orchestratorAddr1, "").ClaimHash())
+	require.NotNil(t, att)
+	assert.Len(t, att.Votes, 2)
+	assert.Nil(t, k.GetAttestation(ctx, 1, claim(1, orchestratorAddr1, relayer).ClaimHash()))
+
+	// claims submitted together are reduced the same way, so their votes land on the same attestation
+	submitted, err := types.NewMsgSubmitClaims(orchestratorAddr1, []types.EthereumClaim{claim(2, orchestratorAddr1, relayer)})
+	require.NoError(t, err)
+	_, err = h(ctx, submitted)
+	require.NoError(t, err)
+	_, err = h(ctx, claim(2, orchestratorAddr2, ""))
+	require.NoError(t, err)
+	att = k.GetAttestation(ctx, 2, claim(2, orchestratorAddr1, "").ClaimHash())
+	require.NotNil(t, att)
+	assert.Len(t, att.Votes, 2)
+	assert.Nil(t, k.GetAttestation(ctx, 2, claim(2, orchestratorAddr1, relayer).ClaimHash()))
+
+	// once governance enabled extended claims the relayer is part of the claim
+	params := k.GetParams(ctx)
+	params.ExtendedClaims = true
+	k.SetParams(ctx, params)
+	_, err = h(ctx, claim(3, orchestratorAddr1, relayer))
+	require.NoError(t, err)
+	_, err = h(ctx, claim(3, orchestratorAddr2, relayer))
+	require.NoError(t, err)
+	att = k.GetAttestation(ctx, 3, claim(3, orchestratorAddr1, relayer).ClaimHash())
+	require.NotNil(t, att)
+	assert.Len(t, att.Votes, 2)
 }
 
 func TestMsgSetOrchestratorAddresses(t *testing.T) {
@@ -292,7 +361,7 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	// individual lookups
 	ethLookup, found := k.GetEthAddressByValidator(ctx, valAddress)
 	assert.True(t, found)
-	assert.Equal(t, ethLookup, ethAddress)
+	assert.Equal(t, ethLookup.GetAddress(), ethAddress)
 
 	valLookup, found := k.GetOrchestratorValidator(ctx, cosmosAddress)
 	assert.True(t, found)
@@ -318,3 +387,80 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	_, err = h(ctx, msg)
 	require.Error(t, err)
 }
+
+func TestMsgSubmitClaims(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(keeper.ValAddrs[0])
+	input.GravityKeeper.SetOrchestratorValidator(ctx, keeper.ValAddrs[0], keeper.AccAddrs[0])
+	msgServer := keeper.NewMsgServerImpl(input.GravityKeeper)
+
+	claim := func(nonce uint64) types.EthereumClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: keeper.AccAddrs[1].String(),
+			Orchestrator:   keeper.AccAddrs[0].String(),
+		}
+	}
+	// the third claim skips a nonce, it should fail without reverting the other two
+	msg, err := types.NewMsgSubmitClaims(keeper.AccAddrs[0], []types.EthereumClaim{claim(1), claim(2), claim(4)})
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+
+	res, err := msgServer.SubmitClaims(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 3)
+	assert.True(t, res.Results[0].Accepted)
+	assert.True(t, res.Results[1].Accepted)
+	assert.False(t, res.Results[2].Accepted)
+	assert.NotEmpty(t, res.Results[2].Error)
+
+	assert.Equal(t, uint64(2), input.GravityKeeper.GetLastEventNonceByValidator(ctx, keeper.ValAddrs[0]))
+	assert.Len(t, input.GravityKeeper.GetAttestationsByNonce(ctx, 2), 1)
+	assert.Empty(t, input.GravityKeeper.GetAttestationsByNonce(ctx, 4))
+
+	// claims made by someone else can't be submitted
+	other := claim(3).(*types.MsgSendToCosmosClaim)
+	other.Orchestrator = keeper.AccAddrs[1].String()
+	msg, err = types.NewMsgSubmitClaims(keeper.AccAddrs[0], []types.EthereumClaim{other})
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgSubmitConfirms(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	msgServer := keeper.NewMsgServerImpl(pk)
+
+	valset := pk.SetValsetRequest(ctx)
+	checkpoint := valset.GetCheckpoint(pk.GetGravityID(ctx))
+	privKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := ethCrypto.PubkeyToAddress(privKey.PublicKey).String()
+	pk.SetEthAddressForValidator(ctx, keeper.ValAddrs[0], types.MustNewEthAddress(ethAddr))
+	pk.SetOrchestratorValidator(ctx, keeper.ValAddrs[0], keeper.AccAddrs[0])
+	sig, err := types.NewEthereumSignature(checkpoint, privKey)
+	require.NoError(t, err)
+
+	good := *types.NewMsgValsetConfirm(valset.Nonce, ethAddr, keeper.AccAddrs[0], hex.EncodeToString(sig))
+	missing := good
+	missing.Nonce = valset.Nonce + 1
+	msg := &types.MsgSubmitConfirms{
+		ValsetConfirms: []types.MsgValsetConfirm{good, missing, good},
+		Orchestrator:   keeper.AccAddrs[0].String(),
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	res, err := msgServer.SubmitConfirms(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Len(t, res.ValsetResults, 3)
+	assert.True(t, res.ValsetResults[0].Accepted)
+	// there is no valset at this nonce
+	assert.False(t, res.ValsetResults[1].Accepted)
+	// duplicates are rejected
+	assert.False(t, res.ValsetResults[2].Accepted)
+	assert.NotNil(t, pk.GetValsetConfirm(ctx, valset.Nonce, keeper.AccAddrs[0]))
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// ValidateOrchestratorMsgs runs the stateful checks that decide if the orchestrator messages in a tx are worth including
+// in a block, without executing them. The orchestrator must belong to a bonded validator, claims must continue on from
+// the last event nonce the validator submitted and confirms must sign a valset, batch or logic call that exists with a
+// valid signature that has not already been submitted. Other messages are ignored, orchestratorOnly is true if every
+// message in msgs is an orchestrator message, whether or not they pass.
+//
+// The checks run on a cache of the state that each claim and confirm is applied to in turn, so a tx can't repeat a
+// confirm. In CheckTx the cache is written to the check state: the validator's last event nonce is advanced past the
+// claims and the confirms are stored, this lets an orchestrator have several claim txs in the mempool at once and
+// rejects a second tx with the same confirm. The check state is reset to the committed state every block.
+func (k Keeper) ValidateOrchestratorMsgs(ctx sdk.Context, msgs []sdk.Msg) (orchestratorOnly bool, err error) {
+	orchestratorOnly = len(msgs) > 0
+	for _, msg := range msgs {
+		orchestratorOnly = orchestratorOnly && isOrchestratorMsg(msg)
+	}
+
+	ctx, write := ctx.CacheContext()
+	ms := msgServer{Keeper: k}
+	// the next event nonce expected from each validator that has submitted claims in this tx
+	nextNonces := make(map[string]uint64)
+
+	validateClaims := func(orchestrator string, claims ...types.EthereumClaim) error {
+		if err := ms.checkOrchestratorValidatorInSet(ctx, orchestrator); err != nil {
+			return err
+		}
+		orchaddr, _ := sdk.AccAddressFromBech32(orchestrator)
+		validator, _ := k.GetOrchestratorValidator(ctx, orchaddr)
+		valAddr := validator.GetOperator()
+		next, found := nextNonces[valAddr.String()]
+		if !found {
+			next = ms.lastClaimableEventNonce(ctx, valAddr) + 1
+		}
+		for _, claim := range claims {
+			if claim.GetEventNonce() != next {
+				return sdkerrors.Wrapf(types.ErrNonContiguousEventNonce, "expected %d got %d", next, claim.GetEventNonce())
+			}
+			next++
+		}
+		nextNonces[valAddr.String()] = next
+		return nil
+	}
+	validateConfirm := func(orchestrator string, check func() error) error {
+		if err := ms.checkOrchestratorValidatorInSet(ctx, orchestrator); err != nil {
+			return err
+		}
+		return check()
+	}
+	validateValsetConfirm := func(msg *types.MsgValsetConfirm) error {
+		return validateConfirm(msg.Orchestrator, func() error {
+			if err := ms.checkValsetConfirm(ctx, msg); err != nil {
+				return err
+			}
+			k.SetValsetConfirm(ctx, *msg)
+			return nil
+		})
+	}
+	validateBatchConfirm := func(msg *types.MsgConfirmBatch) error {
+		return validateConfirm(msg.Orchestrator, func() error {
+			if err := ms.checkBatchConfirm(ctx, msg); err != nil {
+				return err
+			}
+			// SetBatchConfirm normalizes the token contract, the tx's own message is left as it is
+			confirm := *msg
+			k.SetBatchConfirm(ctx, &confirm)
+			return nil
+		})
+	}
+	validateLogicCallConfirm := func(msg *types.MsgConfirmLogicCall) error {
+		return validateConfirm(msg.Orchestrator, func() error {
+			if err := ms.checkLogicCallConfirm(ctx, msg); err != nil {
+				return err
+			}
+			k.SetLogicCallConfirm(ctx, msg)
+			return nil
+		})
+	}
+
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case types.EthereumClaim:
+			err = validateClaims(msg.GetClaimer().String(), msg)
+		case *types.MsgSubmitClaims:
+			var claims []types.EthereumClaim
+			if claims, err = msg.UnpackClaims(); err == nil {
+				err = validateClaims(msg.Orchestrator, claims...)
+			}
+		case *types.MsgValsetConfirm:
+			err = validateValsetConfirm(msg)
+		case *types.MsgConfirmBatch:
+			err = validateBatchConfirm(msg)
+		case *types.MsgConfirmLogicCall:
+			err = validateLogicCallConfirm(msg)
+		case *types.MsgSubmitConfirms:
+			for i := 0; err == nil && i < len(msg.ValsetConfirms); i++ {
+				err = validateValsetConfirm(&msg.ValsetConfirms[i])
+			}
+			for i := 0; err == nil && i < len(msg.BatchConfirms); i++ {
+				err = validateBatchConfirm(&msg.BatchConfirms[i])
+			}
+			for i := 0; err == nil && i < len(msg.LogicCallConfirms); i++ {
+				err = validateLogicCallConfirm(&msg.LogicCallConfirms[i])
+			}
+		}
+		if err != nil {
+			return orchestratorOnly, err
+		}
+	}
+
+	if ctx.IsCheckTx() {
+		for val, next := range nextNonces {
+			valAddr, _ := sdk.ValAddressFromBech32(val)
+			k.setLastEventNonceByValidator(ctx, valAddr, next-1)
+		}
+		write()
+	}
+	return orchestratorOnly, nil
+}
+
+// isOrchestratorMsg returns true for the claims and confirms that make up an orchestrator's bridge duties
+func isOrchestratorMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case types.EthereumClaim, *types.MsgSubmitClaims, *types.MsgValsetConfirm, *types.MsgConfirmBatch,
+		*types.MsgConfirmLogicCall, *types.MsgSubmitConfirms:
+		return true
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -35,17 +36,21 @@ func (k Keeper) Attest(
 	// Tries to get an attestation with the same eventNonce and claim as the claim that was submitted.
 	att := k.GetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash())
 
-	// If it does not exist, create a new one.
+	// If it does not exist, create a new one. The total power is snapshotted here, at the first
+	// vote, so that the threshold this attestation is judged against can't rise while it's pending
 	if att == nil {
 		att = &types.Attestation{
 			Observed: false,
 			Height:   uint64(ctx.BlockHeight()),
 			Claim:    anyClaim,
+			Tally:    types.NewAttestationTally(k.StakingKeeper.GetLastTotalPower(ctx)),
 		}
+	} else if !att.HasTally() {
+		k.snapshotAttestationTally(ctx, att)
 	}
 
-	// Add the validator's vote to this attestation
-	att.Votes = append(att.Votes, valAddr.String())
+	// Add the validator's vote to this attestation, along with the power it holds right now
+	att.AddVote(valAddr, k.StakingKeeper.GetLastValidatorPower(ctx, valAddr))
 
 	k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
 	k.setLastEventNonceByValidator(ctx, valAddr, claim.GetEventNonce())
@@ -61,42 +66,35 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	if err != nil {
 		panic("could not cast to claim")
 	}
-	// If the attestation has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
+	// If the attestation has not yet been Observed, check the tally and see if it is ready to apply to the state.
 	// This conditional stops the attestation from accidentally being applied twice.
 	if !att.Observed {
-		// Sum the current powers of all validators who have voted and see if it passes the current threshold
-		// TODO: The different integer types and math here needs a careful review
-		totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
-		requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
-		attestationPower := sdk.NewInt(0)
-		for _, validator := range att.Votes {
-			val, err := sdk.ValAddressFromBech32(validator)
-			if err != nil {
-				panic(err)
-			}
-			validatorPower := k.StakingKeeper.GetLastValidatorPower(ctx, val)
-			// Add it to the attestation power's sum
-			attestationPower = attestationPower.Add(sdk.NewInt(validatorPower))
-			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
-			// process the attestation, set Observed to true, and break
-			if attestationPower.GTE(requiredPower) {
-				lastEventNonce := k.GetLastObservedEventNonce(ctx)
-				// this check is performed at the next level up so this should never panic
-				// outside of programmer error.
-				if claim.GetEventNonce() != lastEventNonce+1 {
-					panic("attempting to apply events to state out of order")
-				}
-				k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
-
-				att.Observed = true
-				k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
-
-				k.processAttestation(ctx, att, claim)
-				k.emitObservedEvent(ctx, att, claim)
-
-				break
+		if !att.HasTally() {
+			k.snapshotAttestationTally(ctx, att)
+			k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
+		} else if att.CapTotalPower(k.StakingKeeper.GetLastTotalPower(ctx)) {
+			// the threshold follows the bonded power down, never up, so it stays reachable
+			k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
+		}
+		// If the power of all the validators that have voted on the attestation, as of when they voted,
+		// is higher or equal to the threshold, process the attestation and set Observed to true
+		if att.PassedThreshold() {
+			lastEventNonce := k.GetLastObservedEventNonce(ctx)
+			// this check is performed at the next level up so this should never panic
+			// outside of programmer error.
+			if claim.GetEventNonce() != lastEventNonce+1 {
+				panic("attempting to apply events to state out of order")
 			}
+			k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
+			k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
+
+			att.Observed = true
+			att.ObservedHeight = uint64(ctx.BlockHeight())
+			k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
+
+			k.processAttestation(ctx, att, claim)
+			k.emitObservedEvent(ctx, att, claim)
+			k.recordClaimVotes(ctx, att)
 		}
 	} else {
 		// We panic here because this should never happen
@@ -104,6 +102,35 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	}
 }
 
+// recordClaimVotes records for every bonded validator whether it voted for an attestation by the
+// time it was observed
+func (k Keeper) recordClaimVotes(ctx sdk.Context, att *types.Attestation) {
+	voted := make(map[string]bool, len(att.Votes))
+	for _, validator := range att.Votes {
+		voted[validator] = true
+	}
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		k.RecordClaim(ctx, val.GetOperator(), !voted[val.GetOperator().String()])
+	}
+}
+
+// snapshotAttestationTally fills in the tally of an attestation that was stored before votes carried
+// their power, for example one imported from an older genesis file. There is no record of the powers
+// at the time of voting, so the current powers are snapshotted and used from here on
+func (k Keeper) snapshotAttestationTally(ctx sdk.Context, att *types.Attestation) {
+	votes := att.Votes
+	att.Votes = nil
+	att.VotePowers = nil
+	att.Tally = types.NewAttestationTally(k.StakingKeeper.GetLastTotalPower(ctx))
+	for _, validator := range votes {
+		val, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			panic(err)
+		}
+		att.AddVote(val, k.StakingKeeper.GetLastValidatorPower(ctx, val))
+	}
+}
+
 // processAttestation actually applies the attestation to the consensus state
 func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
 	// then execute in a new Tx so that we can store state on failure
@@ -120,6 +147,8 @@ func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, clai
 		)
 	} else {
 		commit() // persist transient storage
+		// the cache context collects its own events, pass them on with the state they describe
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
 	}
 }
 
@@ -207,6 +236,33 @@ func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attest
 	}
 }
 
+// GetAttestationsByNonce returns all the attestations at a given event nonce, there is more than
+// one when validators disagree about what event happened at that nonce
+func (k Keeper) GetAttestationsByNonce(ctx sdk.Context, eventNonce uint64) (out []types.Attestation) {
+	k.IterateAttestationsByNonceRange(ctx, eventNonce, eventNonce+1, func(_ []byte, att types.Attestation) bool {
+		out = append(out, att)
+		return false
+	})
+	return
+}
+
+// IterateAttestationsByNonceRange iterates through the attestations with an event nonce from start up to,
+// but not including, end in ASC order. Attestations are keyed by event nonce so only that range is read
+func (k Keeper) IterateAttestationsByNonceRange(ctx sdk.Context, start uint64, end uint64, cb func([]byte, types.Attestation) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OracleAttestationKey)
+	iter := prefixStore.Iterator(types.UInt64Bytes(start), types.UInt64Bytes(end))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		att := types.Attestation{}
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		// cb returns true to stop early
+		if cb(iter.Key(), att) {
+			return
+		}
+	}
+}
+
 // GetLastObservedEventNonce returns the latest observed event nonce
 func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
@@ -237,11 +293,15 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastOb
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store.
 func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
-	height := types.LastObservedEthereumBlockHeight{
+	k.setLastObservedEthereumBlockHeight(ctx, types.LastObservedEthereumBlockHeight{
 		EthereumBlockHeight: ethereumHeight,
 		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
-	}
+	})
+}
+
+// setLastObservedEthereumBlockHeight sets both block heights as given, used when importing genesis
+func (k Keeper) setLastObservedEthereumBlockHeight(ctx sdk.Context, height types.LastObservedEthereumBlockHeight) {
+	store := ctx.KVStore(k.storeKey)
 	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshalBinaryBare(&height))
 }
 
@@ -261,10 +321,12 @@ func (k Keeper) GetLastObservedValset(ctx sdk.Context) *types.Valset {
 	return &valset
 }
 
-// SetLastObservedValset updates the last observed validator set in the store
+// SetLastObservedValset updates the last observed validator set in the store and releases the reward
+// escrows of the valsets it superseded
 func (k Keeper) SetLastObservedValset(ctx sdk.Context, valset types.Valset) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.LastObservedValsetKey, k.cdc.MustMarshalBinaryBare(&valset))
+	k.releaseValsetRewardEscrows(ctx, valset.Nonce)
 }
 
 // setLastObservedEventNonce sets the latest observed event nonce
@@ -293,8 +355,35 @@ func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 	return types.UInt64FromBytes(bytes)
 }
 
+// IsPrunedEventNonce returns true if the event at this nonce has been observed and every attestation
+// for it has been pruned, claims for such an event can never be observed
+func (k Keeper) IsPrunedEventNonce(ctx sdk.Context, eventNonce uint64) bool {
+	if eventNonce >= k.GetLastObservedEventNonce(ctx) {
+		return false
+	}
+	pruned := true
+	k.IterateAttestationsByNonceRange(ctx, eventNonce, eventNonce+1, func(_ []byte, _ types.Attestation) bool {
+		pruned = false
+		return true
+	})
+	return pruned
+}
+
 // setLastEventNonceByValidator sets the latest event nonce for a give validator
 func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetLastEventNonceByValidatorKey(validator), types.UInt64Bytes(nonce))
 }
+
+// IterateLastEventNonceByValidator iterates over the last event nonce of every validator that has submitted a claim
+func (k Keeper) IterateLastEventNonceByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, nonce uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastEventNonceByValidatorKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(sdk.ValAddress(iter.Key()), types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
@@ -20,42 +20,40 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 	switch claim := claim.(type) {
 	// deposit in this context means a deposit into the Ethereum side of the bridge
 	case *types.MsgSendToCosmosClaim:
-		// Check if coin is Cosmos-originated asset and get denom
-		isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, claim.TokenContract)
-
-		if isCosmosOriginated {
-			// If it is cosmos originated, unlock the coins
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
-			}
-
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
-		} else {
-			// If it is not cosmos originated, mint the coins (aka vouchers)
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
-			}
+		addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
+		if err != nil {
+			return sdkerrors.Wrap(err, "invalid receiver address")
+		}
 
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid receiver address")
+		// Check if coin is Cosmos-originated asset
+		if isCosmosOriginated, _ := a.keeper.ERC20ToDenomLookup(ctx, claim.TokenContract); !isCosmosOriginated {
+			// deposits of tokens the token policy does not allow are held for the receiver to reclaim
+			if !a.keeper.IsTokenAllowed(ctx, claim.TokenContract) {
+				a.keeper.HoldDeposit(ctx, claim)
+				return nil
 			}
+			a.keeper.setDepositedTokenMetadata(ctx, claim)
+		}
 
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
+		// If it is cosmos originated, unlock the coins, if not mint the coins (aka vouchers). Either way
+		// the ERC20 amount is scaled to the decimals of the Cosmos denom
+		if err := a.keeper.creditDeposit(ctx, claim.TokenContract, claim.Amount, addr); err != nil {
+			return err
 		}
 	// withdraw in this context means a withdraw from the Ethereum side of the bridge
 	case *types.MsgBatchSendToEthClaim:
+		// the fees are recorded from the batch before executing it removes it from the store
+		if batch := a.keeper.GetOutgoingTXBatch(ctx, claim.TokenContract, claim.BatchNonce); batch != nil {
+			a.keeper.RecordRelayedBatch(ctx, claim.Relayer, batch)
+		}
 		a.keeper.OutgoingTxBatchExecuted(ctx, claim.TokenContract, claim.BatchNonce)
 		return nil
+	case *types.MsgLogicCallExecutedClaim:
+		// a logic call that timed out or was canceled in the meantime has nothing left to clean up
+		if call := a.keeper.GetOutgoingLogicCall(ctx, claim.InvalidationId, claim.InvalidationNonce); call != nil {
+			a.keeper.RecordRelayedLogicCall(ctx, claim.Relayer, call)
+			a.keeper.OutgoingLogicCallExecuted(ctx, claim.InvalidationId, claim.InvalidationNonce)
+		}
 	case *types.MsgERC20DeployedClaim:
 		// Check if it already exists
 		existingERC20, exists := a.keeper.GetCosmosOriginatedERC20(ctx, claim.CosmosDenom)
@@ -65,6 +63,19 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 				fmt.Sprintf("ERC20 %s already exists for denom %s", existingERC20, claim.CosmosDenom))
 		}
 
+		// Reject deployments governance did not approve, so that nobody can front-run the canonical ERC20
+		// of a denom with a look-alike. The attestation is still handled, the event tells why nothing changed
+		if err := a.keeper.checkERC20DeploymentApproved(ctx, claim); err != nil {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeERC20DeploymentRejected,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyDenom, claim.CosmosDenom),
+				sdk.NewAttribute(types.AttributeKeyERC20, claim.TokenContract),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+			return nil
+		}
+
 		// Check if denom exists
 		metadata := a.keeper.bankKeeper.GetDenomMetaData(ctx, claim.CosmosDenom)
 		if metadata.Base == "" {
@@ -105,14 +116,21 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 			}
 		}
 
-		if decimals != uint32(claim.Decimals) {
-			return sdkerrors.Wrap(
+		// An ERC20 with other decimals than the denom is only bridged with a decimal scaling between the
+		// two that governance approved for the denom
+		scaling := types.TokenScaling{TokenContract: claim.TokenContract, Exponent: int64(claim.Decimals) - int64(decimals)}
+		if approved := a.keeper.approvedScalingExponent(ctx, claim.CosmosDenom); scaling.Exponent != approved {
+			return sdkerrors.Wrapf(
 				types.ErrInvalid,
-				fmt.Sprintf("ERC20 decimals %d does not match denom decimals %d", claim.Decimals, decimals))
+				"ERC20 decimals %d does not match denom decimals %d with the approved exponent %d", claim.Decimals, decimals, approved)
 		}
 
 		// Add to denom-erc20 mapping
 		a.keeper.setCosmosOriginatedDenomToERC20(ctx, claim.CosmosDenom, claim.TokenContract)
+		a.keeper.setTokenScaling(ctx, scaling)
+		if scaling.Exponent != 0 {
+			a.keeper.emitTokenScalingSet(ctx, scaling)
+		}
 	case *types.MsgValsetUpdatedClaim:
 		// TODO here we should check the contents of the validator set against
 		// the store, if they differ we should take some action to indicate to the
@@ -123,6 +141,7 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 			RewardAmount: claim.RewardAmount,
 			RewardToken:  claim.RewardToken,
 		})
+		a.keeper.RecordRelayedValset(ctx, claim.Relayer, types.ERC20Token{Contract: claim.RewardToken, Amount: claim.RewardAmount})
 		// if the reward is greater than zero and the reward token
 		// is valid then some reward was issued by this validator set
 		// and we need to either add to the total tokens for a Cosmos native
@@ -130,6 +149,8 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 		if claim.RewardAmount.GT(sdk.ZeroInt()) && claim.RewardToken != "0x0000000000000000000000000000000000000000" {
 			// Check if coin is Cosmos-originated asset and get denom
 			isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, claim.RewardToken)
+			// rewards are created as whole Cosmos units, see RewardToERC20Lookup
+			rewardAmount, _ := a.keeper.GetTokenScaling(ctx, claim.RewardToken).FromERC20(claim.RewardAmount)
 			if isCosmosOriginated {
 				// If it is cosmos originated, mint some coins to account
 				// for coins that now exist on Ethereum and may eventually come
@@ -145,18 +166,13 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 				//
 				// Note we are minting based on the claim! This is important as the reward value
 				// could change between when this event occurred and the present
-				coins := sdk.Coins{sdk.NewCoin(denom, claim.RewardAmount)}
+				coins := sdk.Coins{sdk.NewCoin(denom, rewardAmount)}
 				a.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 			} else {
-				// // If it is not cosmos originated, burn the coins (aka Vouchers)
-				// // so that we don't think we have more in the bridge than we actually do
-				// coins := sdk.Coins{sdk.NewCoin(denom, claim.RewardAmount)}
-				// a.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
-
-				// if you want to issue Ethereum originated tokens remove this panic and uncomment
-				// the above code but note that you will have to constantly replenish the tokens in the
-				// module or your chain will eventually halt.
-				panic("Can not use Ethereum originated token as reward!")
+				// If it is not cosmos originated, burn the coins (aka Vouchers) from the valset reward pool
+				// so that we don't think we have more in the bridge than we actually do. Valsets are only
+				// created with an Ethereum originated reward while the pool can pay it.
+				a.keeper.payEthereumOriginatedValsetReward(ctx, denom, rewardAmount)
 			}
 		}
 
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Tests that votes are tallied with the power the validator had when it voted
+func TestAttestationVotePowerSnapshot(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	mock := NewStakingKeeperMock(ValAddrs[0], ValAddrs[1], ValAddrs[2])
+	k.StakingKeeper = mock
+	for i := range []int{0, 1, 2} {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+
+	claim := func(orch sdk.AccAddress) (*types.MsgSendToCosmosClaim, *codectypes.Any) {
+		msg := &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[4].String(),
+			Orchestrator:   orch.String(),
+		}
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		return msg, any
+	}
+
+	msg, any := claim(AccAddrs[0])
+	_, err := k.Attest(ctx, msg, any)
+	require.NoError(t, err)
+	msg, any = claim(AccAddrs[1])
+	att, err := k.Attest(ctx, msg, any)
+	require.NoError(t, err)
+
+	// the total power is fixed by the first vote
+	assert.Equal(t, sdk.NewInt(300), att.Tally.TotalPower)
+	assert.Equal(t, sdk.NewInt(198), att.Tally.RequiredPower)
+	assert.Equal(t, sdk.NewInt(200), att.Tally.VotesPower)
+	assert.Equal(t, []int64{100, 100}, att.VotePowers)
+
+	// the second voter unbonds and the third gains power before the tally, neither
+	// should change the outcome
+	mock.ValidatorPower[ValAddrs[1].String()] = 0
+	mock.ValidatorPower[ValAddrs[2].String()] = 1000
+
+	k.TryAttestation(ctx, att)
+	require.True(t, att.Observed)
+	assert.Equal(t, uint64(1), k.GetLastObservedEventNonce(ctx))
+
+	stored := k.GetAttestationsByNonce(ctx, 1)
+	require.Len(t, stored, 1)
+	assert.True(t, stored[0].Observed)
+	assert.Equal(t, sdk.NewInt(200), stored[0].Tally.VotesPower)
+}
+
+// Tests that an attestation can still pass after the bonded power dropped below its snapshot
+func TestAttestationTotalPowerDrop(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	mock := NewStakingKeeperMock(ValAddrs[0], ValAddrs[1], ValAddrs[2])
+	k.StakingKeeper = mock
+	for i := range []int{0, 1, 2} {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+	claim := func(orch sdk.AccAddress) (*types.MsgSendToCosmosClaim, *codectypes.Any) {
+		msg := &types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[4].String(),
+			Orchestrator:   orch.String(),
+		}
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		return msg, any
+	}
+
+	msg, any := claim(AccAddrs[0])
+	att, err := k.Attest(ctx, msg, any)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(198), att.Tally.RequiredPower)
+
+	// two of the three validators unbond, the one that is left can never reach the snapshot threshold
+	// alone, so the threshold follows the bonded power down
+	mock.ValidatorPower[ValAddrs[1].String()] = 0
+	mock.ValidatorPower[ValAddrs[2].String()] = 0
+	k.TryAttestation(ctx, att)
+	require.True(t, att.Observed)
+	assert.Equal(t, sdk.NewInt(100), att.Tally.TotalPower)
+	assert.Equal(t, sdk.NewInt(66), att.Tally.RequiredPower)
+	assert.Equal(t, sdk.NewInt(100), att.Tally.VotesPower)
+
+	// the threshold never rises above the snapshot
+	msg, _ = claim(AccAddrs[0])
+	msg.EventNonce = 2
+	any, err = codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	mock.ValidatorPower[ValAddrs[1].String()] = 100
+	mock.ValidatorPower[ValAddrs[2].String()] = 100
+	att, err = k.Attest(ctx, msg, any)
+	require.NoError(t, err)
+	mock.ValidatorPower[ValAddrs[2].String()] = 1000
+	k.TryAttestation(ctx, att)
+	require.False(t, att.Observed)
+	assert.Equal(t, sdk.NewInt(300), att.Tally.TotalPower)
+}
+
+// Tests that attestations stored before votes carried their power are tallied
+func TestAttestationWithoutTally(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.StakingKeeper = NewStakingKeeperMock(ValAddrs[0], ValAddrs[1], ValAddrs[2])
+
+	msg := &types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[4].String(),
+		Orchestrator:   AccAddrs[0].String(),
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	att := &types.Attestation{
+		Votes: []string{ValAddrs[0].String()},
+		Claim: any,
+	}
+	k.SetAttestation(ctx, msg.EventNonce, msg.ClaimHash(), att)
+
+	k.TryAttestation(ctx, att)
+	require.False(t, att.Observed)
+
+	stored := k.GetAttestation(ctx, msg.EventNonce, msg.ClaimHash())
+	require.NotNil(t, stored)
+	assert.True(t, stored.HasTally())
+	assert.Equal(t, sdk.NewInt(100), stored.Tally.VotesPower)
+	assert.Equal(t, sdk.NewInt(300), stored.Tally.TotalPower)
+}
+
+// Tests that claims for events which were observed and pruned are recognized and that
+// validators which fell behind them skip ahead
+func TestPrunedEventNonce(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	k.setLastObservedEventNonce(ctx, 5)
+	att := &types.Attestation{Observed: true, Votes: []string{ValAddrs[0].String()}}
+	k.SetAttestation(ctx, 3, []byte("claim hash"), att)
+
+	assert.True(t, k.IsPrunedEventNonce(ctx, 2))
+	assert.False(t, k.IsPrunedEventNonce(ctx, 3))
+	assert.True(t, k.IsPrunedEventNonce(ctx, 4))
+	// the last observed nonce and anything above it are never considered pruned
+	assert.False(t, k.IsPrunedEventNonce(ctx, 5))
+	assert.False(t, k.IsPrunedEventNonce(ctx, 6))
+
+	ms := msgServer{Keeper: k}
+	// the next event for this validator is still retained
+	k.setLastEventNonceByValidator(ctx, ValAddrs[0], 2)
+	assert.Equal(t, uint64(2), ms.lastClaimableEventNonce(ctx, ValAddrs[0]))
+	// the next event for this validator has been pruned, it continues from the last observed event
+	// while the stored nonce is reported as it is
+	k.setLastEventNonceByValidator(ctx, ValAddrs[1], 3)
+	assert.Equal(t, uint64(3), k.GetLastEventNonceByValidator(ctx, ValAddrs[1]))
+	assert.Equal(t, uint64(4), ms.lastClaimableEventNonce(ctx, ValAddrs[1]))
+
+	k.SetOrchestratorValidator(ctx, ValAddrs[1], AccAddrs[1])
+	claim := &types.MsgSendToCosmosClaim{
+		EventNonce:     5,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[4].String(),
+		Orchestrator:   AccAddrs[1].String(),
+	}
+	require.NoError(t, ms.claimHandlerCommon(ctx, claim))
+	assert.Equal(t, uint64(5), k.GetLastEventNonceByValidator(ctx, ValAddrs[1]))
+}
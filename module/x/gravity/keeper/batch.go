@@ -27,6 +27,11 @@ func (k Keeper) BuildOutgoingTXBatch(
 	if maxElements == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "max elements value")
 	}
+	contract, err := types.NewEthAddress(contractAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "token contract")
+	}
+	contractAddress = contract.GetAddress()
 
 	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, contractAddress)
 
@@ -60,8 +65,13 @@ func (k Keeper) BuildOutgoingTXBatch(
 	k.StoreBatch(ctx, batch)
 
 	// Get the checkpoint and store it as a legit past batch
-	checkpoint := batch.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	k.SetPastEthSignatureCheckpointInfo(ctx, types.PastEthSignatureCheckpoint{
+		Checkpoint:    batch.GetCheckpoint(k.GetGravityID(ctx)),
+		Type:          types.CHECKPOINT_TYPE_BATCH,
+		Nonce:         batch.BatchNonce,
+		TokenContract: batch.TokenContract,
+		Height:        uint64(ctx.BlockHeight()),
+	})
 
 	batchEvent := sdk.NewEvent(
 		types.EventTypeOutgoingBatch,
@@ -112,8 +122,8 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract string, n
 	// Iterate through remaining batches
 	k.IterateOutgoingTXBatches(ctx, func(key []byte, iter_batch *types.OutgoingTxBatch) bool {
 		// If the iterated batches nonce is lower than the one that was just executed, cancel it
-		if iter_batch.BatchNonce < b.BatchNonce && iter_batch.TokenContract == tokenContract {
-			err := k.CancelOutgoingTXBatch(ctx, tokenContract, iter_batch.BatchNonce)
+		if iter_batch.BatchNonce < b.BatchNonce && iter_batch.TokenContract == b.TokenContract {
+			err := k.CancelOutgoingTXBatch(ctx, b.TokenContract, iter_batch.BatchNonce)
 			if err != nil {
 				panic(fmt.Sprintf("Failed cancel out batch %s %d while trying to execute %s %d with %s", tokenContract, iter_batch.BatchNonce, tokenContract, nonce, err))
 			}
@@ -131,7 +141,9 @@ func (k Keeper) StoreBatch(ctx sdk.Context, batch *types.OutgoingTxBatch) {
 	store := ctx.KVStore(k.storeKey)
 	// set the current block height when storing the batch
 	batch.Block = uint64(ctx.BlockHeight())
-	key := types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce)
+	contract := types.MustNewEthAddress(batch.TokenContract)
+	batch.TokenContract = contract.GetAddress()
+	key := types.GetOutgoingTxBatchKey(contract, batch.BatchNonce)
 	store.Set(key, k.cdc.MustMarshalBinaryBare(batch))
 
 	blockKey := types.GetOutgoingTxBatchBlockKey(batch.Block)
@@ -141,7 +153,9 @@ func (k Keeper) StoreBatch(ctx sdk.Context, batch *types.OutgoingTxBatch) {
 // StoreBatchUnsafe stores a transaction batch w/o setting the height
 func (k Keeper) StoreBatchUnsafe(ctx sdk.Context, batch *types.OutgoingTxBatch) {
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce)
+	contract := types.MustNewEthAddress(batch.TokenContract)
+	batch.TokenContract = contract.GetAddress()
+	key := types.GetOutgoingTxBatchKey(contract, batch.BatchNonce)
 	store.Set(key, k.cdc.MustMarshalBinaryBare(batch))
 
 	blockKey := types.GetOutgoingTxBatchBlockKey(batch.Block)
@@ -151,8 +165,19 @@ func (k Keeper) StoreBatchUnsafe(ctx sdk.Context, batch *types.OutgoingTxBatch)
 // DeleteBatch deletes an outgoing transaction batch
 func (k Keeper) DeleteBatch(ctx sdk.Context, batch types.OutgoingTxBatch) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
+	store.Delete(types.GetOutgoingTxBatchKey(types.MustNewEthAddress(batch.TokenContract), batch.BatchNonce))
 	store.Delete(types.GetOutgoingTxBatchBlockKey(batch.Block))
+
+	// confirms are only used while the batch is stored, they'd never be read again
+	var confirmKeys [][]byte
+	k.IterateBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract, func(key []byte, _ types.MsgConfirmBatch) bool {
+		confirmKeys = append(confirmKeys, key)
+		return false
+	})
+	confirmStore := prefix.NewStore(store, types.BatchConfirmKey)
+	for _, key := range confirmKeys {
+		confirmStore.Delete(key)
+	}
 }
 
 // pickUnbatchedTX find TX in pool and remove from "available" second index
@@ -176,8 +201,12 @@ func (k Keeper) pickUnbatchedTX(
 
 // GetOutgoingTXBatch loads a batch object. Returns nil when not exists.
 func (k Keeper) GetOutgoingTXBatch(ctx sdk.Context, tokenContract string, nonce uint64) *types.OutgoingTxBatch {
+	contract, err := types.NewEthAddress(tokenContract)
+	if err != nil {
+		return nil
+	}
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetOutgoingTxBatchKey(tokenContract, nonce)
+	key := types.GetOutgoingTxBatchKey(*contract, nonce)
 	bz := store.Get(key)
 	if len(bz) == 0 {
 		return nil
@@ -185,8 +214,8 @@ func (k Keeper) GetOutgoingTXBatch(ctx sdk.Context, tokenContract string, nonce
 	var b types.OutgoingTxBatch
 	k.cdc.MustUnmarshalBinaryBare(bz, &b)
 	for _, tx := range b.Transactions {
-		tx.Erc20Token.Contract = tokenContract
-		tx.Erc20Fee.Contract = tokenContract
+		tx.Erc20Token.Contract = contract.GetAddress()
+		tx.Erc20Fee.Contract = contract.GetAddress()
 	}
 	return &b
 }
@@ -198,8 +227,8 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract string, non
 		return types.ErrUnknown
 	}
 	for _, tx := range batch.Transactions {
-		tx.Erc20Fee.Contract = tokenContract
-		k.prependToUnbatchedTXIndex(ctx, tokenContract, *tx.Erc20Fee, tx.Id)
+		tx.Erc20Fee.Contract = batch.TokenContract
+		k.prependToUnbatchedTXIndex(ctx, batch.TokenContract, *tx.Erc20Fee, tx.Id)
 	}
 
 	// Delete batch since it is finished
@@ -243,6 +272,11 @@ func (k Keeper) GetOutgoingTxBatches(ctx sdk.Context) (out []*types.OutgoingTxBa
 
 // GetLastOutgoingBatchByTokenType gets the latest outgoing tx batch by token type
 func (k Keeper) GetLastOutgoingBatchByTokenType(ctx sdk.Context, token string) *types.OutgoingTxBatch {
+	contract, err := types.NewEthAddress(token)
+	if err != nil {
+		return nil
+	}
+	token = contract.GetAddress()
 	batches := k.GetOutgoingTxBatches(ctx)
 	var lastBatch *types.OutgoingTxBatch = nil
 	lastNonce := uint64(0)
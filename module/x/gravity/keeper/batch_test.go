@@ -21,11 +21,12 @@ func TestBatches(t *testing.T) {
 		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
 		allVouchers         = sdk.NewCoins(
-			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+			sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(99999)),
 		)
 	)
 
 	// mint some voucher first
+	input.GravityKeeper.RegisterDenomTrace(ctx, myTokenContractAddr)
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
@@ -36,8 +37,8 @@ func TestBatches(t *testing.T) {
 
 	// add some TX to the pool
 	for i, v := range []uint64{2, 3, 2, 1} {
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(v))
 		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 	}
@@ -106,8 +107,8 @@ func TestBatches(t *testing.T) {
 	// add some more TX to the pool to create a more profitable batch
 	for i, v := range []uint64{4, 5} {
 
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(v))
 		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 	}
@@ -205,11 +206,12 @@ func TestBatchesFullCoins(t *testing.T) {
 		totalCoins, _       = sdk.NewIntFromString("1500000000000000000000") // 1,500 ETH worth
 		oneEth, _           = sdk.NewIntFromString("1000000000000000000")
 		allVouchers         = sdk.NewCoins(
-			types.NewSDKIntERC20Token(totalCoins, myTokenContractAddr).GravityCoin(),
+			sdk.NewCoin(types.GravityDenom(myTokenContractAddr), totalCoins),
 		)
 	)
 
 	// mint some voucher first
+	input.GravityKeeper.RegisterDenomTrace(ctx, myTokenContractAddr)
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
@@ -221,8 +223,8 @@ func TestBatchesFullCoins(t *testing.T) {
 	// add some TX to the pool
 	for _, v := range []uint64{20, 300, 25, 10} {
 		vAsSDKInt := sdk.NewIntFromUint64(v)
-		amount := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
-		fee := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), oneEth.Mul(vAsSDKInt))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), oneEth.Mul(vAsSDKInt))
 		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 	}
@@ -243,17 +245,17 @@ func TestBatchesFullCoins(t *testing.T) {
 		Transactions: []*types.OutgoingTransferTx{
 			{
 				Id:          2,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(300)), myTokenContractAddr),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(300)), myTokenContractAddr),
 			},
 			{
 				Id:          3,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(25)), myTokenContractAddr),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(25)), myTokenContractAddr),
 			},
 		},
 		TokenContract: myTokenContractAddr,
@@ -270,17 +272,17 @@ func TestBatchesFullCoins(t *testing.T) {
 	expUnbatchedTx := []*types.OutgoingTransferTx{
 		{
 			Id:          1,
-			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(20)), myTokenContractAddr),
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
-			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(20)), myTokenContractAddr),
 		},
 		{
 			Id:          4,
-			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(10)), myTokenContractAddr),
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
-			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(10)), myTokenContractAddr),
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -291,8 +293,8 @@ func TestBatchesFullCoins(t *testing.T) {
 	// add some more TX to the pool to create a more profitable batch
 	for _, v := range []uint64{4, 5} {
 		vAsSDKInt := sdk.NewIntFromUint64(v)
-		amount := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
-		fee := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), oneEth.Mul(vAsSDKInt))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), oneEth.Mul(vAsSDKInt))
 		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 	}
@@ -309,17 +311,17 @@ func TestBatchesFullCoins(t *testing.T) {
 		Transactions: []*types.OutgoingTransferTx{
 			{
 				Id:          1,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(20)), myTokenContractAddr),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(20)), myTokenContractAddr),
 			},
 			{
 				Id:          4,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(10)), myTokenContractAddr),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(10)), myTokenContractAddr),
 			},
 		},
 		TokenContract: myTokenContractAddr,
@@ -347,31 +349,31 @@ func TestBatchesFullCoins(t *testing.T) {
 	expUnbatchedTx = []*types.OutgoingTransferTx{
 		{
 			Id:          2,
-			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(300)), myTokenContractAddr),
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
-			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(300)), myTokenContractAddr),
 		},
 		{
 			Id:          3,
-			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(25)), myTokenContractAddr),
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
-			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(25)), myTokenContractAddr),
 		},
 		{
 			Id:          6,
-			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(5)), myTokenContractAddr),
+			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(5)), myTokenContractAddr),
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
-			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(5)), myTokenContractAddr),
+			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(5)), myTokenContractAddr),
 		},
 		{
 			Id:          5,
-			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(4)), myTokenContractAddr),
+			Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(4)), myTokenContractAddr),
 			Sender:      mySender.String(),
 			DestAddress: myReceiver,
-			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(4)), myTokenContractAddr),
+			Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewInt(4)), myTokenContractAddr),
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -393,14 +395,17 @@ func TestManyBatches(t *testing.T) {
 		totalCoins, _      = sdk.NewIntFromString("1500000000000000000000000")
 		oneEth, _          = sdk.NewIntFromString("1000000000000000000")
 		allVouchers        = sdk.NewCoins(
-			types.NewSDKIntERC20Token(totalCoins, tokenContractAddr1).GravityCoin(),
-			types.NewSDKIntERC20Token(totalCoins, tokenContractAddr2).GravityCoin(),
-			types.NewSDKIntERC20Token(totalCoins, tokenContractAddr3).GravityCoin(),
-			types.NewSDKIntERC20Token(totalCoins, tokenContractAddr4).GravityCoin(),
+			sdk.NewCoin(types.GravityDenom(tokenContractAddr1), totalCoins),
+			sdk.NewCoin(types.GravityDenom(tokenContractAddr2), totalCoins),
+			sdk.NewCoin(types.GravityDenom(tokenContractAddr3), totalCoins),
+			sdk.NewCoin(types.GravityDenom(tokenContractAddr4), totalCoins),
 		)
 	)
 
 	// mint vouchers first
+	for _, contract := range []string{tokenContractAddr1, tokenContractAddr2, tokenContractAddr3, tokenContractAddr4} {
+		input.GravityKeeper.RegisterDenomTrace(ctx, contract)
+	}
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
@@ -414,8 +419,8 @@ func TestManyBatches(t *testing.T) {
 	for _, contract := range tokens {
 		for v := 1; v < 500; v++ {
 			vAsSDKInt := sdk.NewIntFromUint64(uint64(v))
-			amount := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), contract).GravityCoin()
-			fee := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), contract).GravityCoin()
+			amount := sdk.NewCoin(types.GravityDenom(contract), oneEth.Mul(vAsSDKInt))
+			fee := sdk.NewCoin(types.GravityDenom(contract), oneEth.Mul(vAsSDKInt))
 			_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 			require.NoError(t, err)
 		}
@@ -465,12 +470,13 @@ func TestPoolTxRefund(t *testing.T) {
 		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
 		allVouchers         = sdk.NewCoins(
-			types.NewERC20Token(414, myTokenContractAddr).GravityCoin(),
+			sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(414)),
 		)
-		myDenom = types.NewERC20Token(1, myTokenContractAddr).GravityCoin().Denom
+		myDenom = types.GravityDenom(myTokenContractAddr)
 	)
 
 	// mint some voucher first
+	input.GravityKeeper.RegisterDenomTrace(ctx, myTokenContractAddr)
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
@@ -481,8 +487,8 @@ func TestPoolTxRefund(t *testing.T) {
 
 	// add some TX to the pool
 	for i, v := range []uint64{2, 3, 2, 1} {
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(v))
 		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 	}
@@ -510,3 +516,62 @@ func TestPoolTxRefund(t *testing.T) {
 	balances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, sdk.NewInt(104), balances.AmountOf(myDenom))
 }
+
+func TestPoolTxRefundCosmosOriginated(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+		myDenom             = "stake"
+	)
+	input.GravityKeeper.setCosmosOriginatedDenomToERC20(ctx, myDenom, myTokenContractAddr)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, sdk.NewCoins(sdk.NewInt64Coin(myDenom, 500))))
+
+	id, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, sdk.NewInt64Coin(myDenom, 100), sdk.NewInt64Coin(myDenom, 3))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(397), input.BankKeeper.GetBalance(ctx, mySender, myDenom).Amount)
+
+	// the locked coins are returned, not vouchers
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, id, mySender))
+	balances := input.BankKeeper.GetAllBalances(ctx, mySender)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(myDenom, 500)), balances)
+}
+
+func TestCancelBatchDeletesConfirms(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		allVouchers         = sdk.NewCoins(sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(1000)))
+	)
+	input.GravityKeeper.RegisterDenomTrace(ctx, myTokenContractAddr)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	for i := 0; i < 2; i++ {
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(100))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+1)))
+		_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+		require.NoError(t, err)
+	}
+	batch, err := k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 2)
+	require.NoError(t, err)
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: batch.TokenContract,
+		EthSigner:     EthAddrs[0].String(),
+		Orchestrator:  AccAddrs[0].String(),
+		Signature:     "signature",
+	})
+	require.Len(t, k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract), 1)
+
+	require.NoError(t, k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce))
+	assert.Empty(t, k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract))
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// outstandingConfirm is a valset, batch or logic call validators can still be slashed for not
+// confirming, along with who confirmed it so far
+type outstandingConfirm struct {
+	info types.PastEthSignatureCheckpoint
+	// the block at the end of which validators missing a confirm are slashed
+	slashHeight uint64
+	// valset confirms are matched by Ethereum address, batch and logic call confirms by orchestrator
+	signers map[string]bool
+	// only set for valsets
+	valset *types.Valset
+}
+
+// confirmedBy tells whether the validator with the given delegate keys confirmed the item
+func (o outstandingConfirm) confirmedBy(ethAddress *types.EthAddress, orchestrator string) bool {
+	if o.info.Type == types.CHECKPOINT_TYPE_VALSET {
+		return ethAddress != nil && o.signers[ethAddress.GetAddress()]
+	}
+	return orchestrator != "" && o.signers[orchestrator]
+}
+
+// requiredFrom tells whether the validator with the given Ethereum address that started signing blocks
+// at startHeight is slashed for not confirming the item, mirroring the checks of the slashing in the EndBlocker
+func (o outstandingConfirm) requiredFrom(ethAddress *types.EthAddress, startHeight int64, found bool) bool {
+	if !found {
+		return o.info.Type != types.CHECKPOINT_TYPE_VALSET
+	}
+	if o.info.Type == types.CHECKPOINT_TYPE_VALSET {
+		member := ethAddress == nil || o.valset.HasMember(*ethAddress)
+		return uint64(startHeight) < o.info.Height && member
+	}
+	return startHeight <= int64(o.info.Height)
+}
+
+// getOutstandingConfirms returns the valsets, batches and logic calls that haven't been slashed for yet
+func (k Keeper) getOutstandingConfirms(ctx sdk.Context, params types.Params) (out []outstandingConfirm) {
+	gravityID := k.GetGravityID(ctx)
+
+	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
+	k.IterateValsetBySlashedValsetNonce(ctx, lastSlashedValsetNonce, func(_ []byte, valset *types.Valset) bool {
+		if valset.Nonce <= lastSlashedValsetNonce {
+			return false
+		}
+		item := outstandingConfirm{
+			info: types.PastEthSignatureCheckpoint{
+				Checkpoint: valset.GetCheckpoint(gravityID),
+				Type:       types.CHECKPOINT_TYPE_VALSET,
+				Nonce:      valset.Nonce,
+				Height:     valset.Height,
+			},
+			// valsets are only slashed for once the chain is past the first window
+			slashHeight: maxUint64(valset.Height+params.SignedValsetsWindow, params.SignedValsetsWindow+1),
+			signers:     make(map[string]bool),
+			valset:      valset,
+		}
+		for _, confirm := range k.GetValsetConfirms(ctx, valset.Nonce) {
+			if ethAddress, err := types.NewEthAddress(confirm.EthAddress); err == nil {
+				item.signers[ethAddress.GetAddress()] = true
+			}
+		}
+		out = append(out, item)
+		return false
+	})
+
+	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx)
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		if batch.Block <= lastSlashedBatchBlock {
+			continue
+		}
+		item := outstandingConfirm{
+			info: types.PastEthSignatureCheckpoint{
+				Checkpoint:    batch.GetCheckpoint(gravityID),
+				Type:          types.CHECKPOINT_TYPE_BATCH,
+				Nonce:         batch.BatchNonce,
+				TokenContract: batch.TokenContract,
+				Height:        batch.Block,
+			},
+			slashHeight: batch.Block + params.SignedBatchesWindow + 1,
+			signers:     make(map[string]bool),
+		}
+		for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract) {
+			item.signers[confirm.Orchestrator] = true
+		}
+		out = append(out, item)
+	}
+
+	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx)
+	for _, call := range k.GetOutgoingLogicCalls(ctx) {
+		if call.Block <= lastSlashedLogicCallBlock {
+			continue
+		}
+		item := outstandingConfirm{
+			info: types.PastEthSignatureCheckpoint{
+				Checkpoint:     call.GetCheckpoint(gravityID),
+				Type:           types.CHECKPOINT_TYPE_LOGIC_CALL,
+				Nonce:          call.InvalidationNonce,
+				InvalidationId: call.InvalidationId,
+				Height:         call.Block,
+			},
+			slashHeight: call.Block + params.SignedLogicCallsWindow + 1,
+			signers:     make(map[string]bool),
+		}
+		for _, confirm := range k.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce) {
+			item.signers[confirm.Orchestrator] = true
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// BridgeStatus returns the health of the bridge and of the orchestrator of every bonded validator
+func (k Keeper) BridgeStatus(
+	c context.Context,
+	req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+	lastObservedNonce := k.GetLastObservedEventNonce(ctx)
+
+	res := &types.QueryBridgeStatusResponse{
+		LastObservedEventNonce:     lastObservedNonce,
+		LastObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx),
+		PoolDepths:                 k.GetPoolDepths(ctx),
+	}
+	if res.LastObservedEthereumHeight.CosmosBlockHeight < height {
+		res.EthereumHeightAge = height - res.LastObservedEthereumHeight.CosmosBlockHeight
+	}
+	k.IterateAttestationsByNonceRange(ctx, lastObservedNonce+1, math.MaxUint64, func(_ []byte, _ types.Attestation) bool {
+		res.PendingAttestations++
+		return false
+	})
+
+	orchestrators := make(map[string]string)
+	k.IterateOrchestratorValidators(ctx, func(orch sdk.AccAddress, val sdk.ValAddress) bool {
+		orchestrators[val.String()] = orch.String()
+		return false
+	})
+	outstanding := k.getOutstandingConfirms(ctx, params)
+	var oldest *outstandingConfirm
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		status := types.ValidatorBridgeStatus{
+			ValidatorAddress:    val.GetOperator().String(),
+			OrchestratorAddress: orchestrators[val.GetOperator().String()],
+			LastEventNonce:      k.GetLastEventNonceByValidator(ctx, val.GetOperator()),
+		}
+		ethAddress, foundEthAddress := k.GetEthAddressByValidator(ctx, val.GetOperator())
+		if foundEthAddress {
+			status.EthAddress = ethAddress.GetAddress()
+		}
+		if status.LastEventNonce < lastObservedNonce {
+			status.EventNonceLag = lastObservedNonce - status.LastEventNonce
+		}
+
+		consAddr, _ := val.GetConsAddr()
+		signingInfo, found := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		var slashHeight uint64
+		for i, item := range outstanding {
+			if !item.requiredFrom(ethAddress, signingInfo.StartHeight, found) || item.confirmedBy(ethAddress, status.OrchestratorAddress) {
+				continue
+			}
+			switch item.info.Type {
+			case types.CHECKPOINT_TYPE_VALSET:
+				status.MissingValsetConfirms++
+			case types.CHECKPOINT_TYPE_BATCH:
+				status.MissingBatchConfirms++
+			case types.CHECKPOINT_TYPE_LOGIC_CALL:
+				status.MissingLogicCallConfirms++
+			}
+			if slashHeight == 0 || item.slashHeight < slashHeight {
+				slashHeight = item.slashHeight
+			}
+			if oldest == nil || item.info.Height < oldest.info.Height {
+				oldest = &outstanding[i]
+			}
+		}
+		if slashHeight > height {
+			status.BlocksUntilSlashing = slashHeight - height
+		}
+		res.Validators = append(res.Validators, status)
+	}
+	if oldest != nil {
+		res.OldestUnconfirmed = &oldest.info
+	}
+	return res, nil
+}
+
+func maxUint64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
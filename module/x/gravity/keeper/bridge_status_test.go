@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestBridgeStatus(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	token := TokenContractAddrs[0]
+	for i := range ValAddrs[:2] {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+
+	// the validators started signing before the valset and the batch were created
+	created := ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+	valset := k.SetValsetRequest(created)
+	k.SetValsetConfirm(created, types.MsgValsetConfirm{Nonce: valset.Nonce, Orchestrator: AccAddrs[0].String(), EthAddress: EthAddrs[0].String()})
+	batch := &types.OutgoingTxBatch{BatchNonce: 1, TokenContract: token}
+	k.StoreBatch(created, batch)
+	k.SetBatchConfirm(created, &types.MsgConfirmBatch{Nonce: 1, TokenContract: token, EthSigner: EthAddrs[1].String(), Orchestrator: AccAddrs[1].String()})
+
+	// one event observed, validator 1 lags behind and a claim for the next event is pending
+	k.setLastObservedEventNonce(ctx, 2)
+	k.setLastEventNonceByValidator(ctx, ValAddrs[0], 2)
+	k.setLastEventNonceByValidator(ctx, ValAddrs[1], 1)
+	claim := &types.MsgSendToCosmosClaim{EventNonce: 3, TokenContract: token, Orchestrator: AccAddrs[0].String()}
+	any, err := codectypes.NewAnyWithValue(claim)
+	require.NoError(t, err)
+	k.SetAttestation(ctx, claim.EventNonce, claim.ClaimHash(), &types.Attestation{Claim: any})
+
+	vouchers := sdk.NewCoins(sdk.NewCoin(types.GravityDenom(token), sdk.NewInt(99999)))
+	k.RegisterDenomTrace(ctx, token)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, AccAddrs[0], vouchers))
+	for _, fee := range []int64{2, 3} {
+		_, err := k.AddToOutgoingPool(ctx, AccAddrs[0], EthAddrs[1].String(), sdk.NewCoin(types.GravityDenom(token), sdk.NewInt(100)), sdk.NewCoin(types.GravityDenom(token), sdk.NewIntFromUint64(uint64(fee))))
+		require.NoError(t, err)
+	}
+
+	now := created.WithBlockHeight(created.BlockHeight() + 10)
+	res, err := k.BridgeStatus(sdk.WrapSDKContext(now), &types.QueryBridgeStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.LastObservedEventNonce)
+	assert.Equal(t, uint64(1), res.PendingAttestations)
+	assert.Equal(t, []types.PoolDepth{{TokenContract: token, UnbatchedTransfers: 2, TotalFees: sdk.NewInt(5)}}, res.PoolDepths)
+	require.NotNil(t, res.OldestUnconfirmed)
+	assert.Equal(t, uint64(created.BlockHeight()), res.OldestUnconfirmed.Height)
+
+	require.Len(t, res.Validators, 5)
+	statuses := make(map[string]types.ValidatorBridgeStatus)
+	for _, status := range res.Validators {
+		statuses[status.ValidatorAddress] = status
+	}
+	batchSlashIn := uint64(created.BlockHeight()) + params.SignedBatchesWindow + 1 - uint64(now.BlockHeight())
+	valsetSlashIn := uint64(created.BlockHeight()) + params.SignedValsetsWindow - uint64(now.BlockHeight())
+
+	first := statuses[ValAddrs[0].String()]
+	assert.Equal(t, AccAddrs[0].String(), first.OrchestratorAddress)
+	assert.Equal(t, EthAddrs[0].String(), first.EthAddress)
+	assert.Equal(t, uint64(0), first.EventNonceLag)
+	assert.Equal(t, uint64(0), first.MissingValsetConfirms)
+	assert.Equal(t, uint64(1), first.MissingBatchConfirms)
+	assert.Equal(t, batchSlashIn, first.BlocksUntilSlashing)
+
+	second := statuses[ValAddrs[1].String()]
+	assert.Equal(t, uint64(1), second.EventNonceLag)
+	assert.Equal(t, uint64(1), second.MissingValsetConfirms)
+	assert.Equal(t, uint64(0), second.MissingBatchConfirms)
+	assert.Equal(t, valsetSlashIn, second.BlocksUntilSlashing)
+
+	// without delegate keys nothing is confirmed, a validator that never submitted a claim
+	// starts right before the last observed event
+	last := statuses[ValAddrs[4].String()]
+	assert.Empty(t, last.OrchestratorAddress)
+	assert.Equal(t, uint64(1), last.EventNonceLag)
+	assert.Equal(t, uint64(1), last.MissingValsetConfirms)
+	assert.Equal(t, uint64(1), last.MissingBatchConfirms)
+}
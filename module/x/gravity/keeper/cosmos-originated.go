@@ -10,8 +10,12 @@ import (
 )
 
 func (k Keeper) GetCosmosOriginatedDenom(ctx sdk.Context, tokenContract string) (string, bool) {
+	contract, err := types.NewEthAddress(tokenContract)
+	if err != nil {
+		return "", false
+	}
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetERC20ToDenomKey(tokenContract))
+	bz := store.Get(types.GetERC20ToDenomKey(*contract))
 
 	if bz != nil {
 		return string(bz), true
@@ -30,33 +34,41 @@ func (k Keeper) GetCosmosOriginatedERC20(ctx sdk.Context, denom string) (string,
 }
 
 func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, tokenContract string) {
+	contract := types.MustNewEthAddress(tokenContract)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDenomToERC20Key(denom), []byte(contract.GetAddress()))
+	store.Set(types.GetERC20ToDenomKey(contract), []byte(denom))
+}
+
+// setUnlockOnlyERC20 maps an ERC20 a Cosmos originated denom was remapped away from back to the denom, without
+// making it the ERC20 of the denom
+func (k Keeper) setUnlockOnlyERC20(ctx sdk.Context, denom string, tokenContract string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetDenomToERC20Key(denom), []byte(tokenContract))
-	store.Set(types.GetERC20ToDenomKey(tokenContract), []byte(denom))
+	store.Set(types.GetERC20ToDenomKey(types.MustNewEthAddress(tokenContract)), []byte(denom))
 }
 
 // DenomToERC20 returns (bool isCosmosOriginated, string ERC20, err)
 // Using this information, you can see if an asset is native to Cosmos or Ethereum,
 // and get its corresponding ERC20 address.
-// This will return an error if it cant parse the denom as a gravity denom, and then also can't find the denom
-// in an index of ERC20 contracts deployed on Ethereum to serve as synthetic Cosmos assets.
+// This will return an error if it cant find the denom in the traces of gravity vouchers, and then also can't
+// find the denom in an index of ERC20 contracts deployed on Ethereum to serve as synthetic Cosmos assets.
 func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, string, error) {
-	// First try parsing the ERC20 out of the denom
-	tc1, err := types.GravityDenomToERC20(denom)
+	// First try tracing the denom back to the ERC20 of the voucher
+	trace, found := k.GetDenomTrace(ctx, denom)
 
-	if err != nil {
+	if !found {
 		// Look up ERC20 contract in index and error if it's not in there.
 		tc2, exists := k.GetCosmosOriginatedERC20(ctx, denom)
 		if !exists {
 			return false, "",
-				fmt.Errorf("denom not a gravity voucher coin: %s, and also not in cosmos-originated ERC20 index", err)
+				fmt.Errorf("denom %s not a traced gravity voucher coin, and also not in cosmos-originated ERC20 index", denom)
 		}
 		// This is a cosmos-originated asset
 		return true, tc2, nil
 	}
 
 	// This is an ethereum-originated asset
-	return false, tc1, nil
+	return false, trace.TokenContract, nil
 }
 
 // RewardToERC20Lookup is a specialized function wrapping DenomToERC20Lookup designed to validate
@@ -77,7 +89,12 @@ func (k Keeper) RewardToERC20Lookup(ctx sdk.Context, coin sdk.Coin) (string, sdk
 		if err != nil {
 			panic("Invalid Valset reward! Correct or remove the paramater value")
 		}
-		return addressStr, coin.Amount
+		// a reward that can't be represented in the decimals of the ERC20 is paused like an unfunded one
+		amount, err := k.GetTokenScaling(ctx, addressStr).ToERC20(coin.Amount)
+		if err != nil {
+			return "0x0000000000000000000000000000000000000000", sdk.ZeroInt()
+		}
+		return addressStr, amount
 	}
 }
 
@@ -92,7 +109,11 @@ func (k Keeper) ERC20ToDenomLookup(ctx sdk.Context, tokenContract string) (bool,
 		return true, dn1
 	}
 
-	// If it is not in there, it is not a cosmos originated token, turn the ERC20 into a gravity denom
+	// If it is not in there, it is not a cosmos originated token, its vouchers are the traced denom or, if
+	// none were minted yet, the denom they get once they are
+	if trace, found := k.GetDenomTraceByERC20(ctx, tokenContract); found {
+		return false, trace.Denom
+	}
 	return false, types.GravityDenom(tokenContract)
 }
 
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetDenomTrace returns the trace of a voucher denom back to its ERC20
+func (k Keeper) GetDenomTrace(ctx sdk.Context, denom string) (types.DenomTrace, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDenomTraceKey(denom))
+	if bz == nil {
+		return types.DenomTrace{}, false
+	}
+	var trace types.DenomTrace
+	k.cdc.MustUnmarshalBinaryBare(bz, &trace)
+	return trace, true
+}
+
+// GetDenomTraceByERC20 returns the trace of the vouchers of an ERC20, the address may be in any casing
+func (k Keeper) GetDenomTraceByERC20(ctx sdk.Context, tokenContract string) (types.DenomTrace, bool) {
+	contract, err := types.NewEthAddress(tokenContract)
+	if err != nil {
+		return types.DenomTrace{}, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDenomTraceByERC20Key(*contract))
+	if bz == nil {
+		return types.DenomTrace{}, false
+	}
+	return k.GetDenomTrace(ctx, string(bz))
+}
+
+// setDenomTrace sets the trace of a voucher denom and indexes it by its ERC20
+func (k Keeper) setDenomTrace(ctx sdk.Context, trace types.DenomTrace) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDenomTraceKey(trace.Denom), k.cdc.MustMarshalBinaryBare(&trace))
+	store.Set(types.GetDenomTraceByERC20Key(types.MustNewEthAddress(trace.TokenContract)), []byte(trace.Denom))
+}
+
+// RegisterDenomTrace returns the trace of the vouchers of an Ethereum originated ERC20, registering one
+// named after the hash of the contract if its vouchers have none yet
+func (k Keeper) RegisterDenomTrace(ctx sdk.Context, tokenContract string) types.DenomTrace {
+	if trace, found := k.GetDenomTraceByERC20(ctx, tokenContract); found {
+		return trace
+	}
+	trace := types.NewDenomTrace(tokenContract)
+	k.setDenomTrace(ctx, trace)
+	return trace
+}
+
+// IterateDenomTraces iterates over the traces of every voucher denom
+func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(trace types.DenomTrace) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var trace types.DenomTrace
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &trace)
+		// cb returns true to stop early
+		if cb(trace) {
+			break
+		}
+	}
+}
+
+// DenomTrace queries the ERC20 behind a voucher denom, or the voucher denom of an ERC20
+func (k Keeper) DenomTrace(
+	c context.Context,
+	req *types.QueryDenomTraceRequest) (*types.QueryDenomTraceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var (
+		trace types.DenomTrace
+		found bool
+	)
+	if req.Denom != "" {
+		trace, found = k.GetDenomTrace(ctx, req.Denom)
+	} else {
+		trace, found = k.GetDenomTraceByERC20(ctx, req.Erc20)
+	}
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "denom trace")
+	}
+	return &types.QueryDenomTraceResponse{Trace: trace}, nil
+}
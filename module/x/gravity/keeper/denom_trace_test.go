@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestDenomTraces(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	tokenContract := TokenContractAddrs[0]
+	receiver := AccAddrs[0]
+
+	// deposits of the same ERC20 spelled in different casings mint the same vouchers
+	for nonce, contract := range []string{strings.ToLower(tokenContract), tokenContract} {
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     uint64(nonce + 1),
+			TokenContract:  contract,
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: receiver.String(),
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+	denom := types.GravityDenom(tokenContract)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 200)), input.BankKeeper.GetAllBalances(ctx, receiver))
+
+	isCosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, denom)
+	require.NoError(t, err)
+	assert.False(t, isCosmosOriginated)
+	assert.Equal(t, tokenContract, erc20)
+	_, lookedUp := k.ERC20ToDenomLookup(ctx, strings.ToLower(tokenContract))
+	assert.Equal(t, denom, lookedUp)
+
+	// vouchers are only known once they were minted
+	_, _, err = k.DenomToERC20Lookup(ctx, types.GravityDenom(TokenContractAddrs[1]))
+	assert.Error(t, err)
+
+	c := sdk.WrapSDKContext(ctx)
+	res, err := k.DenomTrace(c, &types.QueryDenomTraceRequest{Denom: denom})
+	require.NoError(t, err)
+	assert.Equal(t, types.DenomTrace{Denom: denom, TokenContract: tokenContract}, res.Trace)
+	res, err = k.DenomTrace(c, &types.QueryDenomTraceRequest{Erc20: strings.ToLower(tokenContract)})
+	require.NoError(t, err)
+	assert.Equal(t, denom, res.Trace.Denom)
+	_, err = k.DenomTrace(c, &types.QueryDenomTraceRequest{Erc20: TokenContractAddrs[1]})
+	assert.Error(t, err)
+}
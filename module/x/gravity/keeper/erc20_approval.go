@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetERC20DeploymentApproval returns the governance approval for ERC20 deployments of a denom
+func (k Keeper) GetERC20DeploymentApproval(ctx sdk.Context, denom string) (approval types.ERC20DeploymentApproval, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetERC20DeploymentApprovalKey(denom))
+	if bz == nil {
+		return approval, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &approval)
+	return approval, true
+}
+
+// SetERC20DeploymentApproval sets the approval for ERC20 deployments of a denom, replacing any previous one
+func (k Keeper) SetERC20DeploymentApproval(ctx sdk.Context, approval types.ERC20DeploymentApproval) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetERC20DeploymentApprovalKey(approval.Denom), k.cdc.MustMarshalBinaryBare(&approval))
+}
+
+// IterateERC20DeploymentApprovals iterates over the approvals of every denom
+func (k Keeper) IterateERC20DeploymentApprovals(ctx sdk.Context, cb func(approval types.ERC20DeploymentApproval) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ERC20DeploymentApprovalKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var approval types.ERC20DeploymentApproval
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &approval)
+		// cb returns true to stop early
+		if cb(approval) {
+			break
+		}
+	}
+}
+
+// checkERC20DeploymentApproved returns an error explaining why an observed ERC20 deployment is rejected. Deployments
+// for a denom without an approval are only rejected while approvals are required, an approval rejects deployments of
+// any other ERC20 or by any other deployer than the one it expects
+func (k Keeper) checkERC20DeploymentApproved(ctx sdk.Context, claim *types.MsgERC20DeployedClaim) error {
+	approval, found := k.GetERC20DeploymentApproval(ctx, claim.CosmosDenom)
+	if !found {
+		var required bool
+		k.paramSpace.Get(ctx, types.ParamStoreRequireERC20DeploymentApproval, &required)
+		if required {
+			return sdkerrors.Wrap(types.ErrUnknown, "denom not approved for erc20 deployments")
+		}
+		return nil
+	}
+	if approval.Erc20 != "" && !types.SameEthAddress(approval.Erc20, claim.TokenContract) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "erc20 %s is not the approved %s", claim.TokenContract, approval.Erc20)
+	}
+	if approval.Deployer != "" && !types.SameEthAddress(approval.Deployer, claim.Deployer) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "deployer %q is not the approved %s", claim.Deployer, approval.Deployer)
+	}
+	return nil
+}
+
+// approvedScalingExponent returns the decimal scaling exponent governance approved between a denom and its ERC20,
+// denoms without an approval are only bridged to ERC20s with their decimals
+func (k Keeper) approvedScalingExponent(ctx sdk.Context, denom string) int64 {
+	approval, _ := k.GetERC20DeploymentApproval(ctx, denom)
+	return approval.Exponent
+}
+
+// HandleERC20WhitelistProposal stores the approvals of a passed ERC20 whitelist proposal. Claims only carry the
+// deployer once extended claims are enabled, until then an approval that expects a deployer would reject every
+// deployment of its denom, so it is refused
+func (k Keeper) HandleERC20WhitelistProposal(ctx sdk.Context, p *types.ERC20WhitelistProposal) error {
+	var extendedClaims bool
+	k.paramSpace.Get(ctx, types.ParamStoreExtendedClaims, &extendedClaims)
+	for _, approval := range p.Approvals {
+		if err := approval.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, approval.Denom)
+		}
+		if approval.Deployer != "" && !extendedClaims {
+			return sdkerrors.Wrapf(types.ErrInvalid, "%s: deployers aren't claimed until extended claims are enabled", approval.Denom)
+		}
+		k.SetERC20DeploymentApproval(ctx, approval)
+	}
+	return nil
+}
+
+// HandleERC20RemapProposal maps a Cosmos originated denom to the ERC20 its token was migrated to, which is bridged
+// with the decimal scaling of the proposal. The previous ERC20 is kept mapped to the denom with its scaling and
+// dust so deposits of it still unlock the coins locked for its supply, but transfers out go through the new ERC20,
+// so the remap is refused while transfers out through the previous one are pending
+func (k Keeper) HandleERC20RemapProposal(ctx sdk.Context, p *types.ERC20RemapProposal) error {
+	previous, exists := k.GetCosmosOriginatedERC20(ctx, p.Denom)
+	if !exists {
+		return sdkerrors.Wrapf(types.ErrUnknown, "no erc20 for denom %s", p.Denom)
+	}
+	if denom, exists := k.GetCosmosOriginatedDenom(ctx, p.Erc20); exists {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "erc20 %s already represents %s", p.Erc20, denom)
+	}
+	if k.hasPendingOutgoingTransfers(ctx, previous) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "transfers of erc20 %s are still pending", previous)
+	}
+
+	scaling := types.TokenScaling{TokenContract: p.Erc20, Exponent: p.Exponent}
+	if err := scaling.ValidateBasic(); err != nil {
+		return err
+	}
+
+	k.setCosmosOriginatedDenomToERC20(ctx, p.Denom, p.Erc20)
+	k.setTokenScaling(ctx, scaling)
+	if scaling.Exponent != 0 {
+		k.emitTokenScalingSet(ctx, scaling)
+	}
+	// keep an approval that expects the previous ERC20 in line with the mapping
+	if approval, found := k.GetERC20DeploymentApproval(ctx, p.Denom); found && approval.Erc20 != "" {
+		approval.Erc20 = p.Erc20
+		approval.Exponent = p.Exponent
+		k.SetERC20DeploymentApproval(ctx, approval)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeERC20Remapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDenom, p.Denom),
+		sdk.NewAttribute(types.AttributeKeyERC20, p.Erc20),
+		sdk.NewAttribute(types.AttributeKeyPreviousERC20, previous),
+	))
+	return nil
+}
+
+// hasPendingOutgoingTransfers returns true if the pool or a batch still holds transfers of the ERC20
+func (k Keeper) hasPendingOutgoingTransfers(ctx sdk.Context, tokenContract string) bool {
+	tokenContract = types.CanonicalEthAddress(tokenContract)
+	for _, tx := range k.GetPoolTransactions(ctx) {
+		if tx.Erc20Token.Contract == tokenContract {
+			return true
+		}
+	}
+	pending := false
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
+		pending = batch.TokenContract == tokenContract
+		return pending
+	})
+	return pending
+}
+
+// ERC20DeploymentApprovals queries the denoms governance approved for ERC20 deployments
+func (k Keeper) ERC20DeploymentApprovals(
+	c context.Context,
+	req *types.QueryERC20DeploymentApprovalsRequest) (*types.QueryERC20DeploymentApprovalsResponse, error) {
+	var approvals []types.ERC20DeploymentApproval
+	k.IterateERC20DeploymentApprovals(sdk.UnwrapSDKContext(c), func(approval types.ERC20DeploymentApproval) bool {
+		approvals = append(approvals, approval)
+		return false
+	})
+	return &types.QueryERC20DeploymentApprovalsResponse{Approvals: approvals}, nil
+}
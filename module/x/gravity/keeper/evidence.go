@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -55,7 +56,7 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 	}
 
 	// Find the offending validator by eth address
-	val, found := k.GetValidatorByEthAddress(ctx, ethAddress)
+	val, found := k.GetValidatorByEthAddress(ctx, *ethAddress)
 	if !found {
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Did not find validator for eth address %s", ethAddress))
 	}
@@ -91,3 +92,52 @@ func (k Keeper) GetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte
 		return false
 	}
 }
+
+// IteratePastEthSignatureCheckpoints iterates over every checkpoint that has ever existed
+func (k Keeper) IteratePastEthSignatureCheckpoints(ctx sdk.Context, cb func(checkpoint []byte) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PastEthSignatureCheckpointKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
+
+// SetPastEthSignatureCheckpointInfo stores the checkpoint of a valset, batch, or logic call as a legit
+// past checkpoint, along with a record of which object it was produced from
+func (k Keeper) SetPastEthSignatureCheckpointInfo(ctx sdk.Context, info types.PastEthSignatureCheckpoint) {
+	k.SetPastEthSignatureCheckpoint(ctx, info.Checkpoint)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPastEthSignatureCheckpointInfoKey(info.Checkpoint), k.cdc.MustMarshalBinaryBare(&info))
+}
+
+// GetPastEthSignatureCheckpointInfo returns the record of which valset, batch, or logic call a checkpoint
+// was produced from, or nil if there is none
+func (k Keeper) GetPastEthSignatureCheckpointInfo(ctx sdk.Context, checkpoint []byte) *types.PastEthSignatureCheckpoint {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPastEthSignatureCheckpointInfoKey(checkpoint))
+	if bz == nil {
+		return nil
+	}
+	var info types.PastEthSignatureCheckpoint
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return &info
+}
+
+// IteratePastEthSignatureCheckpointInfos iterates over the record of every checkpoint that has one
+func (k Keeper) IteratePastEthSignatureCheckpointInfos(ctx sdk.Context, cb func(info types.PastEthSignatureCheckpoint) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PastEthSignatureCheckpointInfoKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.PastEthSignatureCheckpoint
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		// cb returns true to stop early
+		if cb(info) {
+			break
+		}
+	}
+}
@@ -22,11 +22,12 @@ func TestSubmitBadSignatureEvidenceBatchExists(t *testing.T) {
 		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
 		allVouchers         = sdk.NewCoins(
-			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+			sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(99999)),
 		)
 	)
 
 	// mint some voucher first
+	input.GravityKeeper.RegisterDenomTrace(ctx, myTokenContractAddr)
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
@@ -36,8 +37,8 @@ func TestSubmitBadSignatureEvidenceBatchExists(t *testing.T) {
 
 	// add some TX to the pool
 	for i, v := range []uint64{2, 3, 2, 1} {
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(v))
 		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 	}
@@ -114,7 +115,7 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 
 	ethAddress := crypto.PubkeyToAddress(privKey.PublicKey)
 
-	input.GravityKeeper.SetEthAddressForValidator(ctx, ValAddrs[0], ethAddress.String())
+	input.GravityKeeper.SetEthAddressForValidator(ctx, ValAddrs[0], types.MustNewEthAddress(ethAddress.String()))
 
 	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
 	require.NoError(t, err)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -9,21 +11,38 @@ import (
 // InitGenesis starts a chain from a genesis state
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetParams(ctx, *data.Params)
+	// the state below is always imported in the current layout
+	k.setStoreVersion(ctx, types.ConsensusVersion)
 	// reset valsets in state
 	for _, vs := range data.Valsets {
 		// TODO: block height?
 		k.StoreValsetUnsafe(ctx, vs)
 	}
+	if data.LatestValsetNonce != 0 {
+		k.SetLatestValsetNonce(ctx, data.LatestValsetNonce)
+	}
+	if data.LastObservedValset != nil {
+		k.SetLastObservedValset(ctx, *data.LastObservedValset)
+	}
 
 	// reset valset confirmations in state
 	for _, conf := range data.ValsetConfirms {
 		k.SetValsetConfirm(ctx, *conf)
 	}
 
-	// reset batches in state
-	for _, batch := range data.Batches {
+	// reset batches in state, in the order they were built so that the latest batch of a
+	// block ends up in the block index
+	batches := append([]*types.OutgoingTxBatch{}, data.Batches...)
+	sort.Slice(batches, func(i, j int) bool { return batches[i].BatchNonce < batches[j].BatchNonce })
+	for _, batch := range batches {
 		// TODO: block height?
 		k.StoreBatchUnsafe(ctx, batch)
+		// batched transactions stay in the pool until the batch is executed or cancelled
+		for _, tx := range batch.Transactions {
+			if err := k.setPoolEntry(ctx, tx); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	// reset batch confirmations in state
@@ -48,7 +67,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err := k.setPoolEntry(ctx, tx); err != nil {
 			panic(err)
 		}
+		k.appendToUnbatchedTXIndex(ctx, tx.Erc20Fee.Contract, *tx.Erc20Fee, tx.Id)
 	}
+	k.setNextID(ctx, types.KeyLastTXPoolID, data.NextTxPoolId)
+	k.setNextID(ctx, types.KeyLastOutgoingBatchID, data.NextOutgoingBatchId)
 
 	// reset attestations in state
 	for _, att := range data.Attestations {
@@ -71,15 +93,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic("couldn't cast to claim")
 		}
-		// reconstruct the latest event nonce for every validator
-		// if somehow this genesis state is saved when all attestations
-		// have been cleaned up GetLastEventNonceByValidator handles that case
-		//
-		// if we where to save and load the last event nonce for every validator
-		// then we would need to carry that state forever across all chain restarts
-		// but since we've already had to handle the edge case of new validators joining
-		// while all attestations have already been cleaned up we can do this instead and
-		// not carry around every validators event nonce counter forever.
+		// reconstruct the latest event nonce for every validator, this is only
+		// needed for genesis files exported before the last event nonces were,
+		// any exported nonce below overrides what is reconstructed here
 		for _, vote := range att.Votes {
 			val, err := sdk.ValAddressFromBech32(vote)
 			if err != nil {
@@ -92,6 +108,80 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 	}
 
+	// reset the last event nonce of specific validators
+	for _, nonce := range data.LastEventNonces {
+		val, err := sdk.ValAddressFromBech32(nonce.Validator)
+		if err != nil {
+			panic(err)
+		}
+		k.setLastEventNonceByValidator(ctx, val, nonce.EventNonce)
+	}
+
+	// reset the ethereum height and slashing progress, left unset if they never were
+	if data.LastObservedEthereumHeight.EthereumBlockHeight != 0 {
+		k.setLastObservedEthereumBlockHeight(ctx, data.LastObservedEthereumHeight)
+	}
+	if data.LastSlashedValsetNonce != 0 {
+		k.SetLastSlashedValsetNonce(ctx, data.LastSlashedValsetNonce)
+	}
+	if data.LastSlashedBatchBlock != 0 {
+		k.SetLastSlashedBatchBlock(ctx, data.LastSlashedBatchBlock)
+	}
+	if data.LastSlashedLogicCallBlock != 0 {
+		k.SetLastSlashedLogicCallBlock(ctx, data.LastSlashedLogicCallBlock)
+	}
+	if data.LastUnBondingBlockHeight != 0 {
+		k.SetLastUnBondingBlockHeight(ctx, data.LastUnBondingBlockHeight)
+	}
+	if data.LastEthAddressChangeHeight != 0 {
+		k.SetLastEthAddressChangeHeight(ctx, data.LastEthAddressChangeHeight)
+	}
+
+	// reset past checkpoints so signatures over them can't be punished as evidence
+	for _, checkpoint := range data.PastEthSignatureCheckpoints {
+		k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	}
+	for _, info := range data.PastEthSignatureCheckpointInfos {
+		k.SetPastEthSignatureCheckpointInfo(ctx, info)
+	}
+
+	// reset the record of missed bridge confirms and claims
+	for _, info := range data.BridgeSigningInfos {
+		k.SetValidatorBridgeSigningInfo(ctx, info)
+	}
+
+	// reset what each relayer was observed relaying
+	for _, stats := range data.RelayerStats {
+		k.SetRelayerStats(ctx, stats)
+	}
+
+	// reset the denoms governance approved for ERC20 deployments
+	for _, approval := range data.Erc20DeploymentApprovals {
+		k.SetERC20DeploymentApproval(ctx, approval)
+	}
+
+	// reset the token policy and the deposits it held, a chain without a policy allows every token
+	if data.TokenPolicy.Mode != types.TOKEN_POLICY_MODE_ALLOW_ALL {
+		k.SetTokenPolicy(ctx, data.TokenPolicy)
+	}
+	for _, deposit := range data.HeldDeposits {
+		k.SetHeldDeposit(ctx, deposit)
+	}
+	k.setNextID(ctx, types.KeyLastHeldDepositID, data.NextHeldDepositId)
+
+	// reset the decimal scaling of tokens and the deposit dust not yet credited
+	for _, scaling := range data.TokenScalings {
+		k.setTokenScaling(ctx, scaling)
+	}
+	for _, dust := range data.Dust {
+		k.setDust(ctx, dust)
+	}
+
+	// reset the traces of voucher denoms
+	for _, trace := range data.DenomTraces {
+		k.setDenomTrace(ctx, trace)
+	}
+
 	// reset delegate keys in state
 	for _, keys := range data.DelegateKeys {
 		err := keys.ValidateBasic()
@@ -111,11 +201,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		// set the orchestrator address
 		k.SetOrchestratorValidator(ctx, val, orch)
 		// set the ethereum address
-		k.SetEthAddressForValidator(ctx, val, keys.EthAddress)
+		k.SetEthAddressForValidator(ctx, val, types.MustNewEthAddress(keys.EthAddress))
 	}
 
-	// populate state with cosmos originated denom-erc20 mapping
+	// populate state with cosmos originated denom-erc20 mapping, ERC20s the denom was remapped
+	// away from only map back to the denom
 	for _, item := range data.Erc20ToDenoms {
+		if item.UnlockOnly {
+			k.setUnlockOnlyERC20(ctx, item.Denom, item.Erc20)
+			continue
+		}
 		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, item.Erc20)
 	}
 
@@ -140,7 +235,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		calls              = k.GetOutgoingLogicCalls(ctx)
 		batches            = k.GetOutgoingTxBatches(ctx)
 		valsets            = k.GetValsets(ctx)
-		attmap             = k.GetAttestationMapping(ctx)
 		vsconfs            = []*types.MsgValsetConfirm{}
 		batchconfs         = []types.MsgConfirmBatch{}
 		callconfs          = []types.MsgConfirmLogicCall{}
@@ -149,6 +243,16 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		lastobserved       = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms      = []*types.ERC20ToDenom{}
 		unbatchedTransfers = k.GetPoolTransactions(ctx)
+		lastEventNonces    = []types.LastEventNonceByValidator{}
+		checkpoints        = [][]byte{}
+		checkpointInfos    = []types.PastEthSignatureCheckpoint{}
+		signingInfos       = []types.ValidatorBridgeSigningInfo{}
+		relayerStats       = []types.RelayerStats{}
+		approvals          = []types.ERC20DeploymentApproval{}
+		heldDeposits       = []types.HeldDeposit{}
+		tokenScalings      = []types.TokenScaling{}
+		dust               = []types.ERC20Token{}
+		denomTraces        = []types.DenomTrace{}
 	)
 
 	// export valset confirmations from state
@@ -172,13 +276,74 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	}
 
 	// export attestations from state
-	for _, atts := range attmap {
-		// TODO: set height = 0?
-		attestations = append(attestations, atts...)
-	}
+	k.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
+		attestations = append(attestations, att)
+		return false
+	})
+
+	// export the last event nonce of every validator
+	k.IterateLastEventNonceByValidator(ctx, func(val sdk.ValAddress, nonce uint64) bool {
+		lastEventNonces = append(lastEventNonces, types.LastEventNonceByValidator{
+			Validator:  val.String(),
+			EventNonce: nonce,
+		})
+		return false
+	})
+
+	// export past checkpoints
+	k.IteratePastEthSignatureCheckpoints(ctx, func(checkpoint []byte) bool {
+		checkpoints = append(checkpoints, checkpoint)
+		return false
+	})
+	k.IteratePastEthSignatureCheckpointInfos(ctx, func(info types.PastEthSignatureCheckpoint) bool {
+		checkpointInfos = append(checkpointInfos, info)
+		return false
+	})
+
+	// export the record of missed bridge confirms and claims
+	k.IterateValidatorBridgeSigningInfos(ctx, func(info types.ValidatorBridgeSigningInfo) bool {
+		signingInfos = append(signingInfos, info)
+		return false
+	})
+
+	// export what each relayer was observed relaying
+	k.IterateRelayerStats(ctx, func(stats types.RelayerStats) bool {
+		relayerStats = append(relayerStats, stats)
+		return false
+	})
+
+	// export the denoms governance approved for ERC20 deployments
+	k.IterateERC20DeploymentApprovals(ctx, func(approval types.ERC20DeploymentApproval) bool {
+		approvals = append(approvals, approval)
+		return false
+	})
+
+	// export the deposits held by the token policy
+	k.IterateHeldDeposits(ctx, func(deposit types.HeldDeposit) bool {
+		heldDeposits = append(heldDeposits, deposit)
+		return false
+	})
+
+	// export the decimal scaling of tokens and their deposit dust
+	k.IterateTokenScalings(ctx, func(scaling types.TokenScaling) bool {
+		tokenScalings = append(tokenScalings, scaling)
+		return false
+	})
+	k.IterateDust(ctx, func(d types.ERC20Token) bool {
+		dust = append(dust, d)
+		return false
+	})
+
+	// export the traces of voucher denoms
+	k.IterateDenomTraces(ctx, func(trace types.DenomTrace) bool {
+		denomTraces = append(denomTraces, trace)
+		return false
+	})
 
 	// export erc20 to denom relations
 	k.IterateERC20ToDenom(ctx, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		current, _ := k.GetCosmosOriginatedERC20(ctx, erc20ToDenom.Denom)
+		erc20ToDenom.UnlockOnly = current != erc20ToDenom.Erc20
 		erc20ToDenoms = append(erc20ToDenoms, erc20ToDenom)
 		return false
 	})
@@ -196,5 +361,28 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		DelegateKeys:       delegates,
 		Erc20ToDenoms:      erc20ToDenoms,
 		UnbatchedTransfers: unbatchedTransfers,
+
+		LastObservedValset:              k.GetLastObservedValset(ctx),
+		LastObservedEthereumHeight:      k.GetLastObservedEthereumBlockHeight(ctx),
+		LastEventNonces:                 lastEventNonces,
+		PastEthSignatureCheckpoints:     checkpoints,
+		PastEthSignatureCheckpointInfos: checkpointInfos,
+		BridgeSigningInfos:              signingInfos,
+		RelayerStats:                    relayerStats,
+		Erc20DeploymentApprovals:        approvals,
+		TokenPolicy:                     k.GetTokenPolicy(ctx),
+		HeldDeposits:                    heldDeposits,
+		TokenScalings:                   tokenScalings,
+		Dust:                            dust,
+		DenomTraces:                     denomTraces,
+		LatestValsetNonce:               k.GetLatestValsetNonce(ctx),
+		LastSlashedValsetNonce:          k.GetLastSlashedValsetNonce(ctx),
+		LastSlashedBatchBlock:           k.GetLastSlashedBatchBlock(ctx),
+		LastSlashedLogicCallBlock:       k.GetLastSlashedLogicCallBlock(ctx),
+		LastUnBondingBlockHeight:        k.GetLastUnBondingBlockHeight(ctx),
+		LastEthAddressChangeHeight:      k.GetLastEthAddressChangeHeight(ctx),
+		NextTxPoolId:                    k.getNextID(ctx, types.KeyLastTXPoolID),
+		NextOutgoingBatchId:             k.getNextID(ctx, types.KeyLastOutgoingBatchID),
+		NextHeldDepositId:               k.getNextID(ctx, types.KeyLastHeldDepositID),
 	}
 }
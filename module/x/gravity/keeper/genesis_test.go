@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Tests that importing an exported genesis state reproduces the gravity store exactly
+func TestGenesisRoundTrip(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	for i := range ValAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], AccAddrs[i])
+	}
+
+	// valsets and their confirms
+	valset := k.SetValsetRequest(ctx)
+	k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: AccAddrs[0].String(),
+		EthAddress:   EthAddrs[0].String(),
+		Signature:    "signature",
+	})
+	k.SetLastObservedValset(ctx, *valset)
+
+	// pool transactions, some of them batched
+	vouchers := sdk.NewCoins(sdk.NewCoin(types.GravityDenom(TokenContractAddrs[0]), sdk.NewInt(99999)))
+	k.RegisterDenomTrace(ctx, TokenContractAddrs[0])
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, AccAddrs[0], vouchers))
+	for i, v := range []uint64{2, 3, 2, 1} {
+		amount := sdk.NewCoin(types.GravityDenom(TokenContractAddrs[0]), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(TokenContractAddrs[0]), sdk.NewIntFromUint64(v))
+		_, err := k.AddToOutgoingPool(ctx, AccAddrs[0], EthAddrs[1].String(), amount, fee)
+		require.NoError(t, err)
+	}
+	batch, err := k.BuildOutgoingTXBatch(ctx, TokenContractAddrs[0], 2)
+	require.NoError(t, err)
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: batch.TokenContract,
+		EthSigner:     EthAddrs[0].String(),
+		Orchestrator:  AccAddrs[0].String(),
+		Signature:     "signature",
+	})
+
+	// logic calls and their confirms
+	call := &types.OutgoingLogicCall{
+		InvalidationId:    []byte("invalidation id"),
+		InvalidationNonce: 1,
+		Timeout:           1000,
+	}
+	k.SetOutgoingLogicCall(ctx, call)
+	k.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+		InvalidationId:    "696e76616c69646174696f6e206964",
+		InvalidationNonce: 1,
+		EthSigner:         EthAddrs[0].String(),
+		Orchestrator:      AccAddrs[0].String(),
+		Signature:         "signature",
+	})
+
+	// an observed deposit and a pending one
+	for nonce, voters := range []int{1: 5, 2: 1} {
+		var att *types.Attestation
+		for i := 0; i < voters; i++ {
+			msg := &types.MsgSendToCosmosClaim{
+				EventNonce:     uint64(nonce),
+				BlockHeight:    uint64(100 + nonce),
+				TokenContract:  TokenContractAddrs[1],
+				Amount:         sdk.NewInt(100),
+				EthereumSender: EthAddrs[0].String(),
+				CosmosReceiver: AccAddrs[4].String(),
+				Orchestrator:   AccAddrs[i].String(),
+			}
+			any, err := codectypes.NewAnyWithValue(msg)
+			require.NoError(t, err)
+			att, err = k.Attest(ctx, msg, any)
+			require.NoError(t, err)
+		}
+		if att != nil {
+			k.TryAttestation(ctx, att)
+		}
+	}
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(ctx))
+
+	// a deposit held by the token policy
+	k.SetTokenPolicy(ctx, types.TokenPolicy{Mode: types.TOKEN_POLICY_MODE_DENY_LIST, TokenContracts: []string{TokenContractAddrs[3]}})
+	k.HoldDeposit(ctx, &types.MsgSendToCosmosClaim{
+		EventNonce:     3,
+		TokenContract:  TokenContractAddrs[3],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[4].String(),
+	})
+
+	// a scaled token with deposit dust
+	k.setTokenScaling(ctx, types.TokenScaling{TokenContract: TokenContractAddrs[4], Exponent: 12})
+	k.setDust(ctx, types.ERC20Token{Contract: TokenContractAddrs[4], Amount: sdk.NewInt(42)})
+
+	// a cosmos originated denom remapped to a new ERC20, the previous one only unlocks
+	k.setCosmosOriginatedDenomToERC20(ctx, "stake", "0x7580bfe88dd3d07947908fae12d95872a260f2d8")
+	k.setCosmosOriginatedDenomToERC20(ctx, "stake", TokenContractAddrs[2])
+	k.SetLastSlashedValsetNonce(ctx, 3)
+	k.SetLastSlashedBatchBlock(ctx, 4)
+	k.SetLastSlashedLogicCallBlock(ctx, 5)
+	k.SetLastUnBondingBlockHeight(ctx, 6)
+	// set by InitGenesis on import
+	k.setStoreVersion(ctx, types.ConsensusVersion)
+
+	genesis := ExportGenesis(ctx, k)
+	require.Len(t, genesis.Erc20ToDenoms, 2)
+	for _, item := range genesis.Erc20ToDenoms {
+		assert.Equal(t, item.Erc20 != TokenContractAddrs[2], item.UnlockOnly, item.Erc20)
+	}
+
+	imported := CreateTestEnv(t)
+	InitGenesis(imported.Context, imported.GravityKeeper, genesis)
+
+	assert.Equal(t, storeContents(ctx, k), storeContents(imported.Context, imported.GravityKeeper))
+	assert.Equal(t, genesis, ExportGenesis(imported.Context, imported.GravityKeeper))
+}
+
+func storeContents(ctx sdk.Context, k Keeper) map[string][]byte {
+	out := make(map[string][]byte)
+	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out[string(iter.Key())] = iter.Value()
+	}
+	return out
+}
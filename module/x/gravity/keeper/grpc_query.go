@@ -2,9 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"math"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -48,27 +53,91 @@ func (k Keeper) ValsetConfirm(
 func (k Keeper) ValsetConfirmsByNonce(
 	c context.Context,
 	req *types.QueryValsetConfirmsByNonceRequest) (*types.QueryValsetConfirmsByNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetConfirmKey), types.UInt64Bytes(req.Nonce))
+
 	var confirms []*types.MsgValsetConfirm
-	k.IterateValsetConfirmByNonce(sdk.UnwrapSDKContext(c), req.Nonce, func(_ []byte, c types.MsgValsetConfirm) bool {
-		confirms = append(confirms, &c)
-		return false
+	pageRes, err := query.Paginate(store, limitPageRequest(req.Pagination, MaxResults), func(_, value []byte) error {
+		var confirm types.MsgValsetConfirm
+		if err := k.cdc.UnmarshalBinaryBare(value, &confirm); err != nil {
+			return err
+		}
+		confirms = append(confirms, &confirm)
+		return nil
 	})
-	return &types.QueryValsetConfirmsByNonceResponse{Confirms: confirms}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryValsetConfirmsByNonceResponse{Confirms: confirms, Pagination: pageRes}, nil
 }
 
 // LastValsetRequests queries the LastValsetRequests of the gravity module
 func (k Keeper) LastValsetRequests(
 	c context.Context,
 	req *types.QueryLastValsetRequestsRequest) (*types.QueryLastValsetRequestsResponse, error) {
-	valReq := k.GetValsets(sdk.UnwrapSDKContext(c))
-	valReqLen := len(valReq)
-	retLen := 0
-	if valReqLen < maxValsetRequestsReturned {
-		retLen = valReqLen
-	} else {
-		retLen = maxValsetRequestsReturned
-	}
-	return &types.QueryLastValsetRequestsResponse{Valsets: valReq[0:retLen]}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
+
+	var valsets []*types.Valset
+	pageRes, err := reversePaginate(store, limitPageRequest(req.Pagination, maxValsetRequestsReturned), func(_, value []byte) error {
+		var valset types.Valset
+		if err := k.cdc.UnmarshalBinaryBare(value, &valset); err != nil {
+			return err
+		}
+		valsets = append(valsets, &valset)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryLastValsetRequestsResponse{Valsets: valsets, Pagination: pageRes}, nil
+}
+
+// Valsets queries the valsets in a nonce range, lowest nonce first
+func (k Keeper) Valsets(
+	c context.Context,
+	req *types.QueryValsetsRequest) (*types.QueryValsetsResponse, error) {
+	if req.EndNonce != 0 && req.EndNonce < req.StartNonce {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "end nonce below start nonce")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
+
+	// the end of the range is exclusive, an end nonce at the highest nonce leaves it open
+	var end []byte
+	if req.EndNonce != 0 && req.EndNonce != math.MaxUint64 {
+		end = types.UInt64Bytes(req.EndNonce + 1)
+	}
+
+	var valsets []*types.Valset
+	pageRes, err := rangePaginate(store, types.UInt64Bytes(req.StartNonce), end, limitPageRequest(req.Pagination, MaxResults), func(_, value []byte) error {
+		var valset types.Valset
+		if err := k.cdc.UnmarshalBinaryBare(value, &valset); err != nil {
+			return err
+		}
+		valsets = append(valsets, &valset)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryValsetsResponse{Valsets: valsets, Pagination: pageRes}, nil
+}
+
+// CheckpointInfo queries whether an Ethereum signature checkpoint was produced by this chain, and from
+// which valset, batch or logic call
+func (k Keeper) CheckpointInfo(
+	c context.Context,
+	req *types.QueryCheckpointInfoRequest) (*types.QueryCheckpointInfoResponse, error) {
+	checkpoint, err := hex.DecodeString(strings.TrimPrefix(req.Checkpoint, "0x"))
+	if err != nil || len(checkpoint) != 32 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "checkpoint must be 32 hex encoded bytes")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryCheckpointInfoResponse{
+		Legitimate: k.GetPastEthSignatureCheckpoint(ctx, checkpoint),
+		Info:       k.GetPastEthSignatureCheckpointInfo(ctx, checkpoint),
+	}, nil
 }
 
 // LastPendingValsetRequestByAddr queries the LastPendingValsetRequestByAddr of the gravity module
@@ -104,7 +173,11 @@ func (k Keeper) LastPendingValsetRequestByAddr(
 func (k Keeper) BatchFees(
 	c context.Context,
 	req *types.QueryBatchFeeRequest) (*types.QueryBatchFeeResponse, error) {
-	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(sdk.UnwrapSDKContext(c), OutgoingTxBatchSize)}, nil
+	batchFees, pageRes, err := k.PaginateBatchFees(sdk.UnwrapSDKContext(c), limitPageRequest(req.Pagination, MaxResults))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBatchFeeResponse{BatchFees: batchFees, Pagination: pageRes}, nil
 }
 
 // LastPendingBatchRequestByAddr queries the LastPendingBatchRequestByAddr of the gravity module
@@ -154,24 +227,44 @@ func (k Keeper) LastPendingLogicCallByAddr(
 func (k Keeper) OutgoingTxBatches(
 	c context.Context,
 	req *types.QueryOutgoingTxBatchesRequest) (*types.QueryOutgoingTxBatchesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXBatchKey)
+
 	var batches []*types.OutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch *types.OutgoingTxBatch) bool {
-		batches = append(batches, batch)
-		return len(batches) == MaxResults
+	pageRes, err := reversePaginate(store, limitPageRequest(req.Pagination, MaxResults), func(_, value []byte) error {
+		var batch types.OutgoingTxBatch
+		if err := k.cdc.UnmarshalBinaryBare(value, &batch); err != nil {
+			return err
+		}
+		batches = append(batches, &batch)
+		return nil
 	})
-	return &types.QueryOutgoingTxBatchesResponse{Batches: batches}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryOutgoingTxBatchesResponse{Batches: batches, Pagination: pageRes}, nil
 }
 
 // OutgoingLogicCalls queries the OutgoingLogicCalls of the gravity module
 func (k Keeper) OutgoingLogicCalls(
 	c context.Context,
 	req *types.QueryOutgoingLogicCallsRequest) (*types.QueryOutgoingLogicCallsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOutgoingLogicCall)
+
 	var calls []*types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, call *types.OutgoingLogicCall) bool {
-		calls = append(calls, call)
-		return len(calls) == MaxResults
+	pageRes, err := query.Paginate(store, limitPageRequest(req.Pagination, MaxResults), func(_, value []byte) error {
+		var call types.OutgoingLogicCall
+		if err := k.cdc.UnmarshalBinaryBare(value, &call); err != nil {
+			return err
+		}
+		calls = append(calls, &call)
+		return nil
 	})
-	return &types.QueryOutgoingLogicCallsResponse{Calls: calls}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryOutgoingLogicCallsResponse{Calls: calls, Pagination: pageRes}, nil
 }
 
 // BatchRequestByNonce queries the BatchRequestByNonce of the gravity module
@@ -192,13 +285,27 @@ func (k Keeper) BatchRequestByNonce(
 func (k Keeper) BatchConfirms(
 	c context.Context,
 	req *types.QueryBatchConfirmsRequest) (*types.QueryBatchConfirmsResponse, error) {
+	contract, err := types.NewEthAddress(req.ContractAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchConfirmKey),
+		append([]byte(contract.GetAddress()), types.UInt64Bytes(req.Nonce)...))
+
 	var confirms []*types.MsgConfirmBatch
-	k.IterateBatchConfirmByNonceAndTokenContract(sdk.UnwrapSDKContext(c),
-		req.Nonce, req.ContractAddress, func(_ []byte, c types.MsgConfirmBatch) bool {
-			confirms = append(confirms, &c)
-			return false
-		})
-	return &types.QueryBatchConfirmsResponse{Confirms: confirms}, nil
+	pageRes, err := query.Paginate(store, limitPageRequest(req.Pagination, MaxResults), func(_, value []byte) error {
+		var confirm types.MsgConfirmBatch
+		if err := k.cdc.UnmarshalBinaryBare(value, &confirm); err != nil {
+			return err
+		}
+		confirms = append(confirms, &confirm)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryBatchConfirmsResponse{Confirms: confirms, Pagination: pageRes}, nil
 }
 
 // LogicConfirms returns the Logic confirmations by nonce and token contract
@@ -244,6 +351,9 @@ func (k Keeper) DenomToERC20(
 	var ret types.QueryDenomToERC20Response
 	ret.Erc20 = erc20
 	ret.CosmosOriginated = cosmosOriginated
+	if err == nil {
+		ret.Exponent = k.GetTokenScaling(ctx, erc20).Exponent
+	}
 
 	return &ret, err
 }
@@ -257,6 +367,7 @@ func (k Keeper) ERC20ToDenom(
 	var ret types.QueryERC20ToDenomResponse
 	ret.Denom = name
 	ret.CosmosOriginated = cosmosOriginated
+	ret.Exponent = k.GetTokenScaling(ctx, req.Erc20).Exponent
 
 	return &ret, nil
 }
@@ -312,11 +423,12 @@ func (k Keeper) GetDelegateKeyByEth(
 	req *types.QueryDelegateKeysByEthAddress) (*types.QueryDelegateKeysByEthAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	keys := k.GetDelegateKeys(ctx)
-	if err := types.ValidateEthAddress(req.EthAddress); err != nil {
+	ethAddress, err := types.NewEthAddress(req.EthAddress)
+	if err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid eth address")
 	}
 	for _, key := range keys {
-		if req.EthAddress == key.EthAddress {
+		if ethAddress.GetAddress() == key.EthAddress {
 			return &types.QueryDelegateKeysByEthAddressResponse{
 				ValidatorAddress:    key.Validator,
 				OrchestratorAddress: key.Orchestrator,
@@ -331,24 +443,116 @@ func (k Keeper) GetPendingSendToEth(
 	c context.Context,
 	req *types.QueryPendingSendToEth) (*types.QueryPendingSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	batches := k.GetOutgoingTxBatches(ctx)
-	unbatchedTx := k.GetPoolTransactions(ctx)
-	senderAddress := req.SenderAddress
-	var res *types.QueryPendingSendToEthResponse
-
-	for _, batch := range batches {
-		for _, tx := range batch.Transactions {
-			if tx.Sender == senderAddress {
-				res.TransfersInBatches = append(res.TransfersInBatches, tx)
+	if _, err := sdk.AccAddressFromBech32(req.SenderAddress); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.SenderAddress)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXPoolKey)
+
+	res := &types.QueryPendingSendToEthResponse{}
+	pageRes, err := query.FilteredPaginate(store, limitPageRequest(req.Pagination, MaxResults), func(_, value []byte, accumulate bool) (bool, error) {
+		var tx types.OutgoingTransferTx
+		if err := k.cdc.UnmarshalBinaryBare(value, &tx); err != nil {
+			return false, err
+		}
+		if tx.Sender != req.SenderAddress {
+			return false, nil
+		}
+		if accumulate {
+			if k.isUnbatched(ctx, &tx) {
+				res.UnbatchedTransfers = append(res.UnbatchedTransfers, &tx)
+			} else {
+				res.TransfersInBatches = append(res.TransfersInBatches, &tx)
 			}
 		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	res.Pagination = pageRes
+	return res, nil
+}
 
-	for _, tx := range unbatchedTx {
-		if tx.Sender == senderAddress {
-			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx)
-		}
+// Attestations queries the attestations at a given event nonce, along with their tallies
+func (k Keeper) Attestations(
+	c context.Context,
+	req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
+	return &types.QueryAttestationsResponse{Attestations: k.GetAttestationsByNonce(sdk.UnwrapSDKContext(c), req.Nonce)}, nil
+}
+
+// ValsetRelayBundle returns a valset with the signatures of the last observed valset over it
+func (k Keeper) ValsetRelayBundle(
+	c context.Context,
+	req *types.QueryValsetRelayBundleRequest) (*types.QueryValsetRelayBundleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valset := k.GetValset(ctx, req.Nonce)
+	if valset == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find valset")
+	}
+	signatures := make(map[string]string)
+	for _, confirm := range k.GetValsetConfirms(ctx, req.Nonce) {
+		signatures[confirm.EthAddress] = confirm.Signature
+	}
+	bundle, err := k.relayBundle(ctx, signatures)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValsetRelayBundleResponse{Valset: valset, Bundle: bundle}, nil
+}
+
+// BatchRelayBundle returns a batch with the signatures of the last observed valset over it
+func (k Keeper) BatchRelayBundle(
+	c context.Context,
+	req *types.QueryBatchRelayBundleRequest) (*types.QueryBatchRelayBundleResponse, error) {
+	if err := types.ValidateEthAddress(req.TokenContract); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	batch := k.GetOutgoingTXBatch(ctx, req.TokenContract, req.Nonce)
+	if batch == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find tx batch")
 	}
+	signatures := make(map[string]string)
+	for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, req.Nonce, req.TokenContract) {
+		signatures[confirm.EthSigner] = confirm.Signature
+	}
+	bundle, err := k.relayBundle(ctx, signatures)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBatchRelayBundleResponse{Batch: batch, Bundle: bundle}, nil
+}
 
-	return res, nil
+// LogicCallRelayBundle returns a logic call with the signatures of the last observed valset over it
+func (k Keeper) LogicCallRelayBundle(
+	c context.Context,
+	req *types.QueryLogicCallRelayBundleRequest) (*types.QueryLogicCallRelayBundleResponse, error) {
+	invalidationID, err := hex.DecodeString(req.InvalidationId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalidation id encoding")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	call := k.GetOutgoingLogicCall(ctx, invalidationID, req.InvalidationNonce)
+	if call == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find logic call")
+	}
+	signatures := make(map[string]string)
+	for _, confirm := range k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, req.InvalidationNonce) {
+		signatures[confirm.EthSigner] = confirm.Signature
+	}
+	bundle, err := k.relayBundle(ctx, signatures)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLogicCallRelayBundleResponse{LogicCall: call, Bundle: bundle}, nil
+}
+
+// relayBundle lines the signatures up with the last observed valset, the one the Gravity contract
+// checks them against
+func (k Keeper) relayBundle(ctx sdk.Context, signatures map[string]string) (types.RelayBundle, error) {
+	currentValset := k.GetLastObservedValset(ctx)
+	if currentValset == nil {
+		return types.RelayBundle{}, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no valset observed on Ethereum yet")
+	}
+	return types.NewRelayBundle(currentValset, signatures), nil
 }
@@ -0,0 +1,306 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestLastValsetRequestsPagination(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	for i := uint64(1); i <= 8; i++ {
+		k.StoreValsetUnsafe(ctx, &types.Valset{Nonce: i, Height: i})
+	}
+	nonces := func(valsets []*types.Valset) (out []uint64) {
+		for _, v := range valsets {
+			out = append(out, v.Nonce)
+		}
+		return
+	}
+
+	// without pagination the five latest valsets are returned, latest first
+	res, err := k.LastValsetRequests(sdk.WrapSDKContext(ctx), &types.QueryLastValsetRequestsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{8, 7, 6, 5, 4}, nonces(res.Valsets))
+
+	// the next key continues where the page stopped
+	res, err = k.LastValsetRequests(sdk.WrapSDKContext(ctx), &types.QueryLastValsetRequestsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 5},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 2, 1}, nonces(res.Valsets))
+	assert.Nil(t, res.Pagination.NextKey)
+
+	res, err = k.LastValsetRequests(sdk.WrapSDKContext(ctx), &types.QueryLastValsetRequestsRequest{
+		Pagination: &query.PageRequest{Offset: 6, CountTotal: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 1}, nonces(res.Valsets))
+	assert.Equal(t, uint64(8), res.Pagination.Total)
+}
+
+func TestBatchFeesPagination(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	mySender := AccAddrs[0]
+	myReceiver := EthAddrs[1].String()
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+
+	var vouchers sdk.Coins
+	for _, token := range TokenContractAddrs[:3] {
+		k.RegisterDenomTrace(ctx, token)
+		vouchers = vouchers.Add(sdk.NewCoin(types.GravityDenom(token), sdk.NewInt(99999)))
+	}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, vouchers))
+	for i, token := range TokenContractAddrs[:3] {
+		for _, fee := range []uint64{1, 2} {
+			amount := sdk.NewCoin(types.GravityDenom(token), sdk.NewInt(100))
+			_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver, amount, sdk.NewCoin(types.GravityDenom(token), sdk.NewIntFromUint64(fee*uint64(i+1))))
+			require.NoError(t, err)
+		}
+	}
+	all := k.GetAllBatchFees(ctx, OutgoingTxBatchSize)
+	require.Len(t, all, 3)
+
+	res, err := k.BatchFees(sdk.WrapSDKContext(ctx), &types.QueryBatchFeeRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, all[:2], res.BatchFees)
+	assert.Equal(t, []byte(all[2].Token), res.Pagination.NextKey)
+
+	res, err = k.BatchFees(sdk.WrapSDKContext(ctx), &types.QueryBatchFeeRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, all[2:], res.BatchFees)
+	assert.Nil(t, res.Pagination.NextKey)
+
+	res, err = k.BatchFees(sdk.WrapSDKContext(ctx), &types.QueryBatchFeeRequest{
+		Pagination: &query.PageRequest{Offset: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, all[1:], res.BatchFees)
+	assert.Equal(t, uint64(3), res.Pagination.Total)
+}
+
+func TestGetPendingSendToEth(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	mySender, otherSender := AccAddrs[0], AccAddrs[1]
+	myReceiver := EthAddrs[1].String()
+	token := TokenContractAddrs[0]
+
+	vouchers := sdk.NewCoins(sdk.NewCoin(types.GravityDenom(token), sdk.NewInt(99999)))
+	k.RegisterDenomTrace(ctx, token)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers.Add(vouchers...)))
+	for _, sender := range []sdk.AccAddress{mySender, otherSender} {
+		input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+		require.NoError(t, input.BankKeeper.SetBalances(ctx, sender, vouchers))
+	}
+	for i, fee := range []uint64{4, 3, 2, 1} {
+		sender := mySender
+		if i == 1 {
+			sender = otherSender
+		}
+		amount := sdk.NewCoin(types.GravityDenom(token), sdk.NewInt(100))
+		_, err := k.AddToOutgoingPool(ctx, sender, myReceiver, amount, sdk.NewCoin(types.GravityDenom(token), sdk.NewIntFromUint64(fee)))
+		require.NoError(t, err)
+	}
+	// the two highest fees are batched, one of them is mySender's
+	_, err := k.BuildOutgoingTXBatch(ctx, token, 2)
+	require.NoError(t, err)
+
+	res, err := k.GetPendingSendToEth(sdk.WrapSDKContext(ctx), &types.QueryPendingSendToEth{SenderAddress: mySender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.TransfersInBatches, 1)
+	assert.Equal(t, uint64(1), res.TransfersInBatches[0].Id)
+	require.Len(t, res.UnbatchedTransfers, 2)
+	assert.Equal(t, uint64(3), res.UnbatchedTransfers[0].Id)
+	assert.Equal(t, uint64(4), res.UnbatchedTransfers[1].Id)
+
+	// pages only count the sender's transfers
+	res, err = k.GetPendingSendToEth(sdk.WrapSDKContext(ctx), &types.QueryPendingSendToEth{
+		SenderAddress: mySender.String(),
+		Pagination:    &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	assert.Len(t, res.TransfersInBatches, 1)
+	assert.Len(t, res.UnbatchedTransfers, 1)
+	assert.NotNil(t, res.Pagination.NextKey)
+
+	_, err = k.GetPendingSendToEth(sdk.WrapSDKContext(ctx), &types.QueryPendingSendToEth{SenderAddress: "invalid"})
+	require.Error(t, err)
+}
+
+func TestLimitPageRequest(t *testing.T) {
+	assert.Equal(t, uint64(MaxResults), limitPageRequest(nil, MaxResults).Limit)
+	assert.Equal(t, uint64(MaxResults), limitPageRequest(&query.PageRequest{Offset: 3}, MaxResults).Limit)
+	assert.Equal(t, uint64(MaxPageLimit), limitPageRequest(&query.PageRequest{Limit: MaxPageLimit + 1}, MaxResults).Limit)
+	assert.Equal(t, uint64(7), limitPageRequest(&query.PageRequest{Limit: 7}, MaxResults).Limit)
+}
+
+func TestValsetsQuery(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	for i := uint64(1); i <= 8; i++ {
+		k.StoreValsetUnsafe(ctx, &types.Valset{Nonce: i, Height: i})
+	}
+	nonces := func(valsets []*types.Valset) (out []uint64) {
+		for _, v := range valsets {
+			out = append(out, v.Nonce)
+		}
+		return
+	}
+
+	res, err := k.Valsets(sdk.WrapSDKContext(ctx), &types.QueryValsetsRequest{StartNonce: 3, EndNonce: 6})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4, 5, 6}, nonces(res.Valsets))
+	assert.Nil(t, res.Pagination.NextKey)
+
+	// an open range pages up to the latest valset
+	res, err = k.Valsets(sdk.WrapSDKContext(ctx), &types.QueryValsetsRequest{
+		StartNonce: 2,
+		Pagination: &query.PageRequest{Limit: 4, CountTotal: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 3, 4, 5}, nonces(res.Valsets))
+	assert.Equal(t, uint64(7), res.Pagination.Total)
+
+	res, err = k.Valsets(sdk.WrapSDKContext(ctx), &types.QueryValsetsRequest{
+		StartNonce: 2,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 4},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{6, 7, 8}, nonces(res.Valsets))
+	assert.Nil(t, res.Pagination.NextKey)
+
+	_, err = k.Valsets(sdk.WrapSDKContext(ctx), &types.QueryValsetsRequest{StartNonce: 6, EndNonce: 3})
+	require.Error(t, err)
+}
+
+func TestCheckpointInfoQuery(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	gravityID := k.GetGravityID(ctx)
+	mySender := AccAddrs[0]
+	token := TokenContractAddrs[0]
+
+	vouchers := sdk.NewCoins(sdk.NewCoin(types.GravityDenom(token), sdk.NewInt(99999)))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	k.RegisterDenomTrace(ctx, token)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, vouchers))
+	_, err := k.AddToOutgoingPool(ctx, mySender, EthAddrs[1].String(), sdk.NewCoin(types.GravityDenom(token), sdk.NewInt(100)), sdk.NewCoin(types.GravityDenom(token), sdk.NewInt(1)))
+	require.NoError(t, err)
+	batch, err := k.BuildOutgoingTXBatch(ctx, token, 10)
+	require.NoError(t, err)
+	call := &types.OutgoingLogicCall{
+		LogicContractAddress: token,
+		InvalidationId:       []byte("invalidation id"),
+		InvalidationNonce:    3,
+	}
+	k.SetOutgoingLogicCall(ctx, call)
+	valset := k.SetValsetRequest(ctx)
+
+	checkpointInfo := func(checkpoint string) *types.QueryCheckpointInfoResponse {
+		res, err := k.CheckpointInfo(sdk.WrapSDKContext(ctx), &types.QueryCheckpointInfoRequest{Checkpoint: checkpoint})
+		require.NoError(t, err)
+		return res
+	}
+
+	res := checkpointInfo(hex.EncodeToString(batch.GetCheckpoint(gravityID)))
+	assert.True(t, res.Legitimate)
+	require.NotNil(t, res.Info)
+	assert.Equal(t, types.CHECKPOINT_TYPE_BATCH, res.Info.Type)
+	assert.Equal(t, batch.BatchNonce, res.Info.Nonce)
+	assert.Equal(t, token, res.Info.TokenContract)
+
+	res = checkpointInfo("0x" + hex.EncodeToString(call.GetCheckpoint(gravityID)))
+	assert.True(t, res.Legitimate)
+	require.NotNil(t, res.Info)
+	assert.Equal(t, types.CHECKPOINT_TYPE_LOGIC_CALL, res.Info.Type)
+	assert.Equal(t, call.InvalidationNonce, res.Info.Nonce)
+	assert.Equal(t, call.InvalidationId, res.Info.InvalidationId)
+
+	res = checkpointInfo(hex.EncodeToString(valset.GetCheckpoint(gravityID)))
+	assert.True(t, res.Legitimate)
+	require.NotNil(t, res.Info)
+	assert.Equal(t, types.CHECKPOINT_TYPE_VALSET, res.Info.Type)
+	assert.Equal(t, valset.Nonce, res.Info.Nonce)
+
+	// checkpoints imported without a record are legitimate without info
+	imported := make([]byte, 32)
+	imported[0] = 1
+	k.SetPastEthSignatureCheckpoint(ctx, imported)
+	res = checkpointInfo(hex.EncodeToString(imported))
+	assert.True(t, res.Legitimate)
+	assert.Nil(t, res.Info)
+
+	res = checkpointInfo(hex.EncodeToString(make([]byte, 32)))
+	assert.False(t, res.Legitimate)
+	assert.Nil(t, res.Info)
+
+	_, err = k.CheckpointInfo(sdk.WrapSDKContext(ctx), &types.QueryCheckpointInfoRequest{Checkpoint: "0x1234"})
+	require.Error(t, err)
+}
+
+func TestRelayBundleQueries(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	token := TokenContractAddrs[0]
+	valset := &types.Valset{Nonce: 2, Height: 1, RewardAmount: sdk.ZeroInt()}
+	k.StoreValsetUnsafe(ctx, valset)
+	batch := &types.OutgoingTxBatch{BatchNonce: 1, TokenContract: token}
+	k.StoreBatchUnsafe(ctx, batch)
+
+	_, err := k.ValsetRelayBundle(sdk.WrapSDKContext(ctx), &types.QueryValsetRelayBundleRequest{Nonce: 2})
+	require.Error(t, err, "no valset observed yet")
+
+	var members []*types.BridgeValidator
+	for _, addr := range EthAddrs[:3] {
+		members = append(members, &types.BridgeValidator{Power: math.MaxUint32 / 3, EthereumAddress: addr.String()})
+	}
+	k.SetLastObservedValset(ctx, types.Valset{Nonce: 1, Members: members, RewardAmount: sdk.ZeroInt()})
+	signature := hex.EncodeToString(append(make([]byte, 64), 0x1))
+	for i, addr := range EthAddrs[:2] {
+		k.SetValsetConfirm(ctx, types.MsgValsetConfirm{Nonce: 2, Orchestrator: AccAddrs[i].String(), EthAddress: addr.String(), Signature: signature})
+	}
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{Nonce: 1, TokenContract: token, EthSigner: EthAddrs[2].String(), Orchestrator: AccAddrs[2].String(), Signature: signature})
+
+	valsetRes, err := k.ValsetRelayBundle(sdk.WrapSDKContext(ctx), &types.QueryValsetRelayBundleRequest{Nonce: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), valsetRes.Valset.Nonce)
+	assert.Equal(t, uint64(1), valsetRes.Bundle.CurrentValset.Nonce)
+	require.Len(t, valsetRes.Bundle.Signatures, 3)
+	assert.Equal(t, uint32(28), valsetRes.Bundle.Signatures[0].V)
+	assert.Empty(t, valsetRes.Bundle.Signatures[2].R)
+	assert.True(t, valsetRes.Bundle.ThresholdMet)
+
+	batchRes, err := k.BatchRelayBundle(sdk.WrapSDKContext(ctx), &types.QueryBatchRelayBundleRequest{TokenContract: token, Nonce: 1})
+	require.NoError(t, err)
+	assert.Equal(t, batch.BatchNonce, batchRes.Batch.BatchNonce)
+	assert.Empty(t, batchRes.Bundle.Signatures[0].R)
+	assert.NotEmpty(t, batchRes.Bundle.Signatures[2].R)
+	assert.False(t, batchRes.Bundle.ThresholdMet)
+
+	_, err = k.BatchRelayBundle(sdk.WrapSDKContext(ctx), &types.QueryBatchRelayBundleRequest{TokenContract: token, Nonce: 2})
+	require.Error(t, err)
+	_, err = k.LogicCallRelayBundle(sdk.WrapSDKContext(ctx), &types.QueryLogicCallRelayBundleRequest{InvalidationId: "00", InvalidationNonce: 1})
+	require.Error(t, err)
+}
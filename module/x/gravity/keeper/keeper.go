@@ -219,12 +219,13 @@ func (k Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
-// GetUnSlashedLogicCalls returns all the unslashed logic calls in state
+// GetUnSlashedLogicCalls returns the unslashed logic calls in state that were made before maxHeight,
+// the ones made since are still inside the signing window
 func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, maxHeight uint64) (out []*types.OutgoingLogicCall) {
 	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx)
 	calls := k.GetOutgoingLogicCalls(ctx)
 	for _, call := range calls {
-		if call.Block > lastSlashedLogicCallBlock {
+		if call.Block > lastSlashedLogicCallBlock && call.Block < maxHeight {
 			out = append(out, call)
 		}
 	}
@@ -278,3 +279,8 @@ func (k Keeper) DeserializeValidatorIterator(vals []byte) stakingtypes.ValAddres
 	k.cdc.MustUnmarshalBinaryBare(vals, &validators)
 	return validators
 }
+
+// Codec returns the codec used by the keeper to encode the gravity store
+func (k Keeper) Codec() codec.BinaryMarshaler {
+	return k.cdc
+}
@@ -13,8 +13,12 @@ import (
 
 // GetBatchConfirm returns a batch confirmation given its nonce, the token contract, and a validator address
 func (k Keeper) GetBatchConfirm(ctx sdk.Context, nonce uint64, tokenContract string, validator sdk.AccAddress) *types.MsgConfirmBatch {
+	contract, err := types.NewEthAddress(tokenContract)
+	if err != nil {
+		return nil
+	}
 	store := ctx.KVStore(k.storeKey)
-	entity := store.Get(types.GetBatchConfirmKey(tokenContract, nonce, validator))
+	entity := store.Get(types.GetBatchConfirmKey(*contract, nonce, validator))
 	if entity == nil {
 		return nil
 	}
@@ -30,7 +34,9 @@ func (k Keeper) SetBatchConfirm(ctx sdk.Context, batch *types.MsgConfirmBatch) [
 	if err != nil {
 		panic(err)
 	}
-	key := types.GetBatchConfirmKey(batch.TokenContract, batch.Nonce, acc)
+	contract := types.MustNewEthAddress(batch.TokenContract)
+	batch.TokenContract = contract.GetAddress()
+	key := types.GetBatchConfirmKey(contract, batch.Nonce, acc)
 	store.Set(key, k.cdc.MustMarshalBinaryBare(batch))
 	return key
 }
@@ -39,8 +45,12 @@ func (k Keeper) SetBatchConfirm(ctx sdk.Context, batch *types.MsgConfirmBatch) [
 // MARK finish-batches: this is where the key is iterated in the old (presumed working) code
 // TODO: specify which nonce this is
 func (k Keeper) IterateBatchConfirmByNonceAndTokenContract(ctx sdk.Context, nonce uint64, tokenContract string, cb func([]byte, types.MsgConfirmBatch) bool) {
+	contract, err := types.NewEthAddress(tokenContract)
+	if err != nil {
+		return
+	}
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchConfirmKey)
-	prefix := append([]byte(tokenContract), types.UInt64Bytes(nonce)...)
+	prefix := append([]byte(contract.GetAddress()), types.UInt64Bytes(nonce)...)
 	iter := prefixStore.Iterator(prefixRange(prefix))
 	defer iter.Close()
 
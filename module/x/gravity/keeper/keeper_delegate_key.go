@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -32,30 +34,46 @@ func (k Keeper) GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) (
 	return validator, true
 }
 
+// IterateOrchestratorValidators iterates over every orchestrator key and the validator it's associated with
+func (k Keeper) IterateOrchestratorValidators(ctx sdk.Context, cb func(orch sdk.AccAddress, val sdk.ValAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOrchestratorAddress)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(sdk.AccAddress(iter.Key()), sdk.ValAddress(iter.Value())) {
+			break
+		}
+	}
+}
+
 /////////////////////////////
 //       ETH ADDRESS       //
 /////////////////////////////
 
 // SetEthAddress sets the ethereum address for a given validator
-func (k Keeper) SetEthAddressForValidator(ctx sdk.Context, validator sdk.ValAddress, ethAddr string) {
+func (k Keeper) SetEthAddressForValidator(ctx sdk.Context, validator sdk.ValAddress, ethAddr types.EthAddress) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetEthAddressByValidatorKey(validator), []byte(ethAddr))
+	store.Set(types.GetEthAddressByValidatorKey(validator), []byte(ethAddr.GetAddress()))
 	store.Set(types.GetValidatorByEthAddressKey(ethAddr), []byte(validator))
 }
 
 // GetEthAddressByValidator returns the eth address for a given gravity validator
-func (k Keeper) GetEthAddressByValidator(ctx sdk.Context, validator sdk.ValAddress) (ethAddress string, found bool) {
+func (k Keeper) GetEthAddressByValidator(ctx sdk.Context, validator sdk.ValAddress) (ethAddress *types.EthAddress, found bool) {
 	store := ctx.KVStore(k.storeKey)
 	ethAddr := store.Get(types.GetEthAddressByValidatorKey(validator))
 	if ethAddr == nil {
-		return "", false
-	} else {
-		return string(ethAddr), true
+		return nil, false
+	}
+	ethAddress, err := types.NewEthAddress(string(ethAddr))
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "stored eth address of %s", validator))
 	}
+	return ethAddress, true
 }
 
 // GetValidatorByEthAddress returns the validator for a given eth address
-func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr string) (validator stakingtypes.Validator, found bool) {
+func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr types.EthAddress) (validator stakingtypes.Validator, found bool) {
 	store := ctx.KVStore(k.storeKey)
 	valAddr := store.Get(types.GetValidatorByEthAddressKey(ethAddr))
 	if valAddr == nil {
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
@@ -14,11 +15,15 @@ import (
 //       LOGICCALLS        //
 /////////////////////////////
 
-// GetOutgoingLogicCall gets an outgoing logic call
+// GetOutgoingLogicCall gets an outgoing logic call, returns nil when it doesn't exist
 func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) *types.OutgoingLogicCall {
 	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+	if bz == nil {
+		return nil
+	}
 	call := types.OutgoingLogicCall{}
-	k.cdc.MustUnmarshalBinaryBare(store.Get(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce)), &call)
+	k.cdc.MustUnmarshalBinaryBare(bz, &call)
 	return &call
 }
 
@@ -27,8 +32,13 @@ func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicC
 	store := ctx.KVStore(k.storeKey)
 
 	// Store checkpoint to prove that this logic call actually happened
-	checkpoint := call.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	k.SetPastEthSignatureCheckpointInfo(ctx, types.PastEthSignatureCheckpoint{
+		Checkpoint:     call.GetCheckpoint(k.GetGravityID(ctx)),
+		Type:           types.CHECKPOINT_TYPE_LOGIC_CALL,
+		Nonce:          call.InvalidationNonce,
+		InvalidationId: call.InvalidationId,
+		Height:         uint64(ctx.BlockHeight()),
+	})
 
 	store.Set(types.GetOutgoingLogicCallKey(call.InvalidationId, call.InvalidationNonce),
 		k.cdc.MustMarshalBinaryBare(call))
@@ -36,7 +46,46 @@ func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicC
 
 // DeleteOutgoingLogicCall deletes outgoing logic calls
 func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
-	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+
+	// confirms are only used while the logic call is stored, they'd never be read again
+	var confirmKeys [][]byte
+	k.IterateLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, invalidationNonce, func(key []byte, _ *types.MsgConfirmLogicCall) bool {
+		confirmKeys = append(confirmKeys, key)
+		return false
+	})
+	confirmStore := prefix.NewStore(store, types.KeyOutgoingLogicConfirm)
+	for _, key := range confirmKeys {
+		confirmStore.Delete(key)
+	}
+}
+
+// OutgoingLogicCallExecuted is run when the Cosmos chain detects that a logic call has been executed on Ethereum.
+// It deletes the call, then cancels the calls with the same invalidation id and a lower nonce, since the Gravity
+// contract won't execute those any longer
+func (k Keeper) OutgoingLogicCallExecuted(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
+	var invalidated []*types.OutgoingLogicCall
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+		if bytes.Equal(call.InvalidationId, invalidationID) && call.InvalidationNonce < invalidationNonce {
+			invalidated = append(invalidated, call)
+		}
+		return false
+	})
+	for _, call := range invalidated {
+		if err := k.CancelOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce); err != nil {
+			panic(fmt.Sprintf("Failed cancel out logic call %x %d while trying to execute %x %d with %s",
+				call.InvalidationId, call.InvalidationNonce, invalidationID, invalidationNonce, err))
+		}
+	}
+	k.DeleteOutgoingLogicCall(ctx, invalidationID, invalidationNonce)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOutgoingLogicCallExecuted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyInvalidationID, fmt.Sprint(invalidationID)),
+		sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(invalidationNonce)),
+	))
 }
 
 // IterateOutgoingLogicCalls iterates over outgoing logic calls
@@ -72,7 +72,7 @@ func TestCurrentValsetNormalization(t *testing.T) {
 					Operator: cAddr,
 					Power:    int64(v),
 				}
-				input.GravityKeeper.SetEthAddressForValidator(ctx, cAddr, "0xf71402f886b45c134743F4c00750823Bbf5Fd045")
+				input.GravityKeeper.SetEthAddressForValidator(ctx, cAddr, types.MustNewEthAddress("0xf71402f886b45c134743F4c00750823Bbf5Fd045"))
 			}
 			input.GravityKeeper.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
 			r := input.GravityKeeper.GetCurrentValset(ctx)
@@ -81,6 +81,51 @@ func TestCurrentValsetNormalization(t *testing.T) {
 	}
 }
 
+func TestCurrentValsetCap(t *testing.T) {
+	specs := map[string]struct {
+		maxValidators uint64
+		minShare      sdk.Dec
+		expMembers    int
+	}{
+		"uncapped":            {maxValidators: 0, minShare: sdk.OneDec(), expMembers: 4},
+		"capped":              {maxValidators: 2, minShare: sdk.ZeroDec(), expMembers: 2},
+		"min share not met":   {maxValidators: 2, minShare: sdk.NewDecWithPrec(9, 1), expMembers: 3},
+		"min share met":       {maxValidators: 2, minShare: sdk.NewDecWithPrec(8, 1), expMembers: 2},
+		"fewer than the cap":  {maxValidators: 5, minShare: sdk.OneDec(), expMembers: 4},
+		"all needed for 100%": {maxValidators: 1, minShare: sdk.OneDec(), expMembers: 4},
+	}
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	powers := []int64{50, 30, 10, 10}
+	operators := make([]MockStakingValidatorData, len(powers))
+	for i, power := range powers {
+		operators[i] = MockStakingValidatorData{Operator: ValAddrs[i], Power: power}
+		k.SetEthAddressForValidator(ctx, ValAddrs[i], types.MustNewEthAddress(EthAddrs[i].String()))
+	}
+	k.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
+
+	for msg, spec := range specs {
+		spec := spec
+		t.Run(msg, func(t *testing.T) {
+			params := k.GetParams(ctx)
+			params.MaxBridgeValidators = spec.maxValidators
+			params.MinBridgePowerShare = spec.minShare
+			k.SetParams(ctx, params)
+
+			valset := k.GetCurrentValset(ctx)
+			require.Len(t, valset.Members, spec.expMembers)
+			// the most powerful validators are kept and their power is normalized among them
+			assert.True(t, valset.HasMember(types.MustNewEthAddress(EthAddrs[0].String())))
+			var total uint64
+			for _, member := range valset.Members {
+				total += member.Power
+			}
+			assert.InDelta(t, uint64(4294967295), total, float64(len(valset.Members)))
+		})
+	}
+}
+
 func TestAttestationIterator(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
@@ -148,7 +193,7 @@ func TestDelegateKeys(t *testing.T) {
 		// set the orchestrator address
 		k.SetOrchestratorValidator(ctx, val, orch)
 		// set the ethereum address
-		k.SetEthAddressForValidator(ctx, val, ethAddrs[i])
+		k.SetEthAddressForValidator(ctx, val, types.MustNewEthAddress(ethAddrs[i]))
 	}
 
 	addresses := k.GetDelegateKeys(ctx)
@@ -209,3 +254,77 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	assert.Equal(t, len(unslashedValsets), 6)
 	fmt.Println("unslashedValsetsRange", unslashedValsets)
 }
+
+func TestLastSlashedLogicCallBlock(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	for i := 1; i <= 3; i++ {
+		k.SetOutgoingLogicCall(ctx, &types.OutgoingLogicCall{
+			Transfers:            []*types.ERC20Token{types.NewERC20Token(100, TokenContractAddrs[0])},
+			Fees:                 []*types.ERC20Token{types.NewERC20Token(1, TokenContractAddrs[0])},
+			LogicContractAddress: EthAddrs[0].String(),
+			InvalidationId:       []byte("invalidation id"),
+			InvalidationNonce:    uint64(i),
+			Block:                uint64(i * 10),
+		})
+	}
+
+	// logic calls made at maxHeight are still inside the signing window
+	assert.Empty(t, k.GetUnSlashedLogicCalls(ctx, 10))
+	assert.Len(t, k.GetUnSlashedLogicCalls(ctx, 11), 1)
+	assert.Len(t, k.GetUnSlashedLogicCalls(ctx, 30), 2)
+	assert.Len(t, k.GetUnSlashedLogicCalls(ctx, 31), 3)
+
+	// logic calls at or before the last slashed block are never slashed again
+	k.SetLastSlashedLogicCallBlock(ctx, 20)
+	unslashed := k.GetUnSlashedLogicCalls(ctx, 31)
+	require.Len(t, unslashed, 1)
+	assert.Equal(t, uint64(30), unslashed[0].Block)
+}
+
+func TestLogicCallExecuted(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	invalidationID := []byte("invalidation")
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		k.SetOutgoingLogicCall(ctx, &types.OutgoingLogicCall{InvalidationId: invalidationID, InvalidationNonce: nonce, Block: 1})
+		k.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+			InvalidationId:    fmt.Sprintf("%x", invalidationID),
+			InvalidationNonce: nonce,
+			Orchestrator:      AccAddrs[0].String(),
+		})
+	}
+	other := []byte("other")
+	k.SetOutgoingLogicCall(ctx, &types.OutgoingLogicCall{InvalidationId: other, InvalidationNonce: 1, Block: 1})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	claim := &types.MsgLogicCallExecutedClaim{EventNonce: 1, InvalidationId: invalidationID, InvalidationNonce: 2}
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	assert.True(t, hasEvent(ctx, types.EventTypeOutgoingLogicCallExecuted))
+	assert.True(t, hasEvent(ctx, types.EventTypeOutgoingLogicCallCanceled))
+
+	// the executed call and the earlier one it invalidated are gone along with their confirms
+	for _, nonce := range []uint64{1, 2} {
+		assert.Nil(t, k.GetOutgoingLogicCall(ctx, invalidationID, nonce))
+		assert.Empty(t, k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, nonce))
+	}
+	assert.NotNil(t, k.GetOutgoingLogicCall(ctx, invalidationID, 3))
+	assert.Len(t, k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, 3), 1)
+	assert.NotNil(t, k.GetOutgoingLogicCall(ctx, other, 1))
+
+	// so they are no longer up for confirms or for slashing validators that didn't confirm them
+	unslashed := k.GetUnSlashedLogicCalls(ctx, 2)
+	require.Len(t, unslashed, 2)
+	for _, call := range unslashed {
+		assert.False(t, bytes.Equal(call.InvalidationId, invalidationID) && call.InvalidationNonce < 3)
+	}
+
+	// observing it again has nothing left to do
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	assert.False(t, hasEvent(ctx, types.EventTypeOutgoingLogicCallExecuted))
+}
@@ -20,6 +20,12 @@ import (
 // by taking a snapshot of the current set
 // i.e. {"nonce": 1, "memebers": [{"eth_addr": "foo", "power": 11223}]}
 func (k Keeper) SetValsetRequest(ctx sdk.Context) *types.Valset {
+	return k.SetValsetRequestWithReason(ctx, "")
+}
+
+// SetValsetRequestWithReason works like SetValsetRequest and gives the reason the valset was requested
+// for in its event, see the ValsetReason constants
+func (k Keeper) SetValsetRequestWithReason(ctx sdk.Context, reason string) *types.Valset {
 	valset := k.GetCurrentValset(ctx)
 	k.StoreValset(ctx, valset)
 
@@ -27,8 +33,12 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) *types.Valset {
 	// based slashing. We are storing the checkpoint that will be signed with
 	// the validators Etheruem keys so that we know not to slash them if someone
 	// attempts to submit the signature of this validator set as evidence of bad behavior
-	checkpoint := valset.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	k.SetPastEthSignatureCheckpointInfo(ctx, types.PastEthSignatureCheckpoint{
+		Checkpoint: valset.GetCheckpoint(k.GetGravityID(ctx)),
+		Type:       types.CHECKPOINT_TYPE_VALSET,
+		Nonce:      valset.Nonce,
+		Height:     uint64(ctx.BlockHeight()),
+	})
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -38,9 +48,22 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) *types.Valset {
 			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
 			sdk.NewAttribute(types.AttributeKeyMultisigID, fmt.Sprint(valset.Nonce)),
 			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(valset.Nonce)),
+			sdk.NewAttribute(types.AttributeKeyValsetReason, reason),
 		),
 	)
 
+	if reward := k.GetParams(ctx).ValsetReward; reward.IsValid() && !reward.IsZero() && valset.RewardAmount.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValsetRewardPaused,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(valset.Nonce)),
+				sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+				sdk.NewAttribute(types.AttributeKeyRewardPoolBalance, sdk.NewCoin(reward.Denom, k.GetValsetRewardPoolBalance(ctx, reward.Denom)).String()),
+			),
+		)
+	}
+
 	return valset
 }
 
@@ -50,6 +73,7 @@ func (k Keeper) StoreValset(ctx sdk.Context, valset *types.Valset) {
 	valset.Height = uint64(ctx.BlockHeight())
 	store.Set(types.GetValsetKey(valset.Nonce), k.cdc.MustMarshalBinaryBare(valset))
 	k.SetLatestValsetNonce(ctx, valset.Nonce)
+	k.escrowValsetReward(ctx, valset)
 }
 
 // StoreValsetUnsafe is for storing a valiator set at a given height
@@ -57,6 +81,7 @@ func (k Keeper) StoreValsetUnsafe(ctx sdk.Context, valset *types.Valset) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetValsetKey(valset.Nonce), k.cdc.MustMarshalBinaryBare(valset))
 	k.SetLatestValsetNonce(ctx, valset.Nonce)
+	k.escrowValsetReward(ctx, valset)
 }
 
 // HasValsetRequest returns true if a valset defined by a nonce exists
@@ -168,6 +193,41 @@ func (k Keeper) GetLastUnBondingBlockHeight(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytes(bytes)
 }
 
+// SetLastEthAddressChangeHeight sets the last block a validator registered an Ethereum address at
+func (k Keeper) SetLastEthAddressChangeHeight(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastEthAddressChangeHeightKey, types.UInt64Bytes(height))
+}
+
+// GetLastEthAddressChangeHeight returns the last block a validator registered an Ethereum address at
+func (k Keeper) GetLastEthAddressChangeHeight(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastEthAddressChangeHeightKey)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// capBridgeValidators keeps the first maxValidators of the validators, which are sorted by power, along with as
+// many of the next ones as it takes for the kept validators to hold minShare of the total power. It returns the
+// kept validators and their power, zero maxValidators keeps all of them
+func capBridgeValidators(validators []*types.BridgeValidator, totalPower uint64, maxValidators uint64, minShare sdk.Dec) ([]*types.BridgeValidator, uint64) {
+	if maxValidators == 0 || uint64(len(validators)) <= maxValidators {
+		return validators, totalPower
+	}
+	minPower := minShare.MulInt(sdk.NewIntFromUint64(totalPower))
+	var keptPower uint64
+	for i, validator := range validators {
+		if uint64(i) >= maxValidators && sdk.NewDecFromInt(sdk.NewIntFromUint64(keptPower)).GTE(minPower) {
+			return validators[:i], keptPower
+		}
+		keptPower += validator.Power
+	}
+	return validators, keptPower
+}
+
 // GetUnSlashedValsets returns all the "ready-to-slash" unslashed validator sets in state (valsets at least signedValsetsWindow blocks old)
 func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64) (out []*types.Valset) {
 	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
@@ -223,10 +283,13 @@ func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedVa
 // update the validator set again and the bridge and all its' funds are lost.
 // For this reason we exclude validators with unset eth keys from validator sets
 //
+// With MaxBridgeValidators set only the most powerful validators are included, see capBridgeValidators
+//
 // The function is intended to return what the valset would look like if you made one now
 // you should call this function, evaluate if you want to save this new valset, and discard
 // it or save
 func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
+	params := k.GetParams(ctx)
 	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	// allocate enough space for all validators, but len zero, we then append
 	// so that we have an array with extra capacity but the correct length depending
@@ -241,24 +304,26 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
 		p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
 
 		if ethAddr, found := k.GetEthAddressByValidator(ctx, val); found {
-			bv := &types.BridgeValidator{Power: p, EthereumAddress: ethAddr}
+			bv := &types.BridgeValidator{Power: p, EthereumAddress: ethAddr.GetAddress()}
 			bridgeValidators = append(bridgeValidators, bv)
 			totalPower += p
 		}
 	}
+	bridgeValidators, totalPower = capBridgeValidators(bridgeValidators, totalPower, params.MaxBridgeValidators, params.MinBridgePowerShare)
 	// normalize power values
 	for i := range bridgeValidators {
 		bridgeValidators[i].Power = sdk.NewUint(bridgeValidators[i].Power).MulUint64(math.MaxUint32).QuoUint64(totalPower).Uint64()
 	}
 
 	// get the reward from the params store
-	reward := k.GetParams(ctx).ValsetReward
+	reward := params.ValsetReward
 	var rewardToken string
 	var rewardAmount sdk.Int
-	if !reward.IsValid() || reward.IsZero() {
+	if !reward.IsValid() || reward.IsZero() || !k.isValsetRewardFunded(ctx, reward) {
 		// the case where a validator has 'no reward'. The 'no reward' value is interpreted as having a zero
 		// address for the ERC20 token and a zero value for the reward amount. Since we store a coin with the
-		// params, a coin with a blank denom and/or zero amount is interpreted in this way.
+		// params, a coin with a blank denom and/or zero amount is interpreted in this way. The reward is also
+		// paused while an Ethereum originated reward can not be paid from the valset reward pool.
 		rewardToken = "0x0000000000000000000000000000000000000000"
 		rewardAmount = sdk.NewIntFromUint64(0)
 
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// setDepositedTokenMetadata sets the bank denom metadata of a voucher from the ERC20 metadata reported
+// by a deposit claim. Vouchers that already have metadata keep it, so the first deposit reporting it
+// wins and a governance override is never replaced by a claim. The symbol is left to governance, see
+// types.ClaimedERC20DenomMetadata
+func (k Keeper) setDepositedTokenMetadata(ctx sdk.Context, claim *types.MsgSendToCosmosClaim) {
+	if !claim.HasTokenMetadata() {
+		return
+	}
+	_, denom := k.ERC20ToDenomLookup(ctx, claim.TokenContract)
+	if k.bankKeeper.GetDenomMetaData(ctx, denom).Base != "" {
+		return
+	}
+	decimals, ok := k.voucherDecimals(ctx, claim.TokenContract, claim.TokenDecimals)
+	if !ok {
+		return
+	}
+	k.setERC20DenomMetadata(ctx, types.ClaimedERC20DenomMetadata(denom, claim.TokenContract, claim.TokenName, claim.TokenSymbol, decimals))
+}
+
+// HandleERC20MetadataProposal sets the bank denom metadata of the voucher of an Ethereum originated
+// token, replacing metadata reported by deposit claims
+func (k Keeper) HandleERC20MetadataProposal(ctx sdk.Context, p *types.ERC20MetadataProposal) error {
+	if denom, exists := k.GetCosmosOriginatedDenom(ctx, p.TokenContract); exists {
+		return sdkerrors.Wrapf(types.ErrInvalid, "erc20 %s represents the Cosmos originated %s", p.TokenContract, denom)
+	}
+	if err := types.ValidateERC20Metadata(p.Name, p.Symbol, p.Decimals); err != nil {
+		return err
+	}
+	decimals, ok := k.voucherDecimals(ctx, p.TokenContract, p.Decimals)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalid, "decimals %d below the scaling of erc20 %s", p.Decimals, p.TokenContract)
+	}
+	_, denom := k.ERC20ToDenomLookup(ctx, p.TokenContract)
+	k.setERC20DenomMetadata(ctx, types.ERC20DenomMetadata(denom, p.Name, p.Symbol, decimals))
+	return nil
+}
+
+// voucherDecimals returns the decimals of the voucher of an ERC20 with the given decimals, which differ
+// by the decimal scaling of the token. It returns false if the voucher would have negative decimals
+func (k Keeper) voucherDecimals(ctx sdk.Context, tokenContract string, erc20Decimals uint64) (uint64, bool) {
+	decimals := int64(erc20Decimals) - k.GetTokenScaling(ctx, tokenContract).Exponent
+	if decimals < 0 {
+		return 0, false
+	}
+	return uint64(decimals), true
+}
+
+// setERC20DenomMetadata sets the bank denom metadata of a voucher
+func (k Keeper) setERC20DenomMetadata(ctx sdk.Context, metadata banktypes.Metadata) {
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDenomMetadataSet,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+	))
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestERC20DenomMetadata(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	tokenContract := TokenContractAddrs[0]
+	denom := types.GravityDenom(tokenContract)
+
+	deposit := func(nonce uint64, name, symbol string, decimals uint64) {
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract,
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			TokenName:      name,
+			TokenSymbol:    symbol,
+			TokenDecimals:  decimals,
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+
+	// deposits without metadata leave the voucher without it
+	deposit(1, "", "", 0)
+	assert.Empty(t, input.BankKeeper.GetDenomMetaData(ctx, denom).Base)
+
+	// the first deposit reporting metadata sets it
+	deposit(2, "Dai Stablecoin", "DAI", 18)
+	metadata := input.BankKeeper.GetDenomMetaData(ctx, denom)
+	assert.Equal(t, types.ClaimedERC20DenomMetadata(denom, tokenContract, "Dai Stablecoin", "DAI", 18), metadata)
+	assert.Equal(t, denom, metadata.Base)
+	assert.Equal(t, "Dai Stablecoin (DAI)", metadata.Description)
+	// the claimed symbol isn't displayed until governance confirms it
+	assert.Equal(t, tokenContract, metadata.Display)
+	assert.Equal(t, uint32(18), metadata.DenomUnits[1].Exponent)
+
+	// later deposits don't replace it
+	deposit(3, "Garbage", "GRB", 2)
+	assert.Equal(t, metadata, input.BankKeeper.GetDenomMetaData(ctx, denom))
+
+	// governance does
+	proposal := types.NewERC20MetadataProposal("title", "description", tokenContract, "Dai", "DAI", 6)
+	require.NoError(t, k.HandleERC20MetadataProposal(ctx, proposal))
+	assert.Equal(t, types.ERC20DenomMetadata(denom, "Dai", "DAI", 6), input.BankKeeper.GetDenomMetaData(ctx, denom))
+	deposit(4, "Dai Stablecoin", "DAI", 18)
+	assert.Equal(t, uint32(6), input.BankKeeper.GetDenomMetaData(ctx, denom).DenomUnits[1].Exponent)
+
+	// the ERC20 of a Cosmos originated token keeps the metadata of its denom
+	k.setCosmosOriginatedDenomToERC20(ctx, "stake", TokenContractAddrs[1])
+	proposal = types.NewERC20MetadataProposal("title", "description", TokenContractAddrs[1], "Stake", "STK", 6)
+	assert.Error(t, k.HandleERC20MetadataProposal(ctx, proposal))
+}
@@ -0,0 +1,686 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// MigrationHandler migrates the gravity store from one version to the next
+type MigrationHandler func(ctx sdk.Context) error
+
+// Migrator runs in-place migrations of the gravity store. The configurator of the SDK version we
+// build against can't register module migrations, so the migrations and the store version are
+// tracked here and run from an upgrade handler in app.go
+type Migrator struct {
+	keeper     Keeper
+	migrations map[uint64]MigrationHandler
+}
+
+// NewMigrator returns a Migrator with every migration of the gravity store registered
+func NewMigrator(keeper Keeper) Migrator {
+	m := Migrator{
+		keeper:     keeper,
+		migrations: make(map[uint64]MigrationHandler),
+	}
+	m.RegisterMigration(1, m.Migrate1to2)
+	m.RegisterMigration(2, m.Migrate2to3)
+	m.RegisterMigration(3, m.Migrate3to4)
+	m.RegisterMigration(4, m.Migrate4to5)
+	m.RegisterMigration(5, m.Migrate5to6)
+	m.RegisterMigration(6, m.Migrate6to7)
+	m.RegisterMigration(7, m.Migrate7to8)
+	m.RegisterMigration(8, m.Migrate8to9)
+	m.RegisterMigration(9, m.Migrate9to10)
+	m.RegisterMigration(10, m.Migrate10to11)
+	m.RegisterMigration(11, m.Migrate11to12)
+	m.RegisterMigration(12, m.Migrate12to13)
+	m.RegisterMigration(13, m.Migrate13to14)
+	return m
+}
+
+// RegisterMigration registers the handler that migrates the store from fromVersion to fromVersion+1
+func (m Migrator) RegisterMigration(fromVersion uint64, handler MigrationHandler) {
+	if _, found := m.migrations[fromVersion]; found {
+		panic(fmt.Sprintf("migration from version %d already registered", fromVersion))
+	}
+	m.migrations[fromVersion] = handler
+}
+
+// RunMigrations migrates the store from its current version to types.ConsensusVersion, a store
+// that is already up to date is left alone
+func (m Migrator) RunMigrations(ctx sdk.Context) error {
+	for version := m.keeper.GetStoreVersion(ctx); version < types.ConsensusVersion; version++ {
+		handler, found := m.migrations[version]
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalid, "no migration from store version %d", version)
+		}
+		if err := handler(ctx); err != nil {
+			return sdkerrors.Wrapf(err, "migrating store version %d", version)
+		}
+		m.keeper.setStoreVersion(ctx, version+1)
+	}
+	return nil
+}
+
+// Migrate1to2 sets the params added since version 1 to their defaults and sweeps the claims
+// and attestations left behind by claims for pruned events
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.ParamStoreAttestationRetentionBlocks) {
+		m.keeper.paramSpace.Set(ctx, types.ParamStoreAttestationRetentionBlocks, types.DefaultParams().AttestationRetentionBlocks)
+	}
+	claims, attestations := m.keeper.SweepStaleClaims(ctx)
+	ctx.Logger().Info("swept stale gravity claims", "claims", claims, "attestations", attestations)
+	return nil
+}
+
+// Migrate2to3 records which object the checkpoints of the valsets, batches and logic calls still in
+// the store were produced from
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	backfilled := m.keeper.BackfillCheckpointInfos(ctx)
+	ctx.Logger().Info("backfilled gravity checkpoint infos", "checkpoints", backfilled)
+	return nil
+}
+
+// Migrate3to4 registers the denom traces of the vouchers minted before denom traces, merging the vouchers
+// of an ERC20 that were minted under differently cased denoms
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	traces, merged := m.keeper.TraceLegacyVouchers(ctx)
+	ctx.Logger().Info("traced gravity vouchers", "traces", traces, "merged balances", merged)
+	return nil
+}
+
+// Migrate4to5 moves the store entries keyed by ethereum addresses in whatever casing they were submitted in to
+// the checksummed form of the address, which is what every lookup uses now
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	rekeyed := m.keeper.NormalizeEthAddresses(ctx)
+	ctx.Logger().Info("checksummed gravity ethereum addresses", "rekeyed", rekeyed)
+	return nil
+}
+
+// Migrate5to6 sets the window of the bridge signing info to its default
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.setMissingParams(ctx, types.ParamStoreBridgeSigningWindow)
+	return nil
+}
+
+// Migrate6to7 sets the params of the graduated bridge slashing to their defaults, which leaves it turned off
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	m.setMissingParams(ctx,
+		types.ParamStoreGraduatedBridgeSlashing,
+		types.ParamStoreMinSignedPerWindow,
+		types.ParamStoreBridgeJailDuration,
+		types.ParamStoreSlashFractionBridgeDowntime,
+	)
+	return nil
+}
+
+// Migrate7to8 sets the params of the valset request triggers to their defaults
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	m.setMissingParams(ctx,
+		types.ParamStoreValsetPowerDiffThreshold,
+		types.ParamStoreValsetMaxAge,
+		types.ParamStoreValsetMinInterval,
+		types.ParamStoreValsetOnEthAddressChange,
+	)
+	return nil
+}
+
+// Migrate8to9 sets the params capping the bridge validator set to their defaults, which leave it uncapped
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	m.setMissingParams(ctx,
+		types.ParamStoreMaxBridgeValidators,
+		types.ParamStoreMinBridgePowerShare,
+	)
+	return nil
+}
+
+// Migrate9to10 sets the param requiring governance approval of ERC20 deployments to its default, which leaves it off
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	m.setMissingParams(ctx,
+		types.ParamStoreRequireERC20DeploymentApproval,
+	)
+	return nil
+}
+
+// Migrate10to11 sets the param keeping the fields older orchestrators don't report in claims to its default, which leaves it off
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	m.setMissingParams(ctx,
+		types.ParamStoreExtendedClaims,
+	)
+	return nil
+}
+
+// Migrate11to12 registers the denom traces of the valset reward and of the vouchers pool and batch entries refund
+// that Migrate3to4 missed, because none of their vouchers were in supply
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	traces := m.keeper.TracePendingVouchers(ctx)
+	ctx.Logger().Info("traced pending gravity vouchers", "traces", traces)
+	return nil
+}
+
+// Migrate12to13 unmaps the Cosmos originated denoms whose ERC20 Migrate4to5 mapped to another denom, which
+// happened when several casings of the ERC20 were mapped to different denoms, and moves the attestations to
+// the claim hashes that hash ethereum addresses in their checksummed form
+func (m Migrator) Migrate12to13(ctx sdk.Context) error {
+	unmapped := m.keeper.UnmapConflictingDenoms(ctx)
+	rehashed := m.keeper.RehashAttestations(ctx)
+	ctx.Logger().Info("unmapped conflicting cosmos originated denoms", "denoms", unmapped, "rehashed attestations", rehashed)
+	return nil
+}
+
+// Migrate13to14 escrows the rewards of the valsets that can still be relayed in the store, where they are
+// kept as a running total instead of being counted from the valsets every block
+func (m Migrator) Migrate13to14(ctx sdk.Context) error {
+	escrowed := m.keeper.BackfillValsetRewardEscrows(ctx)
+	ctx.Logger().Info("escrowed gravity valset rewards", "valsets", escrowed)
+	return nil
+}
+
+// setMissingParams sets the params with the given keys that aren't in the store yet to their defaults
+func (m Migrator) setMissingParams(ctx sdk.Context, keys ...[]byte) {
+	for _, pair := range types.DefaultParams().ParamSetPairs() {
+		for _, key := range keys {
+			if bytes.Equal(pair.Key, key) && !m.keeper.paramSpace.Has(ctx, key) {
+				m.keeper.paramSpace.Set(ctx, key, pair.Value)
+			}
+		}
+	}
+}
+
+// GetStoreVersion returns the version of the store layout
+func (k Keeper) GetStoreVersion(ctx sdk.Context) uint64 {
+	bytes := ctx.KVStore(k.storeKey).Get(types.StoreVersionKey)
+	if len(bytes) == 0 {
+		return 1
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// setStoreVersion sets the version of the store layout
+func (k Keeper) setStoreVersion(ctx sdk.Context, version uint64) {
+	ctx.KVStore(k.storeKey).Set(types.StoreVersionKey, types.UInt64Bytes(version))
+}
+
+// SweepStaleClaims deletes the claims and attestations left in the store by claims submitted for events that
+// had already been observed and pruned, before claim handlers started rejecting them. Claims are no longer
+// stored on their own so every key under OracleClaimKey is left over from an earlier version. Attestations below
+// the last observed nonce with no observed attestation at the same nonce can never be observed and are deleted.
+// This only has to be run once, by Migrate1to2, it returns the number of claims and attestations deleted
+func (k Keeper) SweepStaleClaims(ctx sdk.Context) (claims int, attestations int) {
+	store := ctx.KVStore(k.storeKey)
+
+	// we delete outside of the iterators, modifying the store while iterating over it is not safe
+	var claimKeys [][]byte
+	iter := store.Iterator(prefixRange(types.OracleClaimKey))
+	for ; iter.Valid(); iter.Next() {
+		claimKeys = append(claimKeys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range claimKeys {
+		store.Delete(key)
+	}
+
+	// attestations are grouped by event nonce, a group is only kept if one of them was observed
+	var (
+		toDelete []types.Attestation
+		group    []types.Attestation
+		observed bool
+		current  uint64
+	)
+	k.IterateAttestationsByNonceRange(ctx, 0, k.GetLastObservedEventNonce(ctx), func(key []byte, att types.Attestation) bool {
+		nonce := types.UInt64FromBytes(key[:8])
+		if nonce != current {
+			if !observed {
+				toDelete = append(toDelete, group...)
+			}
+			group, observed, current = nil, false, nonce
+		}
+		group = append(group, att)
+		observed = observed || att.Observed
+		return false
+	})
+	if !observed {
+		toDelete = append(toDelete, group...)
+	}
+	for _, att := range toDelete {
+		k.DeleteAttestation(ctx, att)
+	}
+
+	return len(claimKeys), len(toDelete)
+}
+
+// BackfillCheckpointInfos stores the record of which valset, batch or logic call a past checkpoint was
+// produced from, for the ones still in the store that were stored before the records were. Checkpoints
+// of pruned objects, or ones signed under an earlier gravity id, are left without a record. This only has
+// to be run once, by Migrate2to3, it returns the number of records stored
+func (k Keeper) BackfillCheckpointInfos(ctx sdk.Context) int {
+	gravityID := k.GetGravityID(ctx)
+	var count int
+	backfill := func(info types.PastEthSignatureCheckpoint) {
+		if !k.GetPastEthSignatureCheckpoint(ctx, info.Checkpoint) || k.GetPastEthSignatureCheckpointInfo(ctx, info.Checkpoint) != nil {
+			return
+		}
+		k.SetPastEthSignatureCheckpointInfo(ctx, info)
+		count++
+	}
+
+	for _, valset := range k.GetValsets(ctx) {
+		backfill(types.PastEthSignatureCheckpoint{
+			Checkpoint: valset.GetCheckpoint(gravityID),
+			Type:       types.CHECKPOINT_TYPE_VALSET,
+			Nonce:      valset.Nonce,
+			Height:     valset.Height,
+		})
+	}
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		backfill(types.PastEthSignatureCheckpoint{
+			Checkpoint:    batch.GetCheckpoint(gravityID),
+			Type:          types.CHECKPOINT_TYPE_BATCH,
+			Nonce:         batch.BatchNonce,
+			TokenContract: batch.TokenContract,
+			Height:        batch.Block,
+		})
+	}
+	for _, call := range k.GetOutgoingLogicCalls(ctx) {
+		backfill(types.PastEthSignatureCheckpoint{
+			Checkpoint:     call.GetCheckpoint(gravityID),
+			Type:           types.CHECKPOINT_TYPE_LOGIC_CALL,
+			Nonce:          call.InvalidationNonce,
+			InvalidationId: call.InvalidationId,
+			Height:         call.Block,
+		})
+	}
+	return count
+}
+
+// TraceLegacyVouchers registers denom traces for the gravity0x... vouchers minted before denom traces, which
+// keep their denom as an alias. Those denoms spell the contract as the deposit claims did, so the vouchers of
+// one ERC20 may have been minted under several casings of it. The casing with the largest supply is kept and
+// the vouchers of the others are replaced by it in every balance, a balance that can't be moved, like vesting
+// vouchers, is left as is and logged. This only has to be run once, by Migrate3to4, it returns the number of
+// traces registered and balances merged
+func (k Keeper) TraceLegacyVouchers(ctx sdk.Context) (traces int, merged int) {
+	// the legacy vouchers in supply by the checksummed address of their ERC20, coins are sorted by denom
+	vouchers := make(map[string]sdk.Coins)
+	var contracts []string
+	for _, coin := range k.bankKeeper.GetSupply(ctx).GetTotal() {
+		contract, err := types.LegacyGravityDenomToERC20(coin.Denom)
+		if err != nil {
+			continue
+		}
+		contract = types.CanonicalEthAddress(contract)
+		if _, found := vouchers[contract]; !found {
+			contracts = append(contracts, contract)
+		}
+		vouchers[contract] = append(vouchers[contract], coin)
+	}
+
+	mergeInto := make(map[string]string)
+	for _, contract := range contracts {
+		kept := vouchers[contract][0]
+		for _, coin := range vouchers[contract][1:] {
+			if coin.Amount.GT(kept.Amount) {
+				kept = coin
+			}
+		}
+		k.setDenomTrace(ctx, types.DenomTrace{Denom: kept.Denom, TokenContract: contract})
+		traces++
+		for _, coin := range vouchers[contract] {
+			if coin.Denom != kept.Denom {
+				mergeInto[coin.Denom] = kept.Denom
+			}
+		}
+	}
+	if len(mergeInto) == 0 {
+		return traces, 0
+	}
+
+	// we move the balances outside of the iterator, modifying the store while iterating over it is not safe
+	type balance struct {
+		address sdk.AccAddress
+		coin    sdk.Coin
+	}
+	var balances []balance
+	k.bankKeeper.IterateAllBalances(ctx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		if _, found := mergeInto[coin.Denom]; found {
+			balances = append(balances, balance{address, coin})
+		}
+		return false
+	})
+	module := authtypes.NewModuleAddress(types.ModuleName)
+	for _, b := range balances {
+		legacy := sdk.NewCoins(b.coin)
+		kept := sdk.NewCoins(sdk.NewCoin(mergeInto[b.coin.Denom], b.coin.Amount))
+		cacheCtx, write := ctx.CacheContext()
+		err := k.bankKeeper.SendCoins(cacheCtx, b.address, module, legacy)
+		if err == nil {
+			err = k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, legacy)
+		}
+		if err == nil {
+			err = k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, kept)
+		}
+		if err == nil {
+			err = k.bankKeeper.SendCoins(cacheCtx, module, b.address, kept)
+		}
+		if err != nil {
+			ctx.Logger().Error("could not merge gravity vouchers", "address", b.address.String(), "coin", b.coin.String(), "error", err.Error())
+			continue
+		}
+		write()
+		merged++
+	}
+
+	// a valset reward in a merged denom is paid in the kept one
+	// params added by later migrations aren't set yet, so only the reward is read
+	var reward sdk.Coin
+	k.paramSpace.Get(ctx, types.ParamStoreValsetRewardAmount, &reward)
+	if mergeInto[reward.Denom] != "" {
+		k.paramSpace.Set(ctx, types.ParamStoreValsetRewardAmount, sdk.NewCoin(mergeInto[reward.Denom], reward.Amount))
+	}
+	return traces, merged
+}
+
+// TracePendingVouchers registers the denom traces TraceLegacyVouchers missed because none of the vouchers were
+// in supply, which left the valset reward without an ERC20 to be paid in and made refunds of pool and batch
+// entries mint untraced vouchers. A legacy reward denom is traced as the alias of its ERC20, or replaced by the
+// traced denom if its ERC20 already has one. This only has to be run once, by Migrate11to12, it returns the
+// number of traces registered
+func (k Keeper) TracePendingVouchers(ctx sdk.Context) (traces int) {
+	var reward sdk.Coin
+	k.paramSpace.Get(ctx, types.ParamStoreValsetRewardAmount, &reward)
+	if _, _, err := k.DenomToERC20Lookup(ctx, reward.Denom); err != nil && strings.HasPrefix(reward.Denom, types.GravityDenomPrefix) {
+		contract, err := types.LegacyGravityDenomToERC20(reward.Denom)
+		if err != nil {
+			ctx.Logger().Error("could not trace the valset reward", "denom", reward.Denom, "error", err.Error())
+		} else if trace, found := k.GetDenomTraceByERC20(ctx, contract); found {
+			k.paramSpace.Set(ctx, types.ParamStoreValsetRewardAmount, sdk.NewCoin(trace.Denom, reward.Amount))
+		} else {
+			k.setDenomTrace(ctx, types.DenomTrace{Denom: reward.Denom, TokenContract: types.CanonicalEthAddress(contract)})
+			traces++
+		}
+	}
+
+	var contracts []string
+	for _, tx := range k.GetPoolTransactions(ctx) {
+		contracts = append(contracts, tx.Erc20Token.Contract)
+	}
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
+		contracts = append(contracts, batch.TokenContract)
+		return false
+	})
+	for _, contract := range contracts {
+		if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, contract); isCosmosOriginated {
+			continue
+		}
+		if _, found := k.GetDenomTraceByERC20(ctx, contract); !found {
+			k.RegisterDenomTrace(ctx, contract)
+			traces++
+		}
+	}
+	return traces
+}
+
+// UnmapConflictingDenoms removes the ERC20 of the Cosmos originated denoms whose ERC20 is mapped to another denom,
+// so that the two indexes agree. Those denoms can't be bridged until an ERC20 is deployed for them again, the
+// coins locked for them stay locked and are logged for governance to deal with. It returns the number of denoms
+// unmapped
+func (k Keeper) UnmapConflictingDenoms(ctx sdk.Context) (unmapped int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomToERC20Key)
+	var denoms []string
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if denom, _ := k.GetCosmosOriginatedDenom(ctx, string(iter.Value())); denom != string(iter.Key()) {
+			denoms = append(denoms, string(iter.Key()))
+		}
+	}
+	iter.Close()
+
+	module := authtypes.NewModuleAddress(types.ModuleName)
+	for _, denom := range denoms {
+		erc20 := string(store.Get([]byte(denom)))
+		mapped, _ := k.GetCosmosOriginatedDenom(ctx, erc20)
+		ctx.Logger().Error("unmapped cosmos originated denom whose erc20 is mapped to another denom",
+			"denom", denom, "erc20", erc20, "mapped denom", mapped,
+			"locked", k.bankKeeper.GetBalance(ctx, module, denom).String())
+		store.Delete([]byte(denom))
+	}
+	return len(denoms)
+}
+
+// RehashAttestations moves the attestations stored under a claim hash of the ethereum addresses as the claims
+// reported them to the claim hash of their checksummed form, merging the votes of attestations of claims that
+// only differed in casing. This only has to be run once, by Migrate12to13, it returns the number moved
+func (k Keeper) RehashAttestations(ctx sdk.Context) (rehashed int) {
+	// we move the attestations outside of the iterator, modifying the store while iterating over it is not safe
+	var (
+		keys         [][]byte
+		attestations []types.Attestation
+	)
+	k.IterateAttestaions(ctx, func(key []byte, att types.Attestation) bool {
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic(err)
+		}
+		if !bytes.Equal(key, types.GetAttestationKey(claim.GetEventNonce(), claim.ClaimHash())) {
+			keys = append(keys, key)
+			attestations = append(attestations, att)
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for i, att := range attestations {
+		claim, _ := k.UnpackAttestationClaim(&att)
+		store.Delete(keys[i])
+		if existing := k.GetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash()); existing != nil {
+			att = mergeAttestationVotes(*existing, att)
+		}
+		k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), &att)
+	}
+	return len(attestations)
+}
+
+// mergeAttestationVotes adds the votes of other to an attestation of the same claim. Without the power of
+// every vote the powers are dropped, so that the tally is snapshotted again by the next vote
+func mergeAttestationVotes(att types.Attestation, other types.Attestation) types.Attestation {
+	voted := make(map[string]bool, len(att.Votes))
+	for _, validator := range att.Votes {
+		voted[validator] = true
+	}
+	withPowers := att.HasTally() && other.HasTally()
+	for i, validator := range other.Votes {
+		if voted[validator] {
+			continue
+		}
+		att.Votes = append(att.Votes, validator)
+		if withPowers {
+			att.VotePowers = append(att.VotePowers, other.VotePowers[i])
+			att.Tally.VotesPower = att.Tally.VotesPower.AddRaw(other.VotePowers[i])
+		}
+	}
+	if !withPowers {
+		att.VotePowers = nil
+	}
+	if other.Observed && !att.Observed {
+		att.Observed, att.ObservedHeight = true, other.ObservedHeight
+	}
+	return att
+}
+
+// NormalizeEthAddresses rewrites the ethereum addresses in the store that were stored as they were submitted in
+// their checksummed form. Entries keyed by an address are moved to the key of the checksummed address, entries
+// of several casings of one address are merged where they can be and otherwise the one that was already stored
+// under the checksummed key, or the first one moved there, is kept. This only has to be run once, by Migrate4to5,
+// it returns the number of entries moved
+func (k Keeper) NormalizeEthAddresses(ctx sdk.Context) (rekeyed int) {
+	keepExisting := func(value, existing []byte) []byte {
+		if existing != nil {
+			return existing
+		}
+		return value
+	}
+
+	// delegate keys, both directions
+	rekeyed += k.rekeyByEthAddress(ctx, types.ValidatorByEthAddressKey, keepExisting)
+	k.normalizeEthAddressValues(ctx, types.EthAddressByValidatorKey)
+
+	// cosmos originated ERC20s, both directions, several casings mapped to different denoms leave the denoms
+	// that weren't kept mapped to an ERC20 of another denom, Migrate12to13 unmaps those
+	rekeyed += k.rekeyByEthAddress(ctx, types.ERC20ToDenomKey, keepExisting)
+	k.normalizeEthAddressValues(ctx, types.DenomToERC20Key)
+
+	// the pool and its fee index, transfers of several casings with the same fee are queued by id
+	var pool []*types.OutgoingTransferTx
+	poolIter := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXPoolKey).Iterator(nil, nil)
+	for ; poolIter.Valid(); poolIter.Next() {
+		var tx types.OutgoingTransferTx
+		k.cdc.MustUnmarshalBinaryBare(poolIter.Value(), &tx)
+		pool = append(pool, &tx)
+	}
+	poolIter.Close()
+	for _, tx := range pool {
+		tx.Erc20Token.Contract = types.CanonicalEthAddress(tx.Erc20Token.Contract)
+		tx.Erc20Fee.Contract = types.CanonicalEthAddress(tx.Erc20Fee.Contract)
+		if err := k.setPoolEntry(ctx, tx); err != nil {
+			panic(err)
+		}
+	}
+	rekeyed += k.rekeyByEthAddress(ctx, types.SecondIndexOutgoingTXFeeKey, func(value, existing []byte) []byte {
+		if existing == nil {
+			return value
+		}
+		var ids, existingIDs types.IDSet
+		k.cdc.MustUnmarshalBinaryBare(value, &ids)
+		k.cdc.MustUnmarshalBinaryBare(existing, &existingIDs)
+		ids.Ids = append(existingIDs.Ids, ids.Ids...)
+		sort.Slice(ids.Ids, func(i, j int) bool { return ids.Ids[i] < ids.Ids[j] })
+		return k.cdc.MustMarshalBinaryBare(&ids)
+	})
+
+	// batches and their confirms, batch nonces are unique across tokens so these never collide
+	batchStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXBatchKey)
+	var batchKeys [][]byte
+	var batches []*types.OutgoingTxBatch
+	k.IterateOutgoingTXBatches(ctx, func(key []byte, batch *types.OutgoingTxBatch) bool {
+		if batch.TokenContract != types.CanonicalEthAddress(batch.TokenContract) {
+			batchKeys = append(batchKeys, key)
+			batches = append(batches, batch)
+		}
+		return false
+	})
+	for i, batch := range batches {
+		batchStore.Delete(batchKeys[i])
+		for _, tx := range batch.Transactions {
+			tx.Erc20Token.Contract = types.CanonicalEthAddress(tx.Erc20Token.Contract)
+			tx.Erc20Fee.Contract = types.CanonicalEthAddress(tx.Erc20Fee.Contract)
+		}
+		// stores the batch under its checksummed contract, replacing the one under its block
+		k.StoreBatchUnsafe(ctx, batch)
+		rekeyed++
+	}
+	rekeyed += k.rekeyByEthAddress(ctx, types.BatchConfirmKey, func(value, existing []byte) []byte {
+		var confirm types.MsgConfirmBatch
+		k.cdc.MustUnmarshalBinaryBare(keepExisting(value, existing), &confirm)
+		confirm.TokenContract = types.CanonicalEthAddress(confirm.TokenContract)
+		return k.cdc.MustMarshalBinaryBare(&confirm)
+	})
+
+	// relayer stats of several casings are added up
+	rekeyed += k.rekeyByEthAddress(ctx, types.RelayerStatsKey, func(value, existing []byte) []byte {
+		var stats types.RelayerStats
+		k.cdc.MustUnmarshalBinaryBare(value, &stats)
+		stats.Relayer = types.CanonicalEthAddress(stats.Relayer)
+		if existing != nil {
+			var other types.RelayerStats
+			k.cdc.MustUnmarshalBinaryBare(existing, &other)
+			stats.Batches += other.Batches
+			stats.Valsets += other.Valsets
+			stats.LogicCalls += other.LogicCalls
+			for _, fee := range other.Fees {
+				stats.Fees = addERC20Token(stats.Fees, fee)
+			}
+			for _, reward := range other.ValsetRewards {
+				stats.ValsetRewards = addERC20Token(stats.ValsetRewards, reward)
+			}
+			if other.LastRelayedHeight > stats.LastRelayedHeight {
+				stats.LastRelayedHeight = other.LastRelayedHeight
+			}
+		}
+		return k.cdc.MustMarshalBinaryBare(&stats)
+	})
+
+	// decimal scalings are kept, dust of several casings is added up
+	rekeyed += k.rekeyByEthAddress(ctx, types.TokenScalingKey, func(value, existing []byte) []byte {
+		var scaling types.TokenScaling
+		k.cdc.MustUnmarshalBinaryBare(keepExisting(value, existing), &scaling)
+		scaling.TokenContract = types.CanonicalEthAddress(scaling.TokenContract)
+		return k.cdc.MustMarshalBinaryBare(&scaling)
+	})
+	rekeyed += k.rekeyByEthAddress(ctx, types.DustKey, func(value, existing []byte) []byte {
+		var dust types.ERC20Token
+		k.cdc.MustUnmarshalBinaryBare(value, &dust)
+		dust.Contract = types.CanonicalEthAddress(dust.Contract)
+		if existing != nil {
+			var other types.ERC20Token
+			k.cdc.MustUnmarshalBinaryBare(existing, &other)
+			dust.Amount = dust.Amount.Add(other.Amount)
+		}
+		return k.cdc.MustMarshalBinaryBare(&dust)
+	})
+	return rekeyed
+}
+
+// rekeyByEthAddress moves the entries under the prefix whose key starts with an ethereum address that isn't
+// checksummed to the key starting with the checksummed address. update returns the value to store there, given
+// the moved value and the one already stored under the new key, if any
+func (k Keeper) rekeyByEthAddress(ctx sdk.Context, keyPrefix []byte, update func(value, existing []byte) []byte) int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	// we move the entries outside of the iterator, modifying the store while iterating over it is not safe
+	var keys, values [][]byte
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) < types.ETHContractAddressLen {
+			continue
+		}
+		address := string(iter.Key()[:types.ETHContractAddressLen])
+		if types.ValidateEthAddress(address) == nil && address != types.CanonicalEthAddress(address) {
+			keys = append(keys, iter.Key())
+			values = append(values, iter.Value())
+		}
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		address := types.CanonicalEthAddress(string(key[:types.ETHContractAddressLen]))
+		newKey := append([]byte(address), key[types.ETHContractAddressLen:]...)
+		store.Delete(key)
+		store.Set(newKey, update(values[i], store.Get(newKey)))
+	}
+	return len(keys)
+}
+
+// normalizeEthAddressValues rewrites the ethereum address values under the prefix in their checksummed form
+func (k Keeper) normalizeEthAddressValues(ctx sdk.Context, keyPrefix []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	var keys [][]byte
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if address := string(iter.Value()); types.ValidateEthAddress(address) == nil && address != types.CanonicalEthAddress(address) {
+			keys = append(keys, iter.Key())
+		}
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Set(key, []byte(types.CanonicalEthAddress(string(store.Get(key)))))
+	}
+}
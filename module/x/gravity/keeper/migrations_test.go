@@ -0,0 +1,487 @@
+package keeper
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestSweepStaleClaims(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.setLastObservedEventNonce(ctx, 5)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append(types.OracleClaimKey, []byte("left over claim")...), []byte{1})
+
+	// nonce 2 was spammed after the observed attestation was pruned, nonce 3 is still
+	// retained and nonce 6 is still being voted on
+	setClaim := func(nonce uint64, observed bool, receiver int) {
+		msg := &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  TokenContractAddrs[0],
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[receiver].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		k.SetAttestation(ctx, nonce, msg.ClaimHash(), &types.Attestation{Observed: observed, Claim: any})
+	}
+	setClaim(2, false, 0)
+	setClaim(3, true, 0)
+	setClaim(3, false, 1)
+	setClaim(6, false, 0)
+
+	claims, attestations := k.SweepStaleClaims(ctx)
+	assert.Equal(t, 1, claims)
+	assert.Equal(t, 1, attestations)
+	assert.Empty(t, k.GetAttestationsByNonce(ctx, 2))
+	assert.Len(t, k.GetAttestationsByNonce(ctx, 3), 2)
+	assert.Len(t, k.GetAttestationsByNonce(ctx, 6), 1)
+}
+
+// v1Store sets up a store in the version 1 layout. It has no store version or attestation
+// retention param, holds a left over claim and an attestation for a pruned event
+func v1Store(t *testing.T) TestInput {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	paramStore := prefix.NewStore(ctx.KVStore(input.ParamsKey), append([]byte(types.DefaultParamspace), '/'))
+	paramStore.Delete(types.ParamStoreAttestationRetentionBlocks)
+
+	k.setLastObservedEventNonce(ctx, 5)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append(types.OracleClaimKey, []byte("left over claim")...), []byte{1})
+	msg := &types.MsgSendToCosmosClaim{
+		EventNonce:     2,
+		TokenContract:  TokenContractAddrs[0],
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		Orchestrator:   AccAddrs[0].String(),
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	k.SetAttestation(ctx, msg.EventNonce, msg.ClaimHash(), &types.Attestation{Claim: any})
+	return input
+}
+
+func TestMigrate1to2(t *testing.T) {
+	input := v1Store(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	require.Equal(t, uint64(1), k.GetStoreVersion(ctx))
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	require.NoError(t, NewMigrator(k).RunMigrations(ctx))
+
+	assert.Equal(t, uint64(types.ConsensusVersion), k.GetStoreVersion(ctx))
+	assert.Equal(t, types.DefaultParams().AttestationRetentionBlocks, k.GetParams(ctx).AttestationRetentionBlocks)
+	assert.Empty(t, k.GetAttestationsByNonce(ctx, 2))
+	iter := ctx.KVStore(k.storeKey).Iterator(prefixRange(types.OracleClaimKey))
+	assert.False(t, iter.Valid())
+	iter.Close()
+
+	// an up to date store is left alone
+	k.SetParams(ctx, TestingGravityParams)
+	require.NoError(t, NewMigrator(k).RunMigrations(ctx))
+	assert.Equal(t, TestingGravityParams.AttestationRetentionBlocks, k.GetParams(ctx).AttestationRetentionBlocks)
+}
+
+func TestRunMigrationsMissingHandler(t *testing.T) {
+	input := CreateTestEnv(t)
+	m := Migrator{keeper: input.GravityKeeper, migrations: make(map[uint64]MigrationHandler)}
+	assert.Error(t, m.RunMigrations(input.Context))
+	assert.Equal(t, uint64(1), input.GravityKeeper.GetStoreVersion(input.Context))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	gravityID := k.GetGravityID(ctx)
+	k.setStoreVersion(ctx, 2)
+
+	// version 2 only stored the checkpoints themselves
+	valset := &types.Valset{Nonce: 1, Height: 4, RewardAmount: sdk.ZeroInt(), RewardToken: "0x0000000000000000000000000000000000000000"}
+	k.StoreValsetUnsafe(ctx, valset)
+	k.SetPastEthSignatureCheckpoint(ctx, valset.GetCheckpoint(gravityID))
+	batch := &types.OutgoingTxBatch{BatchNonce: 2, TokenContract: TokenContractAddrs[0], Block: 5}
+	k.StoreBatchUnsafe(ctx, batch)
+	k.SetPastEthSignatureCheckpoint(ctx, batch.GetCheckpoint(gravityID))
+	// a valset whose checkpoint was never stored doesn't get a record
+	unchecked := &types.Valset{Nonce: 2, Height: 6, RewardAmount: sdk.ZeroInt(), RewardToken: "0x0000000000000000000000000000000000000000"}
+	k.StoreValsetUnsafe(ctx, unchecked)
+
+	require.NoError(t, NewMigrator(k).RunMigrations(ctx))
+	assert.Equal(t, uint64(types.ConsensusVersion), k.GetStoreVersion(ctx))
+
+	info := k.GetPastEthSignatureCheckpointInfo(ctx, valset.GetCheckpoint(gravityID))
+	require.NotNil(t, info)
+	assert.Equal(t, types.PastEthSignatureCheckpoint{
+		Checkpoint: valset.GetCheckpoint(gravityID),
+		Type:       types.CHECKPOINT_TYPE_VALSET,
+		Nonce:      1,
+		Height:     4,
+	}, *info)
+	info = k.GetPastEthSignatureCheckpointInfo(ctx, batch.GetCheckpoint(gravityID))
+	require.NotNil(t, info)
+	assert.Equal(t, types.CHECKPOINT_TYPE_BATCH, info.Type)
+	assert.Equal(t, uint64(2), info.Nonce)
+	assert.Equal(t, uint64(5), info.Height)
+	assert.Nil(t, k.GetPastEthSignatureCheckpointInfo(ctx, unchecked.GetCheckpoint(gravityID)))
+	assert.False(t, k.GetPastEthSignatureCheckpoint(ctx, unchecked.GetCheckpoint(gravityID)))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.setStoreVersion(ctx, 3)
+
+	// version 3 named vouchers after the contract as the deposits spelled it
+	mint := func(denom string, amount int64, to sdk.AccAddress) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+		require.NoError(t, input.BankKeeper.SendCoins(ctx, authtypes.NewModuleAddress(types.ModuleName), to, coins))
+	}
+	lower := types.GravityDenomPrefix + strings.ToLower(TokenContractAddrs[0])
+	checksummed := types.GravityDenomPrefix + TokenContractAddrs[0]
+	single := types.GravityDenomPrefix + strings.ToLower(TokenContractAddrs[1])
+	mint(lower, 200, AccAddrs[0])
+	mint(lower, 100, k.GetValsetRewardPoolAddress())
+	mint(checksummed, 50, AccAddrs[1])
+	mint(checksummed, 5, AccAddrs[0])
+	mint(single, 10, AccAddrs[2])
+	params := k.GetParams(ctx)
+	params.ValsetReward = sdk.NewInt64Coin(checksummed, 1)
+	k.SetParams(ctx, params)
+
+	require.NoError(t, NewMigrator(k).RunMigrations(ctx))
+	assert.Equal(t, uint64(types.ConsensusVersion), k.GetStoreVersion(ctx))
+
+	// the casing with the largest supply is kept and the others are merged into it
+	isCosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, lower)
+	require.NoError(t, err)
+	assert.False(t, isCosmosOriginated)
+	assert.Equal(t, TokenContractAddrs[0], erc20)
+	_, denom := k.ERC20ToDenomLookup(ctx, TokenContractAddrs[0])
+	assert.Equal(t, lower, denom)
+	_, _, err = k.DenomToERC20Lookup(ctx, checksummed)
+	assert.Error(t, err)
+
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(lower, 205)), input.BankKeeper.GetAllBalances(ctx, AccAddrs[0]))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(lower, 50)), input.BankKeeper.GetAllBalances(ctx, AccAddrs[1]))
+	assert.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, k.GetValsetRewardPoolAddress(), lower).Amount)
+	supply := input.BankKeeper.GetSupply(ctx).GetTotal()
+	assert.Equal(t, sdk.NewInt(355), supply.AmountOf(lower))
+	assert.True(t, supply.AmountOf(checksummed).IsZero())
+	assert.Equal(t, sdk.NewInt64Coin(lower, 1), k.GetParams(ctx).ValsetReward)
+
+	_, denom = k.ERC20ToDenomLookup(ctx, TokenContractAddrs[1])
+	assert.Equal(t, single, denom)
+	assert.Equal(t, sdk.NewInt(10), input.BankKeeper.GetBalance(ctx, AccAddrs[2], single).Amount)
+}
+
+func TestMigrate11to12(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.setStoreVersion(ctx, 11)
+
+	// version 11 left the reward and the refunds of pool entries untraced when none of their vouchers were in supply
+	legacyReward := types.GravityDenomPrefix + strings.ToLower(TokenContractAddrs[0])
+	params := k.GetParams(ctx)
+	params.ValsetReward = sdk.NewInt64Coin(legacyReward, 1)
+	k.SetParams(ctx, params)
+	pooled := k.RegisterDenomTrace(ctx, TokenContractAddrs[1]).Denom
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pooled, 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], coins))
+	_, err := k.AddToOutgoingPool(ctx, AccAddrs[0], EthAddrs[0].String(), sdk.NewInt64Coin(pooled, 90), sdk.NewInt64Coin(pooled, 10))
+	require.NoError(t, err)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomTraceKey(pooled))
+	store.Delete(types.GetDenomTraceByERC20Key(types.MustNewEthAddress(TokenContractAddrs[1])))
+
+	require.NoError(t, NewMigrator(k).RunMigrations(ctx))
+	assert.Equal(t, uint64(types.ConsensusVersion), k.GetStoreVersion(ctx))
+
+	// the legacy reward denom is traced as the alias of its ERC20
+	_, erc20, err := k.DenomToERC20Lookup(ctx, legacyReward)
+	require.NoError(t, err)
+	assert.Equal(t, TokenContractAddrs[0], erc20)
+	assert.Equal(t, sdk.NewInt64Coin(legacyReward, 1), k.GetParams(ctx).ValsetReward)
+
+	// the pool entry refunds traced vouchers
+	_, erc20, err = k.DenomToERC20Lookup(ctx, pooled)
+	require.NoError(t, err)
+	assert.Equal(t, TokenContractAddrs[1], erc20)
+}
+
+func TestMigrate12to13(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.setStoreVersion(ctx, 4)
+	store := ctx.KVStore(k.storeKey)
+
+	// two casings of one ERC20 mapped to different denoms, version 5 kept the checksummed one
+	checksummed := TokenContractAddrs[0]
+	lower := strings.ToLower(checksummed)
+	store.Set(append(types.ERC20ToDenomKey, []byte(lower)...), []byte("stake"))
+	store.Set(types.GetDenomToERC20Key("stake"), []byte(lower))
+	k.setCosmosOriginatedDenomToERC20(ctx, "other", checksummed)
+
+	// claims of one deposit reported in two casings were attested under two claim hashes
+	attest := func(tokenContract string, validator sdk.ValAddress, key []byte) {
+		claim := &types.MsgSendToCosmosClaim{EventNonce: 1, TokenContract: tokenContract, Amount: sdk.NewInt(1)}
+		any, err := codectypes.NewAnyWithValue(claim)
+		require.NoError(t, err)
+		att := types.Attestation{Claim: any, Tally: types.NewAttestationTally(sdk.NewInt(100))}
+		att.AddVote(validator, 10)
+		store.Set(key, k.cdc.MustMarshalBinaryBare(&att))
+	}
+	legacyKey := types.GetAttestationKey(1, []byte("hash of the lowercase claim"))
+	attest(lower, ValAddrs[0], legacyKey)
+	deposit := &types.MsgSendToCosmosClaim{EventNonce: 1, TokenContract: checksummed, Amount: sdk.NewInt(1)}
+	attest(checksummed, ValAddrs[1], types.GetAttestationKey(1, deposit.ClaimHash()))
+
+	require.NoError(t, NewMigrator(k).RunMigrations(ctx))
+	assert.Equal(t, uint64(types.ConsensusVersion), k.GetStoreVersion(ctx))
+
+	// their votes are merged under the hash of the checksummed claim
+	assert.Nil(t, store.Get(legacyKey))
+	att := k.GetAttestation(ctx, 1, deposit.ClaimHash())
+	require.NotNil(t, att)
+	assert.ElementsMatch(t, []string{ValAddrs[0].String(), ValAddrs[1].String()}, att.Votes)
+	assert.Equal(t, sdk.NewInt(20), att.Tally.VotesPower)
+
+	// the denom that wasn't kept no longer sends through the ERC20 of the other
+	_, found := k.GetCosmosOriginatedERC20(ctx, "stake")
+	assert.False(t, found)
+	erc20, _ := k.GetCosmosOriginatedERC20(ctx, "other")
+	assert.Equal(t, checksummed, erc20)
+	denom, _ := k.GetCosmosOriginatedDenom(ctx, lower)
+	assert.Equal(t, "other", denom)
+}
+
+func TestMigrate13to14(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	k.setStoreVersion(ctx, 13)
+	store := ctx.KVStore(k.storeKey)
+	tokenContract := TokenContractAddrs[0]
+	denom := k.RegisterDenomTrace(ctx, tokenContract).Denom
+
+	// version 13 counted the escrowed rewards from the valsets that can still be relayed
+	k.SetLastObservedValset(ctx, types.Valset{Nonce: 1})
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		valset := types.NewValset(nonce, nonce, nil, sdk.NewInt(100), tokenContract)
+		store.Set(types.GetValsetKey(nonce), k.cdc.MustMarshalBinaryBare(valset))
+	}
+	assert.True(t, k.GetEscrowedValsetRewards(ctx, denom).IsZero())
+
+	require.NoError(t, NewMigrator(k).RunMigrations(ctx))
+	assert.Equal(t, uint64(types.ConsensusVersion), k.GetStoreVersion(ctx))
+	assert.Equal(t, sdk.NewInt(200), k.GetEscrowedValsetRewards(ctx, denom))
+
+	// running it again doesn't escrow the rewards twice
+	assert.Equal(t, 0, k.BackfillValsetRewardEscrows(ctx))
+	assert.Equal(t, sdk.NewInt(200), k.GetEscrowedValsetRewards(ctx, denom))
+
+	// the backfilled escrows are released like any other
+	k.SetLastObservedValset(ctx, types.Valset{Nonce: 2})
+	assert.Equal(t, sdk.NewInt(100), k.GetEscrowedValsetRewards(ctx, denom))
+	k.SetLastObservedValset(ctx, types.Valset{Nonce: 3})
+	assert.True(t, k.GetEscrowedValsetRewards(ctx, denom).IsZero())
+}
+
+func TestMigrate4to5(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	k.setStoreVersion(ctx, 4)
+	store := ctx.KVStore(k.storeKey)
+
+	// version 4 keyed entries by the addresses as they were submitted
+	token := TokenContractAddrs[0]
+	lower := strings.ToLower(token)
+	upper := "0x" + strings.ToUpper(token[2:])
+	recased := func(key []byte, address string) []byte {
+		return bytes.Replace(key, []byte(types.CanonicalEthAddress(address)), []byte(address), 1)
+	}
+	contract := types.MustNewEthAddress(token)
+	store.Delete(types.GetValidatorByEthAddressKey(types.MustNewEthAddress(EthAddrs[0].String())))
+	ethAddress := strings.ToLower(TokenContractAddrs[1])
+	store.Set(recased(types.GetValidatorByEthAddressKey(types.MustNewEthAddress(ethAddress)), ethAddress), ValAddrs[0])
+	store.Set(types.GetEthAddressByValidatorKey(ValAddrs[0]), []byte(ethAddress))
+	store.Set(recased(types.GetERC20ToDenomKey(contract), lower), []byte("stake"))
+	store.Set(types.GetDenomToERC20Key("stake"), []byte(lower))
+
+	// transfers of two casings paying the same fee
+	fee := types.ERC20Token{Contract: lower, Amount: sdk.NewInt(3)}
+	for id, address := range map[uint64]string{2: lower, 1: upper} {
+		tx := &types.OutgoingTransferTx{
+			Id:          id,
+			Sender:      AccAddrs[0].String(),
+			DestAddress: EthAddrs[1].String(),
+			Erc20Token:  types.NewERC20Token(100, address),
+			Erc20Fee:    types.NewERC20Token(3, address),
+		}
+		require.NoError(t, k.setPoolEntry(ctx, tx))
+		store.Set(recased(types.GetFeeSecondIndexKey(contract, fee), address), k.cdc.MustMarshalBinaryBare(&types.IDSet{Ids: []uint64{id}}))
+	}
+
+	batch := &types.OutgoingTxBatch{BatchNonce: 7, Block: 5, TokenContract: lower}
+	store.Set(recased(types.GetOutgoingTxBatchKey(contract, 7), lower), k.cdc.MustMarshalBinaryBare(batch))
+	store.Set(types.GetOutgoingTxBatchBlockKey(5), k.cdc.MustMarshalBinaryBare(batch))
+	confirm := &types.MsgConfirmBatch{Nonce: 7, TokenContract: lower, EthSigner: EthAddrs[1].String(), Orchestrator: AccAddrs[1].String()}
+	store.Set(recased(types.GetBatchConfirmKey(contract, 7, AccAddrs[1]), lower), k.cdc.MustMarshalBinaryBare(confirm))
+
+	relayer := types.MustNewEthAddress(TokenContractAddrs[2])
+	for i, address := range []string{strings.ToLower(relayer.GetAddress()), relayer.GetAddress()} {
+		stats := types.RelayerStats{Relayer: address, Batches: 1, LastRelayedHeight: uint64(10 + i)}
+		store.Set(recased(types.GetRelayerStatsKey(relayer), address), k.cdc.MustMarshalBinaryBare(&stats))
+	}
+	for _, address := range []string{lower, upper} {
+		dust := types.ERC20Token{Contract: address, Amount: sdk.NewInt(4)}
+		store.Set(recased(types.GetDustKey(contract), address), k.cdc.MustMarshalBinaryBare(&dust))
+	}
+	scaling := types.TokenScaling{TokenContract: lower, Exponent: 12}
+	store.Set(recased(types.GetTokenScalingKey(contract), lower), k.cdc.MustMarshalBinaryBare(&scaling))
+
+	// the casings don't find each other before the migration
+	_, found := k.GetValidatorByEthAddress(ctx, types.MustNewEthAddress(ethAddress))
+	assert.False(t, found)
+
+	require.NoError(t, NewMigrator(k).RunMigrations(ctx))
+	assert.Equal(t, uint64(types.ConsensusVersion), k.GetStoreVersion(ctx))
+
+	val, found := k.GetValidatorByEthAddress(ctx, types.MustNewEthAddress(ethAddress))
+	require.True(t, found)
+	assert.Equal(t, ValAddrs[0], val.GetOperator())
+	gotEthAddress, found := k.GetEthAddressByValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	assert.Equal(t, TokenContractAddrs[1], gotEthAddress.GetAddress())
+
+	denom, found := k.GetCosmosOriginatedDenom(ctx, upper)
+	require.True(t, found)
+	assert.Equal(t, "stake", denom)
+	erc20, _ := k.GetCosmosOriginatedERC20(ctx, "stake")
+	assert.Equal(t, token, erc20)
+
+	// the transfers are queued by id under the checksummed contract
+	pool := k.GetPoolTransactions(ctx)
+	require.Len(t, pool, 2)
+	assert.Equal(t, uint64(1), pool[0].Id)
+	assert.Equal(t, token, pool[0].Erc20Fee.Contract)
+	assert.Equal(t, uint64(2), pool[1].Id)
+	assert.Equal(t, sdk.NewInt(6), k.GetBatchFeesByTokenType(ctx, lower, OutgoingTxBatchSize).TotalFees)
+
+	gotBatch := k.GetOutgoingTXBatch(ctx, upper, 7)
+	require.NotNil(t, gotBatch)
+	assert.Equal(t, token, gotBatch.TokenContract)
+	gotConfirm := k.GetBatchConfirm(ctx, 7, token, AccAddrs[1])
+	require.NotNil(t, gotConfirm)
+	assert.Equal(t, token, gotConfirm.TokenContract)
+
+	stats, found := k.GetRelayerStats(ctx, relayer.GetAddress())
+	require.True(t, found)
+	assert.Equal(t, uint64(2), stats.Batches)
+	assert.Equal(t, uint64(11), stats.LastRelayedHeight)
+	assert.Equal(t, sdk.NewInt(8), k.GetDust(ctx, token))
+	assert.Equal(t, types.TokenScaling{TokenContract: token, Exponent: 12}, k.GetTokenScaling(ctx, lower))
+
+	// nothing is left under the other casings
+	for _, keyPrefix := range [][]byte{types.ValidatorByEthAddressKey, types.ERC20ToDenomKey, types.SecondIndexOutgoingTXFeeKey,
+		types.OutgoingTXBatchKey, types.BatchConfirmKey, types.RelayerStatsKey, types.DustKey, types.TokenScalingKey} {
+		iter := prefix.NewStore(store, keyPrefix).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			address := string(iter.Key()[:types.ETHContractAddressLen])
+			assert.Equal(t, types.CanonicalEthAddress(address), address)
+		}
+		iter.Close()
+	}
+}
+
+// migratedParams lists the params set by each migration, by the store version the migration starts from
+var migratedParams = []struct {
+	version uint64
+	keys    [][]byte
+}{
+	{5, [][]byte{types.ParamStoreBridgeSigningWindow}},
+	{6, [][]byte{
+		types.ParamStoreGraduatedBridgeSlashing,
+		types.ParamStoreMinSignedPerWindow,
+		types.ParamStoreBridgeJailDuration,
+		types.ParamStoreSlashFractionBridgeDowntime,
+	}},
+	{7, [][]byte{
+		types.ParamStoreValsetPowerDiffThreshold,
+		types.ParamStoreValsetMaxAge,
+		types.ParamStoreValsetMinInterval,
+		types.ParamStoreValsetOnEthAddressChange,
+	}},
+	{8, [][]byte{
+		types.ParamStoreMaxBridgeValidators,
+		types.ParamStoreMinBridgePowerShare,
+	}},
+	{9, [][]byte{
+		types.ParamStoreRequireERC20DeploymentApproval,
+	}},
+	{10, [][]byte{
+		types.ParamStoreExtendedClaims,
+	}},
+}
+
+func TestMigrateParams(t *testing.T) {
+	paramValue := func(params *types.Params, key []byte) interface{} {
+		for _, pair := range params.ParamSetPairs() {
+			if bytes.Equal(pair.Key, key) {
+				return pair.Value
+			}
+		}
+		panic("unknown param " + string(key))
+	}
+	run := func(version uint64, keys [][]byte) {
+		input := CreateTestEnv(t)
+		ctx := input.Context
+		k := input.GravityKeeper
+		k.setStoreVersion(ctx, version)
+		paramStore := prefix.NewStore(ctx.KVStore(input.ParamsKey), append([]byte(types.DefaultParamspace), '/'))
+		for _, key := range keys {
+			paramStore.Delete(key)
+		}
+		require.Panics(t, func() { k.GetParams(ctx) })
+
+		require.NoError(t, NewMigrator(k).RunMigrations(ctx))
+		assert.Equal(t, uint64(types.ConsensusVersion), k.GetStoreVersion(ctx))
+		var migrated types.Params
+		defaults := types.DefaultParams()
+		for _, key := range keys {
+			value := paramValue(&migrated, key)
+			k.paramSpace.Get(ctx, key, value)
+			assert.Equal(t, paramValue(defaults, key), value, string(key))
+		}
+	}
+
+	var all [][]byte
+	for _, params := range migratedParams {
+		run(params.version, params.keys)
+		all = append(all, params.keys...)
+	}
+	// the earlier migrations don't rely on params that are only set by later ones
+	run(2, all)
+}
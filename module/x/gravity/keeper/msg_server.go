@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -35,6 +36,7 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 	ctx := sdk.UnwrapSDKContext(c)
 	val, _ := sdk.ValAddressFromBech32(msg.Validator)
 	orch, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	ethAddr, _ := types.NewEthAddress(msg.EthAddress)
 
 	_, foundExistingOrchestratorKey := k.GetOrchestratorValidator(ctx, orch)
 	_, foundExistingEthAddress := k.GetEthAddressByValidator(ctx, val)
@@ -49,7 +51,8 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 	// set the orchestrator address
 	k.SetOrchestratorValidator(ctx, val, orch)
 	// set the ethereum address
-	k.SetEthAddressForValidator(ctx, val, msg.EthAddress)
+	k.SetEthAddressForValidator(ctx, val, *ethAddr)
+	k.SetLastEthAddressChangeHeight(ctx, uint64(ctx.BlockHeight()))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -66,23 +69,16 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 // ValsetConfirm handles MsgValsetConfirm
 func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	valset := k.GetValset(ctx, msg.Nonce)
-	if valset == nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find valset")
-	}
-
-	gravityID := k.GetGravityID(ctx)
-	checkpoint := valset.GetCheckpoint(gravityID)
-	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
-	if err != nil {
+	if err := k.checkValsetConfirm(ctx, msg); err != nil {
 		return nil, err
 	}
 
-	// persist signature
-	if k.GetValsetConfirm(ctx, msg.Nonce, orchaddr) != nil {
-		return nil, sdkerrors.Wrap(types.ErrDuplicate, "signature duplicate")
+	// persist signature, with the address checksummed like the ones of the valset members it is matched with
+	ethAddr, err := types.NewEthAddress(msg.EthAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ethereum address")
 	}
+	msg.EthAddress = ethAddr.GetAddress()
 	key := k.SetValsetConfirm(ctx, *msg)
 
 	ctx.EventManager().EmitEvent(
@@ -149,25 +145,9 @@ func (k msgServer) RequestBatch(c context.Context, msg *types.MsgRequestBatch) (
 // ConfirmBatch handles MsgConfirmBatch
 func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (*types.MsgConfirmBatchResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-
-	// fetch the outgoing batch given the nonce
-	batch := k.GetOutgoingTXBatch(ctx, msg.TokenContract, msg.Nonce)
-	if batch == nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find batch")
-	}
-
-	gravityID := k.GetGravityID(ctx)
-	checkpoint := batch.GetCheckpoint(gravityID)
-	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
-	if err != nil {
+	if err := k.checkBatchConfirm(ctx, msg); err != nil {
 		return nil, err
 	}
-
-	// check if we already have this confirm
-	if k.GetBatchConfirm(ctx, msg.Nonce, msg.TokenContract, orchaddr) != nil {
-		return nil, sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
-	}
 	key := k.SetBatchConfirm(ctx, msg)
 
 	ctx.EventManager().EmitEvent(
@@ -184,40 +164,95 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 // ConfirmLogicCall handles MsgConfirmLogicCall
 func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogicCall) (*types.MsgConfirmLogicCallResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.checkLogicCallConfirm(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	k.SetLogicCallConfirm(ctx, msg)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+		),
+	)
+
+	return nil, nil
+}
+
+// checkValsetConfirm checks that the valset a confirm signs exists, that the signature is valid and that the
+// orchestrator has not already confirmed it
+func (k msgServer) checkValsetConfirm(ctx sdk.Context, msg *types.MsgValsetConfirm) error {
+	valset := k.GetValset(ctx, msg.Nonce)
+	if valset == nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "couldn't find valset")
+	}
+
+	gravityID := k.GetGravityID(ctx)
+	checkpoint := valset.GetCheckpoint(gravityID)
+	err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
+	if err != nil {
+		return err
+	}
+
+	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if k.GetValsetConfirm(ctx, msg.Nonce, orchaddr) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, "signature duplicate")
+	}
+	return nil
+}
+
+// checkBatchConfirm checks that the batch a confirm signs exists, that the signature is valid and that the
+// orchestrator has not already confirmed it
+func (k msgServer) checkBatchConfirm(ctx sdk.Context, msg *types.MsgConfirmBatch) error {
+	// fetch the outgoing batch given the nonce
+	batch := k.GetOutgoingTXBatch(ctx, msg.TokenContract, msg.Nonce)
+	if batch == nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "couldn't find batch")
+	}
+
+	gravityID := k.GetGravityID(ctx)
+	checkpoint := batch.GetCheckpoint(gravityID)
+	err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
+	if err != nil {
+		return err
+	}
+
+	// check if we already have this confirm
+	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if k.GetBatchConfirm(ctx, msg.Nonce, msg.TokenContract, orchaddr) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
+	}
+	return nil
+}
+
+// checkLogicCallConfirm checks that the logic call a confirm signs exists, that the signature is valid and that
+// the orchestrator has not already confirmed it
+func (k msgServer) checkLogicCallConfirm(ctx sdk.Context, msg *types.MsgConfirmLogicCall) error {
 	invalidationIdBytes, err := hex.DecodeString(msg.InvalidationId)
 	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "invalidation id encoding")
+		return sdkerrors.Wrap(types.ErrInvalid, "invalidation id encoding")
 	}
 
 	// fetch the outgoing logic given the nonce
 	logic := k.GetOutgoingLogicCall(ctx, invalidationIdBytes, msg.InvalidationNonce)
 	if logic == nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find logic")
+		return sdkerrors.Wrap(types.ErrInvalid, "couldn't find logic")
 	}
 
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := logic.GetCheckpoint(gravityID)
-	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
 	err = k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// check if we already have this confirm
+	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
 	if k.GetLogicCallConfirm(ctx, invalidationIdBytes, msg.InvalidationNonce, orchaddr) != nil {
-		return nil, sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
+		return sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
 	}
-
-	k.SetLogicCallConfirm(ctx, msg)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
-		),
-	)
-
-	return nil, nil
+	return nil
 }
 
 // checkOrchestratorValidatorInSet checks that the orchestrator refers to a validator that is
@@ -238,11 +273,55 @@ func (k msgServer) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator
 	return nil
 }
 
+// reduceClaim drops the fields older orchestrators don't report from a claim until governance enables extended
+// claims, so that validators running older orchestrators vote on the same claim hash
+func (k msgServer) reduceClaim(ctx sdk.Context, claim types.EthereumClaim) {
+	var extendedClaims bool
+	k.paramSpace.Get(ctx, types.ParamStoreExtendedClaims, &extendedClaims)
+	if extended, ok := claim.(types.ExtendedClaim); ok && !extendedClaims {
+		extended.DropExtendedFields()
+	}
+}
+
+// lastClaimableEventNonce returns the event nonce the next claim of a validator has to follow. A validator that
+// has fallen so far behind that its next event has already been observed and pruned has nothing left to vote on
+// there, so it continues from the last observed event the same as a validator submitting its first claim
+func (k msgServer) lastClaimableEventNonce(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	nonce := k.GetLastEventNonceByValidator(ctx, validator)
+	if k.IsPrunedEventNonce(ctx, nonce+1) {
+		return k.GetLastObservedEventNonce(ctx) - 1
+	}
+	return nonce
+}
+
 // claimHandlerCommon is an internal function that provides common code for processing claims once they are
-// translated from the message to the Ethereum claim interface
-func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, msg types.EthereumClaim) error {
+// translated from the message to the Ethereum claim interface. Every claim is reduced here, whichever message
+// it was submitted in, so the same event always gets the same claim hash
+func (k msgServer) claimHandlerCommon(ctx sdk.Context, msg types.EthereumClaim) error {
+	// Claims for events that have already been observed and pruned can never execute, storing them
+	// would only leave spam in the chain that is never cleaned up
+	if k.IsPrunedEventNonce(ctx, msg.GetEventNonce()) {
+		return sdkerrors.Wrap(types.ErrOutdated, "event nonce has already been observed and pruned")
+	}
+
+	// a validator that fell behind the pruned events continues from the last observed one
+	val, _ := k.GetOrchestratorValidator(ctx, msg.GetClaimer())
+	if last := k.lastClaimableEventNonce(ctx, val.GetOperator()); last != k.GetLastEventNonceByValidator(ctx, val.GetOperator()) {
+		k.setLastEventNonceByValidator(ctx, val.GetOperator(), last)
+	}
+
+	k.reduceClaim(ctx, msg)
+	pb, ok := msg.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalid, "can't pack claim of type %T", msg)
+	}
+	msgAny, err := codectypes.NewAnyWithValue(pb)
+	if err != nil {
+		return err
+	}
+
 	// Add the claim to the store
-	_, err := k.Attest(ctx, msg, msgAny)
+	_, err = k.Attest(ctx, msg, msgAny)
 	if err != nil {
 		return sdkerrors.Wrap(err, "create attestation")
 	}
@@ -278,7 +357,7 @@ func (k msgServer) confirmHandlerCommon(ctx sdk.Context, orchestrator string, si
 		return sdkerrors.Wrap(types.ErrEmpty, "eth address")
 	}
 
-	err = types.ValidateEthereumSignature(checkpoint, sigBytes, ethAddress)
+	err = types.ValidateEthereumSignature(checkpoint, sigBytes, ethAddress.GetAddress())
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with checkpoint %s found %s", ethAddress, hex.EncodeToString(checkpoint), signature))
 	}
@@ -287,9 +366,6 @@ func (k msgServer) confirmHandlerCommon(ctx sdk.Context, orchestrator string, si
 }
 
 // DepositClaim handles MsgSendToCosmosClaim
-// TODO it is possible to submit an old msgDepositClaim (old defined as covering an event nonce that has already been
-// executed aka 'observed' and had it's slashing window expire) that will never be cleaned up in the endblocker. This
-// should not be a security risk as 'old' events can never execute but it does store spam in the chain.
 func (k msgServer) SendToCosmosClaim(c context.Context, msg *types.MsgSendToCosmosClaim) (*types.MsgSendToCosmosClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -297,11 +373,7 @@ func (k msgServer) SendToCosmosClaim(c context.Context, msg *types.MsgSendToCosm
 	if err != nil {
 		return nil, err
 	}
-	any, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return nil, err
-	}
-	err = k.claimHandlerCommon(ctx, any, msg)
+	err = k.claimHandlerCommon(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -310,9 +382,6 @@ func (k msgServer) SendToCosmosClaim(c context.Context, msg *types.MsgSendToCosm
 }
 
 // WithdrawClaim handles MsgBatchSendToEthClaim
-// TODO it is possible to submit an old msgWithdrawClaim (old defined as covering an event nonce that has already been
-// executed aka 'observed' and had it's slashing window expire) that will never be cleaned up in the endblocker. This
-// should not be a security risk as 'old' events can never execute but it does store spam in the chain.
 func (k msgServer) BatchSendToEthClaim(c context.Context, msg *types.MsgBatchSendToEthClaim) (*types.MsgBatchSendToEthClaimResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -320,11 +389,7 @@ func (k msgServer) BatchSendToEthClaim(c context.Context, msg *types.MsgBatchSen
 	if err != nil {
 		return nil, err
 	}
-	any, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return nil, err
-	}
-	err = k.claimHandlerCommon(ctx, any, msg)
+	err = k.claimHandlerCommon(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -340,11 +405,7 @@ func (k msgServer) ERC20DeployedClaim(c context.Context, msg *types.MsgERC20Depl
 	if err != nil {
 		return nil, err
 	}
-	any, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return nil, err
-	}
-	err = k.claimHandlerCommon(ctx, any, msg)
+	err = k.claimHandlerCommon(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -360,11 +421,7 @@ func (k msgServer) LogicCallExecutedClaim(c context.Context, msg *types.MsgLogic
 	if err != nil {
 		return nil, err
 	}
-	any, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return nil, err
-	}
-	err = k.claimHandlerCommon(ctx, any, msg)
+	err = k.claimHandlerCommon(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -380,11 +437,7 @@ func (k msgServer) ValsetUpdateClaim(c context.Context, msg *types.MsgValsetUpda
 	if err != nil {
 		return nil, err
 	}
-	any, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return nil, err
-	}
-	err = k.claimHandlerCommon(ctx, any, msg)
+	err = k.claimHandlerCommon(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -414,6 +467,33 @@ func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendTo
 	return &types.MsgCancelSendToEthResponse{}, nil
 }
 
+// ReclaimHeldDeposit releases a deposit held by the token policy to its receiver, or returns it to
+// Ethereum if the token is still not allowed
+func (k msgServer) ReclaimHeldDeposit(c context.Context, msg *types.MsgReclaimHeldDeposit) (*types.MsgReclaimHeldDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	receiver, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	returnFee := msg.ReturnFee
+	if returnFee.IsNil() {
+		returnFee = sdk.ZeroInt()
+	}
+	if err := k.Keeper.ReclaimHeldDeposit(ctx, msg.DepositId, receiver, returnFee); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyHeldDepositID, fmt.Sprint(msg.DepositId)),
+		),
+	)
+
+	return &types.MsgReclaimHeldDepositResponse{}, nil
+}
+
 func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -430,3 +510,73 @@ func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgS
 
 	return &types.MsgSubmitBadSignatureEvidenceResponse{}, err
 }
+
+// SubmitClaims handles MsgSubmitClaims
+func (k msgServer) SubmitClaims(c context.Context, msg *types.MsgSubmitClaims) (*types.MsgSubmitClaimsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := msg.UnpackClaims()
+	if err != nil {
+		return nil, err
+	}
+
+	// claims are processed in the order they were submitted, since event nonces must be contiguous
+	// a failed claim will usually cause every claim after it to fail as well
+	res := &types.MsgSubmitClaimsResponse{}
+	for _, claim := range claims {
+		claim := claim
+		res.Results = append(res.Results, processAtomically(ctx, func(ctx sdk.Context) error {
+			return k.claimHandlerCommon(ctx, claim)
+		}))
+	}
+
+	return res, nil
+}
+
+// SubmitConfirms handles MsgSubmitConfirms
+func (k msgServer) SubmitConfirms(c context.Context, msg *types.MsgSubmitConfirms) (*types.MsgSubmitConfirmsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.MsgSubmitConfirmsResponse{}
+	for i := range msg.ValsetConfirms {
+		confirm := &msg.ValsetConfirms[i]
+		res.ValsetResults = append(res.ValsetResults, processAtomically(ctx, func(ctx sdk.Context) error {
+			_, err := k.ValsetConfirm(sdk.WrapSDKContext(ctx), confirm)
+			return err
+		}))
+	}
+	for i := range msg.BatchConfirms {
+		confirm := &msg.BatchConfirms[i]
+		res.BatchResults = append(res.BatchResults, processAtomically(ctx, func(ctx sdk.Context) error {
+			_, err := k.ConfirmBatch(sdk.WrapSDKContext(ctx), confirm)
+			return err
+		}))
+	}
+	for i := range msg.LogicCallConfirms {
+		confirm := &msg.LogicCallConfirms[i]
+		res.LogicCallResults = append(res.LogicCallResults, processAtomically(ctx, func(ctx sdk.Context) error {
+			_, err := k.ConfirmLogicCall(sdk.WrapSDKContext(ctx), confirm)
+			return err
+		}))
+	}
+
+	return res, nil
+}
+
+// processAtomically runs a single entry of a MsgSubmitClaims or MsgSubmitConfirms in a cached context, its
+// state changes and events are only kept if it succeeds. This way a failed entry leaves nothing behind
+// and doesn't revert the entries around it
+func processAtomically(ctx sdk.Context, handler func(sdk.Context) error) types.SubmitResult {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := handler(cacheCtx); err != nil {
+		return types.SubmitResult{Accepted: false, Error: err.Error()}
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return types.SubmitResult{Accepted: true}
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// MaxPageLimit is the most results a paginated gravity query returns, whatever limit is requested
+const MaxPageLimit = 1000
+
+// limitPageRequest applies defaultLimit to a request without a limit and caps the limit at
+// MaxPageLimit, so that a single query never iterates a whole store unless count_total is set
+func limitPageRequest(pageReq *query.PageRequest, defaultLimit uint64) *query.PageRequest {
+	limited := query.PageRequest{Limit: defaultLimit}
+	if pageReq != nil {
+		limited = *pageReq
+	}
+	switch {
+	case limited.Limit == 0:
+		limited.Limit = defaultLimit
+	case limited.Limit > MaxPageLimit:
+		limited.Limit = MaxPageLimit
+	}
+	return &limited
+}
+
+// reversePaginate works like query.Paginate but iterates the store from the last key down,
+// for queries returning the latest entries first. The next key is the highest key of the
+// next page.
+func reversePaginate(
+	store sdk.KVStore,
+	pageReq *query.PageRequest,
+	onResult func(key []byte, value []byte) error,
+) (*query.PageResponse, error) {
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	var end []byte
+	if len(pageReq.Key) != 0 {
+		// the page starts at the key itself, the end of a range is exclusive
+		end = append(append([]byte{}, pageReq.Key...), 0x00)
+	}
+	iter := store.ReverseIterator(nil, end)
+	defer iter.Close()
+	return paginateIterator(iter, pageReq, onResult)
+}
+
+// rangePaginate works like query.Paginate but only over the keys from start inclusive to end
+// exclusive, a nil end leaves the range open
+func rangePaginate(
+	store sdk.KVStore,
+	start, end []byte,
+	pageReq *query.PageRequest,
+	onResult func(key []byte, value []byte) error,
+) (*query.PageResponse, error) {
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if len(pageReq.Key) != 0 && bytes.Compare(pageReq.Key, start) > 0 {
+		start = pageReq.Key
+	}
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	return paginateIterator(iter, pageReq, onResult)
+}
+
+// paginateIterator pages over the entries of iter, a page request with a key is expected to have
+// had the iterator started at the key
+func paginateIterator(
+	iter sdk.Iterator,
+	pageReq *query.PageRequest,
+	onResult func(key []byte, value []byte) error,
+) (*query.PageResponse, error) {
+	var count uint64
+	var nextKey []byte
+	for ; iter.Valid(); iter.Next() {
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if count <= pageReq.Offset+pageReq.Limit {
+			if err := onResult(iter.Key(), iter.Value()); err != nil {
+				return nil, err
+			}
+		} else if nextKey == nil {
+			nextKey = iter.Key()
+			if !pageReq.CountTotal || len(pageReq.Key) != 0 {
+				break
+			}
+		}
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal && len(pageReq.Key) == 0 {
+		res.Total = count
+	}
+	return res, nil
+}
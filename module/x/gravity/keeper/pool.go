@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -31,6 +32,17 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 		return 0, err
 	}
 
+	// amounts that can't be represented in the decimals of the ERC20 are rejected rather than rounded
+	scaling := k.GetTokenScaling(ctx, tokenContract)
+	erc20Amount, err := scaling.ToERC20(amount.Amount)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "amount")
+	}
+	erc20FeeAmount, err := scaling.ToERC20(fee.Amount)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "fee")
+	}
+
 	// If it is a cosmos-originated asset we lock it
 	if isCosmosOriginated {
 		// lock coins in module
@@ -53,7 +65,7 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 	// get next tx id from keeper
 	nextID := k.autoIncrementID(ctx, types.KeyLastTXPoolID)
 
-	erc20Fee := types.NewSDKIntERC20Token(fee.Amount, tokenContract)
+	erc20Fee := types.NewSDKIntERC20Token(erc20FeeAmount, tokenContract)
 
 	// construct outgoing tx, as part of this process we represent
 	// the token as an ERC20 token since it is preparing to go to ETH
@@ -62,7 +74,7 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 		Id:          nextID,
 		Sender:      sender.String(),
 		DestAddress: counterpartReceiver,
-		Erc20Token:  types.NewSDKIntERC20Token(amount.Amount, tokenContract),
+		Erc20Token:  types.NewSDKIntERC20Token(erc20Amount, tokenContract),
 		Erc20Fee:    erc20Fee,
 	}
 
@@ -137,11 +149,15 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 
 	// reissue the amount and the fee
 
-	totalToRefund := tx.Erc20Token.GravityCoin()
-	totalToRefund.Amount = totalToRefund.Amount.Add(tx.Erc20Fee.Amount)
-	totalToRefundCoins := sdk.NewCoins(totalToRefund)
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
+	if !isCosmosOriginated {
+		denom = k.RegisterDenomTrace(ctx, tx.Erc20Token.Contract).Denom
+	}
 
-	isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
+	// the ERC20 amounts were scaled from whole Cosmos amounts in AddToOutgoingPool, so they scale back without dust
+	refundAmount, _ := k.GetTokenScaling(ctx, tx.Erc20Token.Contract).FromERC20(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
+	totalToRefund := sdk.NewCoin(denom, refundAmount)
+	totalToRefundCoins := sdk.NewCoins(totalToRefund)
 
 	// If it is a cosmos-originated the coins are in the module (see AddToOutgoingPool) so we can just take them out
 	if isCosmosOriginated {
@@ -173,7 +189,7 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 // appendToUnbatchedTXIndex add at the end when tx with same fee exists
 func (k Keeper) appendToUnbatchedTXIndex(ctx sdk.Context, tokenContract string, fee types.ERC20Token, txID uint64) {
 	store := ctx.KVStore(k.storeKey)
-	idxKey := types.GetFeeSecondIndexKey(fee)
+	idxKey := types.GetFeeSecondIndexKey(types.MustNewEthAddress(tokenContract), fee)
 	var idSet types.IDSet
 	if store.Has(idxKey) {
 		bz := store.Get(idxKey)
@@ -186,7 +202,7 @@ func (k Keeper) appendToUnbatchedTXIndex(ctx sdk.Context, tokenContract string,
 // appendToUnbatchedTXIndex add at the top when tx with same fee exists
 func (k Keeper) prependToUnbatchedTXIndex(ctx sdk.Context, tokenContract string, fee types.ERC20Token, txID uint64) {
 	store := ctx.KVStore(k.storeKey)
-	idxKey := types.GetFeeSecondIndexKey(fee)
+	idxKey := types.GetFeeSecondIndexKey(types.MustNewEthAddress(tokenContract), fee)
 	var idSet types.IDSet
 	if store.Has(idxKey) {
 		bz := store.Get(idxKey)
@@ -196,13 +212,30 @@ func (k Keeper) prependToUnbatchedTXIndex(ctx sdk.Context, tokenContract string,
 	store.Set(idxKey, k.cdc.MustMarshalBinaryBare(&idSet))
 }
 
+// isUnbatched returns true if the pool transaction is in the unbatched index, pool entries
+// of batched transactions are kept until the batch is executed
+func (k Keeper) isUnbatched(ctx sdk.Context, tx *types.OutgoingTransferTx) bool {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeeSecondIndexKey(types.MustNewEthAddress(tx.Erc20Fee.Contract), *tx.Erc20Fee))
+	if bz == nil {
+		return false
+	}
+	var idSet types.IDSet
+	k.cdc.MustUnmarshalBinaryBare(bz, &idSet)
+	for _, id := range idSet.Ids {
+		if id == tx.Id {
+			return true
+		}
+	}
+	return false
+}
+
 // removeFromUnbatchedTXIndex removes the tx from the index and also removes it from the iterator
 // GetPoolTransactions, making this tx implicitly invisible without a direct request. We remove a tx
 // from the pool for good in OutgoingTxBatchExecuted, but if a batch is canceled or timed out we 'reactivate'
 // an entry by adding it back to the second index.
 func (k Keeper) removeFromUnbatchedTXIndex(ctx sdk.Context, fee types.ERC20Token, txID uint64) error {
 	store := ctx.KVStore(k.storeKey)
-	idxKey := types.GetFeeSecondIndexKey(fee)
+	idxKey := types.GetFeeSecondIndexKey(types.MustNewEthAddress(fee.Contract), fee)
 	var idSet types.IDSet
 	bz := store.Get(idxKey)
 	if bz == nil {
@@ -278,8 +311,12 @@ func (k Keeper) GetPoolTransactions(ctx sdk.Context) []*types.OutgoingTransferTx
 
 // IterateOutgoingPoolByFee iterates over the outgoing pool which is sorted by fee
 func (k Keeper) IterateOutgoingPoolByFee(ctx sdk.Context, contract string, cb func(uint64, *types.OutgoingTransferTx) bool) {
+	tokenContract, err := types.NewEthAddress(contract)
+	if err != nil {
+		return
+	}
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
-	iter := prefixStore.ReverseIterator(prefixRange([]byte(contract)))
+	iter := prefixStore.ReverseIterator(prefixRange([]byte(tokenContract.GetAddress())))
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		var ids types.IDSet
@@ -302,8 +339,104 @@ func (k Keeper) IterateOutgoingPoolByFee(ctx sdk.Context, contract string, cb fu
 // when to request batches and also used by the batch creation process to decide not to create
 // a new batch
 func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr string, maxElements uint) *types.BatchFees {
-	batchFeesMap := k.createBatchFees(ctx, maxElements)
-	return batchFeesMap[tokenContractAddr]
+	tokenContract, err := types.NewEthAddress(tokenContractAddr)
+	if err != nil {
+		return nil
+	}
+	tokenContractAddr = tokenContract.GetAddress()
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+	iter := prefixStore.Iterator(prefixRange([]byte(tokenContractAddr)))
+	defer iter.Close()
+
+	var batchFees *types.BatchFees
+	txCount := 0
+	for ; iter.Valid() && txCount < OutgoingTxBatchSize; iter.Next() {
+		var ids types.IDSet
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &ids)
+		feeAmount := sdk.NewIntFromBigInt(big.NewInt(0).SetBytes(iter.Key()[types.ETHContractAddressLen:]))
+
+		for i := 0; i < len(ids.Ids) && txCount < OutgoingTxBatchSize; i++ {
+			if batchFees == nil {
+				batchFees = &types.BatchFees{Token: tokenContractAddr, TotalFees: sdk.ZeroInt()}
+			}
+			batchFees.TotalFees = batchFees.TotalFees.Add(feeAmount)
+			txCount++
+		}
+	}
+	return batchFees
+}
+
+// GetPoolDepths returns the number of unbatched transactions of every token in the pool and the
+// fees they pay, ordered by token contract
+func (k Keeper) GetPoolDepths(ctx sdk.Context) (depths []types.PoolDepth) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var ids types.IDSet
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &ids)
+		if len(ids.Ids) == 0 {
+			continue
+		}
+		token := string(iter.Key()[:types.ETHContractAddressLen])
+		feeAmount := sdk.NewIntFromBigInt(big.NewInt(0).SetBytes(iter.Key()[types.ETHContractAddressLen:]))
+		if len(depths) == 0 || depths[len(depths)-1].TokenContract != token {
+			depths = append(depths, types.PoolDepth{TokenContract: token, TotalFees: sdk.ZeroInt()})
+		}
+		depth := &depths[len(depths)-1]
+		depth.UnbatchedTransfers += uint64(len(ids.Ids))
+		depth.TotalFees = depth.TotalFees.Add(feeAmount.MulRaw(int64(len(ids.Ids))))
+	}
+	return depths
+}
+
+// PaginateBatchFees returns the fees of the next batch of every token in a page of the tokens
+// with unbatched transactions, ordered by token contract. Only the fee index of the tokens in
+// the page is read, the page key is a token contract.
+func (k Keeper) PaginateBatchFees(ctx sdk.Context, pageReq *query.PageRequest) ([]*types.BatchFees, *query.PageResponse, error) {
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either offset or key is expected, got both")
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+
+	var (
+		batchFees []*types.BatchFees
+		nextKey   []byte
+		count     uint64
+	)
+	// jump from token to token, the fee index is sorted by token contract first
+	for start := pageReq.Key; ; {
+		iter := prefixStore.Iterator(start, nil)
+		if !iter.Valid() {
+			iter.Close()
+			break
+		}
+		token := string(iter.Key()[:types.ETHContractAddressLen])
+		iter.Close()
+		start = sdk.PrefixEndBytes([]byte(token))
+
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if count <= pageReq.Offset+pageReq.Limit {
+			if fees := k.GetBatchFeesByTokenType(ctx, token, OutgoingTxBatchSize); fees != nil {
+				batchFees = append(batchFees, fees)
+			}
+		} else if nextKey == nil {
+			nextKey = []byte(token)
+			if !pageReq.CountTotal || pageReq.Key != nil {
+				break
+			}
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal && pageReq.Key == nil {
+		pageRes.Total = count
+	}
+	return batchFees, pageRes, nil
 }
 
 // GetAllBatchFees creates a fee entry for every batch type currently in the store
@@ -380,3 +513,20 @@ func (k Keeper) autoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
 	store.Set(idKey, bz)
 	return id
 }
+
+// getNextID returns the id autoIncrementID will hand out next, or zero if it has never been called for idKey
+func (k Keeper) getNextID(ctx sdk.Context, idKey []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(idKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setNextID sets the id autoIncrementID will hand out next, zero leaves the counter unset
+func (k Keeper) setNextID(ctx sdk.Context, idKey []byte, id uint64) {
+	if id == 0 {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(idKey, sdk.Uint64ToBigEndian(id))
+}
@@ -20,7 +20,8 @@ func TestAddToOutgoingPool(t *testing.T) {
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
 	)
 	// mint some voucher first
-	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	allVouchers := sdk.Coins{sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(99999))}
+	input.GravityKeeper.RegisterDenomTrace(ctx, myTokenContractAddr)
 	err := input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -31,8 +32,8 @@ func TestAddToOutgoingPool(t *testing.T) {
 
 	// when
 	for i, v := range []uint64{2, 3, 2, 1} {
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(v))
 		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 		t.Logf("___ response: %#v", r)
@@ -87,7 +88,8 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
 	)
 	// mint some voucher first
-	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	allVouchers := sdk.Coins{sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(99999))}
+	input.GravityKeeper.RegisterDenomTrace(ctx, myTokenContractAddr)
 	err := input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -98,8 +100,8 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 
 	// create outgoing pool
 	for i, v := range []uint64{2, 3, 2, 1} {
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(v))
 		r, err2 := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err2)
 		t.Logf("___ response: %#v", r)
@@ -110,7 +112,8 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 		myToken2ContractAddr = "0x7D1AfA7B718fb893dB30A3aBc0Cfc608AaCfeBB0"
 	)
 	// mint some voucher first
-	allVouchers = sdk.Coins{types.NewERC20Token(18446744073709551615, myToken2ContractAddr).GravityCoin()}
+	allVouchers = sdk.Coins{sdk.NewCoin(types.GravityDenom(myToken2ContractAddr), sdk.NewIntFromUint64(18446744073709551615))}
+	input.GravityKeeper.RegisterDenomTrace(ctx, myToken2ContractAddr)
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -123,8 +126,8 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 
 	// create outgoing pool
 	for i := 0; i < 110; i++ {
-		amount := types.NewERC20Token(uint64(i+100), myToken2ContractAddr).GravityCoin()
-		fee := types.NewERC20Token(uint64(5), myToken2ContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myToken2ContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myToken2ContractAddr), sdk.NewIntFromUint64(uint64(5)))
 		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 		t.Logf("___ response: %#v", r)
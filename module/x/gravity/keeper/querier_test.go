@@ -143,7 +143,7 @@ func TestLastValsetRequests(t *testing.T) {
 		for j := 0; j <= i; j++ {
 			// add an validator each block
 			valAddr := bytes.Repeat([]byte{byte(j)}, sdk.AddrLen)
-			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String())
+			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, types.MustNewEthAddress(gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String()))
 			validators = append(validators, valAddr)
 		}
 		input.GravityKeeper.StakingKeeper = NewStakingKeeperMock(validators...)
@@ -300,7 +300,7 @@ func TestPendingValsetRequests(t *testing.T) {
 		for j := 0; j <= i; j++ {
 			// add an validator each block
 			valAddr := bytes.Repeat([]byte{byte(j)}, sdk.AddrLen)
-			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String())
+			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, types.MustNewEthAddress(gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String()))
 			validators = append(validators, valAddr)
 		}
 		input.GravityKeeper.StakingKeeper = NewStakingKeeperMock(validators...)
@@ -471,7 +471,7 @@ func TestLastPendingBatchRequest(t *testing.T) {
 			// add an validator each block
 			// TODO: replace with real SDK addresses
 			valAddr := bytes.Repeat([]byte{byte(j)}, sdk.AddrLen)
-			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String())
+			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, types.MustNewEthAddress(gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String()))
 			validators = append(validators, valAddr)
 		}
 		input.GravityKeeper.StakingKeeper = NewStakingKeeperMock(validators...)
@@ -541,7 +541,8 @@ func createTestBatch(t *testing.T, input TestInput) {
 		now                 = time.Now().UTC()
 	)
 	// mint some voucher first
-	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).GravityCoin()}
+	allVouchers := sdk.Coins{sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(99999))}
+	input.GravityKeeper.RegisterDenomTrace(input.Context, myTokenContractAddr)
 	err := input.BankKeeper.MintCoins(input.Context, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -552,8 +553,8 @@ func createTestBatch(t *testing.T, input TestInput) {
 
 	// add some TX to the pool
 	for i, v := range []uint64{2, 3, 2, 1} {
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(v))
 		_, err = input.GravityKeeper.AddToOutgoingPool(input.Context, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 	}
@@ -610,7 +611,7 @@ func TestQueryLogicCalls(t *testing.T) {
 			// add an validator each block
 			// TODO: replace with real SDK addresses
 			valAddr := bytes.Repeat([]byte{byte(j)}, sdk.AddrLen)
-			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String())
+			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, types.MustNewEthAddress(gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String()))
 			validators = append(validators, valAddr)
 		}
 		input.GravityKeeper.StakingKeeper = NewStakingKeeperMock(validators...)
@@ -618,7 +619,7 @@ func TestQueryLogicCalls(t *testing.T) {
 
 	token := []*types.ERC20Token{{
 		Contract: tokenContract,
-		Amount:   sdk.NewIntFromUint64(5000),
+		Amount:   sdk.NewInt(5000),
 	}}
 
 	call := types.OutgoingLogicCall{
@@ -666,7 +667,7 @@ func TestQueryLogicCallsConfirms(t *testing.T) {
 			// add an validator each block
 			// TODO: replace with real SDK addresses
 			valAddr := bytes.Repeat([]byte{byte(j)}, sdk.AddrLen)
-			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String())
+			input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, types.MustNewEthAddress(gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String()))
 			validators = append(validators, valAddr)
 		}
 		input.GravityKeeper.StakingKeeper = NewStakingKeeperMock(validators...)
@@ -674,7 +675,7 @@ func TestQueryLogicCallsConfirms(t *testing.T) {
 
 	token := []*types.ERC20Token{{
 		Contract: tokenContract,
-		Amount:   sdk.NewIntFromUint64(5000),
+		Amount:   sdk.NewInt(5000),
 	}}
 
 	call := types.OutgoingLogicCall{
@@ -853,12 +854,12 @@ func TestQueryCurrentValset(t *testing.T) {
 	input := CreateTestEnv(t)
 	input.GravityKeeper.StakingKeeper = NewStakingKeeperMock(valAddress)
 	ctx := input.Context
-	input.GravityKeeper.SetEthAddressForValidator(ctx, valAddress, ethAddress)
+	input.GravityKeeper.SetEthAddressForValidator(ctx, valAddress, types.MustNewEthAddress(ethAddress))
 
 	currentValset := input.GravityKeeper.GetCurrentValset(ctx)
 
 	bridgeVal := types.BridgeValidator{EthereumAddress: ethAddress, Power: 4294967295}
-	expectedValset := types.NewValset(1, 1234567, []*types.BridgeValidator{&bridgeVal}, sdk.NewIntFromUint64(0), "0x0000000000000000000000000000000000000000")
+	expectedValset := types.NewValset(1, 1234567, []*types.BridgeValidator{&bridgeVal}, sdk.NewInt(0), "0x0000000000000000000000000000000000000000")
 	assert.Equal(t, expectedValset, currentValset)
 }
 
@@ -914,11 +915,12 @@ func TestQueryPendingSendToEth(t *testing.T) {
 		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
 		allVouchers         = sdk.NewCoins(
-			types.NewERC20Token(99999, myTokenContractAddr).GravityCoin(),
+			sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewInt(99999)),
 		)
 	)
 
 	// mint some voucher first
+	input.GravityKeeper.RegisterDenomTrace(ctx, myTokenContractAddr)
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
@@ -929,8 +931,8 @@ func TestQueryPendingSendToEth(t *testing.T) {
 
 	// add some TX to the pool
 	for i, v := range []uint64{2, 3, 2, 1} {
-		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).GravityCoin()
-		fee := types.NewERC20Token(v, myTokenContractAddr).GravityCoin()
+		amount := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(uint64(i+100)))
+		fee := sdk.NewCoin(types.GravityDenom(myTokenContractAddr), sdk.NewIntFromUint64(v))
 		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
 		require.NoError(t, err)
 	}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetRelayerStats returns what an Ethereum relayer was observed relaying, found is false if
+// no claim reported the relayer yet
+func (k Keeper) GetRelayerStats(ctx sdk.Context, relayer string) (stats types.RelayerStats, found bool) {
+	relayerAddress, err := types.NewEthAddress(relayer)
+	if err != nil {
+		return stats, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRelayerStatsKey(*relayerAddress))
+	if bz == nil {
+		return stats, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	return stats, true
+}
+
+// SetRelayerStats sets the stats of an Ethereum relayer
+func (k Keeper) SetRelayerStats(ctx sdk.Context, stats types.RelayerStats) {
+	relayer := types.MustNewEthAddress(stats.Relayer)
+	stats.Relayer = relayer.GetAddress()
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRelayerStatsKey(relayer), k.cdc.MustMarshalBinaryBare(&stats))
+}
+
+// IterateRelayerStats iterates over the stats of every Ethereum relayer
+func (k Keeper) IterateRelayerStats(ctx sdk.Context, cb func(stats types.RelayerStats) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerStatsKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stats types.RelayerStats
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &stats)
+		// cb returns true to stop early
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// RecordRelayedBatch adds an observed batch and the fees of its transactions to the stats of the relayer
+func (k Keeper) RecordRelayedBatch(ctx sdk.Context, relayer string, batch *types.OutgoingTxBatch) {
+	k.recordRelayed(ctx, relayer, func(stats *types.RelayerStats) {
+		stats.Batches++
+		for _, tx := range batch.Transactions {
+			if tx.Erc20Fee != nil {
+				stats.Fees = addERC20Token(stats.Fees, *tx.Erc20Fee)
+			}
+		}
+	})
+}
+
+// RecordRelayedLogicCall adds an observed logic call and its fees to the stats of the relayer
+func (k Keeper) RecordRelayedLogicCall(ctx sdk.Context, relayer string, call *types.OutgoingLogicCall) {
+	k.recordRelayed(ctx, relayer, func(stats *types.RelayerStats) {
+		stats.LogicCalls++
+		for _, fee := range call.Fees {
+			if fee != nil {
+				stats.Fees = addERC20Token(stats.Fees, *fee)
+			}
+		}
+	})
+}
+
+// RecordRelayedValset adds an observed valset update and the reward it paid to the stats of the relayer,
+// a zero reward only counts the valset
+func (k Keeper) RecordRelayedValset(ctx sdk.Context, relayer string, reward types.ERC20Token) {
+	k.recordRelayed(ctx, relayer, func(stats *types.RelayerStats) {
+		stats.Valsets++
+		if reward.Amount.IsPositive() {
+			stats.ValsetRewards = addERC20Token(stats.ValsetRewards, reward)
+		}
+	})
+}
+
+// recordRelayed updates the stats of the relayer, claims that did not report a relayer are not recorded
+func (k Keeper) recordRelayed(ctx sdk.Context, relayer string, update func(stats *types.RelayerStats)) {
+	if relayer == "" {
+		return
+	}
+	stats, found := k.GetRelayerStats(ctx, relayer)
+	if !found {
+		stats = types.RelayerStats{Relayer: relayer}
+	}
+	update(&stats)
+	stats.LastRelayedHeight = uint64(ctx.BlockHeight())
+	k.SetRelayerStats(ctx, stats)
+}
+
+// addERC20Token adds the amount to the total of the same token contract
+func addERC20Token(totals []types.ERC20Token, token types.ERC20Token) []types.ERC20Token {
+	for i := range totals {
+		if types.CanonicalEthAddress(totals[i].Contract) == types.CanonicalEthAddress(token.Contract) {
+			totals[i].Amount = totals[i].Amount.Add(token.Amount)
+			return totals
+		}
+	}
+	return append(totals, token)
+}
+
+// RelayerStats queries the stats of a single relayer or pages over the stats of all relayers
+func (k Keeper) RelayerStats(
+	c context.Context,
+	req *types.QueryRelayerStatsRequest) (*types.QueryRelayerStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Relayer != "" {
+		stats, found := k.GetRelayerStats(ctx, req.Relayer)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no stats for relayer")
+		}
+		return &types.QueryRelayerStatsResponse{Stats: []types.RelayerStats{stats}}, nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerStatsKey)
+	var all []types.RelayerStats
+	pageRes, err := query.Paginate(store, limitPageRequest(req.Pagination, MaxResults), func(_, value []byte) error {
+		var stats types.RelayerStats
+		if err := k.cdc.UnmarshalBinaryBare(value, &stats); err != nil {
+			return err
+		}
+		all = append(all, stats)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryRelayerStatsResponse{Stats: all, Pagination: pageRes}, nil
+}
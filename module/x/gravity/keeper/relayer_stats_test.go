@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestRelayerStats(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	relayer := EthAddrs[0].String()
+	tokenContract := TokenContractAddrs[0]
+
+	k.StoreBatch(ctx, &types.OutgoingTxBatch{
+		BatchNonce:    1,
+		TokenContract: tokenContract,
+		Transactions: []*types.OutgoingTransferTx{
+			{Id: 1, Erc20Token: types.NewERC20Token(100, tokenContract), Erc20Fee: types.NewERC20Token(2, tokenContract)},
+			{Id: 2, Erc20Token: types.NewERC20Token(100, tokenContract), Erc20Fee: types.NewERC20Token(3, tokenContract)},
+		},
+	})
+	invalidationID := []byte("invalidation")
+	k.SetOutgoingLogicCall(ctx, &types.OutgoingLogicCall{
+		Fees:              []*types.ERC20Token{types.NewERC20Token(5, tokenContract), types.NewERC20Token(7, TokenContractAddrs[1])},
+		InvalidationId:    invalidationID,
+		InvalidationNonce: 1,
+	})
+
+	claims := []types.EthereumClaim{
+		&types.MsgBatchSendToEthClaim{EventNonce: 1, BatchNonce: 1, TokenContract: tokenContract, Relayer: relayer},
+		&types.MsgLogicCallExecutedClaim{EventNonce: 2, InvalidationId: invalidationID, InvalidationNonce: 1, Relayer: relayer},
+		&types.MsgValsetUpdatedClaim{EventNonce: 3, ValsetNonce: 1, RewardAmount: sdk.NewInt(9), RewardToken: TokenContractAddrs[1], Relayer: relayer},
+		// claims without a relayer are not recorded
+		&types.MsgValsetUpdatedClaim{EventNonce: 4, ValsetNonce: 2, RewardAmount: sdk.ZeroInt(), RewardToken: TokenContractAddrs[1]},
+	}
+	for _, claim := range claims {
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+
+	stats, found := k.GetRelayerStats(ctx, relayer)
+	require.True(t, found)
+	assert.Equal(t, uint64(1), stats.Batches)
+	assert.Equal(t, uint64(1), stats.LogicCalls)
+	assert.Equal(t, uint64(1), stats.Valsets)
+	assert.Equal(t, uint64(ctx.BlockHeight()), stats.LastRelayedHeight)
+	require.Len(t, stats.Fees, 2)
+	assert.Equal(t, *types.NewERC20Token(10, tokenContract), stats.Fees[0])
+	assert.Equal(t, *types.NewERC20Token(7, TokenContractAddrs[1]), stats.Fees[1])
+	assert.Equal(t, []types.ERC20Token{*types.NewERC20Token(9, TokenContractAddrs[1])}, stats.ValsetRewards)
+
+	// the relayer is part of the claim hash so validators have to agree on it
+	withRelayer := types.MsgBatchSendToEthClaim{EventNonce: 1, BatchNonce: 1, TokenContract: tokenContract, Relayer: relayer}
+	withoutRelayer := withRelayer
+	withoutRelayer.Relayer = ""
+	assert.NotEqual(t, withRelayer.ClaimHash(), withoutRelayer.ClaimHash())
+
+	c := sdk.WrapSDKContext(ctx)
+	k.SetRelayerStats(ctx, types.RelayerStats{Relayer: EthAddrs[1].String(), Batches: 1})
+	res, err := k.RelayerStats(c, &types.QueryRelayerStatsRequest{Relayer: relayer})
+	require.NoError(t, err)
+	assert.Equal(t, []types.RelayerStats{stats}, res.Stats)
+	_, err = k.RelayerStats(c, &types.QueryRelayerStatsRequest{Relayer: EthAddrs[2].String()})
+	assert.Error(t, err)
+	all, err := k.RelayerStats(c, &types.QueryRelayerStatsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, all.Stats, 2)
+	page, err := k.RelayerStats(c, &types.QueryRelayerStatsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	assert.Len(t, page.Stats, 1)
+	assert.NotNil(t, page.Pagination.NextKey)
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetTokenScaling returns the decimal scaling between the ERC20 and the Cosmos amounts of a token,
+// tokens without one are bridged 1:1
+func (k Keeper) GetTokenScaling(ctx sdk.Context, tokenContract string) types.TokenScaling {
+	scaling := types.TokenScaling{TokenContract: tokenContract}
+	contract, err := types.NewEthAddress(tokenContract)
+	if err != nil {
+		return scaling
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTokenScalingKey(*contract))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &scaling)
+	}
+	return scaling
+}
+
+// setTokenScaling sets the decimal scaling of a token, a zero exponent removes it
+func (k Keeper) setTokenScaling(ctx sdk.Context, scaling types.TokenScaling) {
+	contract := types.MustNewEthAddress(scaling.TokenContract)
+	scaling.TokenContract = contract.GetAddress()
+	store := ctx.KVStore(k.storeKey)
+	if scaling.Exponent == 0 {
+		store.Delete(types.GetTokenScalingKey(contract))
+		return
+	}
+	store.Set(types.GetTokenScalingKey(contract), k.cdc.MustMarshalBinaryBare(&scaling))
+}
+
+// IterateTokenScalings iterates over the decimal scaling of every scaled token
+func (k Keeper) IterateTokenScalings(ctx sdk.Context, cb func(scaling types.TokenScaling) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenScalingKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var scaling types.TokenScaling
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &scaling)
+		// cb returns true to stop early
+		if cb(scaling) {
+			break
+		}
+	}
+}
+
+// HandleTokenScalingProposal sets the decimal scaling between an Ethereum originated ERC20 and its voucher.
+// Changing it would change the value of existing vouchers and pending transfers, so it is refused while any exist
+func (k Keeper) HandleTokenScalingProposal(ctx sdk.Context, p *types.TokenScalingProposal) error {
+	scaling := types.TokenScaling{TokenContract: p.TokenContract, Exponent: p.Exponent}
+	if err := scaling.ValidateBasic(); err != nil {
+		return err
+	}
+	if denom, exists := k.GetCosmosOriginatedDenom(ctx, p.TokenContract); exists {
+		return sdkerrors.Wrapf(types.ErrInvalid, "erc20 %s represents the Cosmos originated %s", p.TokenContract, denom)
+	}
+	_, denom := k.ERC20ToDenomLookup(ctx, p.TokenContract)
+	if !k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom).IsZero() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "vouchers of %s exist", denom)
+	}
+	if k.hasPendingOutgoingTransfers(ctx, p.TokenContract) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "transfers of erc20 %s are still pending", p.TokenContract)
+	}
+	k.setTokenScaling(ctx, scaling)
+	k.emitTokenScalingSet(ctx, scaling)
+	return nil
+}
+
+// emitTokenScalingSet reports the decimal scaling a token was given
+func (k Keeper) emitTokenScalingSet(ctx sdk.Context, scaling types.TokenScaling) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTokenScalingSet,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyERC20, scaling.TokenContract),
+		sdk.NewAttribute(types.AttributeKeyExponent, fmt.Sprint(scaling.Exponent)),
+	))
+}
+
+// GetDustPoolAddress returns the address of the module account deposit dust is credited to
+func (k Keeper) GetDustPoolAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.DustPoolName)
+}
+
+// GetDust returns the ERC20 units of deposits of a token that did not make up a Cosmos unit yet
+func (k Keeper) GetDust(ctx sdk.Context, tokenContract string) sdk.Int {
+	contract, err := types.NewEthAddress(tokenContract)
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDustKey(*contract))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var dust types.ERC20Token
+	k.cdc.MustUnmarshalBinaryBare(bz, &dust)
+	return dust.Amount
+}
+
+// setDust sets the ERC20 units of deposits of a token that did not make up a Cosmos unit yet
+func (k Keeper) setDust(ctx sdk.Context, dust types.ERC20Token) {
+	contract := types.MustNewEthAddress(dust.Contract)
+	dust.Contract = contract.GetAddress()
+	store := ctx.KVStore(k.storeKey)
+	if dust.Amount.IsZero() {
+		store.Delete(types.GetDustKey(contract))
+		return
+	}
+	store.Set(types.GetDustKey(contract), k.cdc.MustMarshalBinaryBare(&dust))
+}
+
+// IterateDust iterates over the dust of every token that has some
+func (k Keeper) IterateDust(ctx sdk.Context, cb func(dust types.ERC20Token) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DustKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var dust types.ERC20Token
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &dust)
+		// cb returns true to stop early
+		if cb(dust) {
+			break
+		}
+	}
+}
+
+// creditDeposit converts the ERC20 amount of a deposit to Cosmos units and sends them to the receiver, minting
+// vouchers of Ethereum originated tokens and unlocking Cosmos originated ones
+func (k Keeper) creditDeposit(ctx sdk.Context, tokenContract string, erc20Amount sdk.Int, receiver sdk.AccAddress) error {
+	coin, err := k.releaseDeposit(ctx, tokenContract, erc20Amount)
+	if err != nil || !coin.IsPositive() {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(coin)); err != nil {
+		return sdkerrors.Wrap(err, "transfer vouchers")
+	}
+	return nil
+}
+
+// releaseDeposit converts the ERC20 amount of a deposit to Cosmos units and makes them available in the module
+// account. ERC20 units that don't make up a Cosmos unit are added to the dust of the token, which is credited
+// to the dust pool as it makes up whole units
+func (k Keeper) releaseDeposit(ctx sdk.Context, tokenContract string, erc20Amount sdk.Int) (sdk.Coin, error) {
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+	if !isCosmosOriginated {
+		denom = k.RegisterDenomTrace(ctx, tokenContract).Denom
+	}
+	scaling := k.GetTokenScaling(ctx, tokenContract)
+	amount, dust := scaling.FromERC20(erc20Amount)
+
+	if !dust.IsZero() {
+		var credited sdk.Int
+		credited, dust = scaling.FromERC20(k.GetDust(ctx, tokenContract).Add(dust))
+		k.setDust(ctx, types.ERC20Token{Contract: tokenContract, Amount: dust})
+		if credited.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(denom, credited))
+			if err := k.releaseToModule(ctx, isCosmosOriginated, coins); err != nil {
+				return sdk.Coin{}, err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.DustPoolName, coins); err != nil {
+				return sdk.Coin{}, sdkerrors.Wrap(err, "credit dust")
+			}
+		}
+	}
+
+	coin := sdk.NewCoin(denom, amount)
+	if coin.IsPositive() {
+		if err := k.releaseToModule(ctx, isCosmosOriginated, sdk.NewCoins(coin)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	return coin, nil
+}
+
+// releaseToModule makes coins that arrived from Ethereum available in the module account, Cosmos originated
+// coins are already locked there and Ethereum originated vouchers are minted
+func (k Keeper) releaseToModule(ctx sdk.Context, isCosmosOriginated bool, coins sdk.Coins) error {
+	if isCosmosOriginated {
+		return nil
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+	}
+	return nil
+}
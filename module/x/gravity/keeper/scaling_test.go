@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestTokenScaling(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	receiver := AccAddrs[0]
+	nonce := uint64(0)
+
+	deposit := func(tokenContract string, amount int64) {
+		nonce++
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract,
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: receiver.String(),
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+
+	// an ERC20 with 3 decimals more than its voucher credits the remainder of deposits as dust
+	scaled := TokenContractAddrs[0]
+	scaledDenom := types.GravityDenom(scaled)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.HandleTokenScalingProposal(ctx, types.NewTokenScalingProposal("title", "description", scaled, 3)))
+	assert.True(t, hasEvent(ctx, types.EventTypeTokenScalingSet))
+
+	deposit(scaled, 1500)
+	assert.Equal(t, sdk.NewInt(1), input.BankKeeper.GetBalance(ctx, receiver, scaledDenom).Amount)
+	assert.Equal(t, sdk.NewInt(500), k.GetDust(ctx, scaled))
+	deposit(scaled, 700)
+	assert.Equal(t, sdk.NewInt(1), input.BankKeeper.GetBalance(ctx, receiver, scaledDenom).Amount)
+	assert.Equal(t, sdk.NewInt(200), k.GetDust(ctx, scaled))
+	assert.Equal(t, sdk.NewInt(1), input.BankKeeper.GetBalance(ctx, k.GetDustPoolAddress(), scaledDenom).Amount)
+
+	res, err := k.ERC20ToDenom(sdk.WrapSDKContext(ctx), &types.QueryERC20ToDenomRequest{Erc20: scaled})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), res.Exponent)
+
+	// the scaling can't change once vouchers exist
+	assert.Error(t, k.HandleTokenScalingProposal(ctx, types.NewTokenScalingProposal("title", "description", scaled, 6)))
+
+	_, err = k.AddToOutgoingPool(ctx, receiver, EthAddrs[1].String(), sdk.NewCoin(scaledDenom, sdk.NewInt(1)), sdk.NewCoin(scaledDenom, sdk.ZeroInt()))
+	require.NoError(t, err)
+	pool := k.GetPoolTransactions(ctx)
+	require.Len(t, pool, 1)
+	assert.Equal(t, sdk.NewInt(1000), pool[0].Erc20Token.Amount)
+
+	// an ERC20 with 2 decimals less than its voucher only sends whole ERC20 units
+	fine := TokenContractAddrs[1]
+	fineDenom := types.GravityDenom(fine)
+	require.NoError(t, k.HandleTokenScalingProposal(ctx, types.NewTokenScalingProposal("title", "description", fine, -2)))
+	deposit(fine, 5)
+	assert.Equal(t, sdk.NewInt(500), input.BankKeeper.GetBalance(ctx, receiver, fineDenom).Amount)
+	assert.True(t, k.GetDust(ctx, fine).IsZero())
+
+	_, err = k.AddToOutgoingPool(ctx, receiver, EthAddrs[1].String(), sdk.NewCoin(fineDenom, sdk.NewInt(150)), sdk.NewCoin(fineDenom, sdk.ZeroInt()))
+	assert.Error(t, err)
+	_, err = k.AddToOutgoingPool(ctx, receiver, EthAddrs[1].String(), sdk.NewCoin(fineDenom, sdk.NewInt(200)), sdk.NewCoin(fineDenom, sdk.NewInt(1)))
+	assert.Error(t, err)
+	id, err := k.AddToOutgoingPool(ctx, receiver, EthAddrs[1].String(), sdk.NewCoin(fineDenom, sdk.NewInt(200)), sdk.NewCoin(fineDenom, sdk.NewInt(100)))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(200), input.BankKeeper.GetBalance(ctx, receiver, fineDenom).Amount)
+
+	// canceling refunds the voucher amount
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, id, receiver))
+	assert.Equal(t, sdk.NewInt(500), input.BankKeeper.GetBalance(ctx, receiver, fineDenom).Amount)
+}
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetValidatorBridgeSigningInfo returns the bridge signing info of a validator, found is false if
+// nothing was recorded for it yet
+func (k Keeper) GetValidatorBridgeSigningInfo(ctx sdk.Context, val sdk.ValAddress) (info types.ValidatorBridgeSigningInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorBridgeSigningInfoKey(val))
+	if bz == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+// SetValidatorBridgeSigningInfo sets the bridge signing info of a validator
+func (k Keeper) SetValidatorBridgeSigningInfo(ctx sdk.Context, info types.ValidatorBridgeSigningInfo) {
+	val, err := sdk.ValAddressFromBech32(info.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBridgeSigningInfoKey(val), k.cdc.MustMarshalBinaryBare(&info))
+}
+
+// IterateValidatorBridgeSigningInfos iterates over the bridge signing info of every validator
+func (k Keeper) IterateValidatorBridgeSigningInfos(ctx sdk.Context, cb func(info types.ValidatorBridgeSigningInfo) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorBridgeSigningInfoKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.ValidatorBridgeSigningInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		// cb returns true to stop early
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// RecordValsetConfirm records whether a validator missed confirming a valset it was slashable for
+func (k Keeper) RecordValsetConfirm(ctx sdk.Context, val sdk.ValAddress, missed bool) {
+	k.recordBridgeSigning(ctx, val, missed, func(info *types.ValidatorBridgeSigningInfo) *types.BridgeSigningCounter {
+		return &info.Valsets
+	})
+}
+
+// RecordBatchConfirm records whether a validator missed confirming a batch it was slashable for
+func (k Keeper) RecordBatchConfirm(ctx sdk.Context, val sdk.ValAddress, missed bool) {
+	k.recordBridgeSigning(ctx, val, missed, func(info *types.ValidatorBridgeSigningInfo) *types.BridgeSigningCounter {
+		return &info.Batches
+	})
+}
+
+// RecordLogicCallConfirm records whether a validator missed confirming a logic call it was slashable for
+func (k Keeper) RecordLogicCallConfirm(ctx sdk.Context, val sdk.ValAddress, missed bool) {
+	k.recordBridgeSigning(ctx, val, missed, func(info *types.ValidatorBridgeSigningInfo) *types.BridgeSigningCounter {
+		return &info.LogicCalls
+	})
+}
+
+// RecordClaim records whether a bonded validator missed voting on an event by the time it was observed
+func (k Keeper) RecordClaim(ctx sdk.Context, val sdk.ValAddress, missed bool) {
+	k.recordBridgeSigning(ctx, val, missed, func(info *types.ValidatorBridgeSigningInfo) *types.BridgeSigningCounter {
+		return &info.Claims
+	})
+}
+
+// recordBridgeSigning adds a miss or a hit to the counter picked from the bridge signing info of
+// the validator, the info is started at the current height the first time anything is recorded
+func (k Keeper) recordBridgeSigning(
+	ctx sdk.Context,
+	val sdk.ValAddress,
+	missed bool,
+	counter func(info *types.ValidatorBridgeSigningInfo) *types.BridgeSigningCounter) {
+	info, found := k.GetValidatorBridgeSigningInfo(ctx, val)
+	if !found {
+		info = types.ValidatorBridgeSigningInfo{
+			ValidatorAddress: val.String(),
+			StartHeight:      ctx.BlockHeight(),
+		}
+	}
+	var window uint64
+	k.paramSpace.Get(ctx, types.ParamStoreBridgeSigningWindow, &window)
+	counter(&info).Record(window, missed)
+	k.SetValidatorBridgeSigningInfo(ctx, info)
+}
+
+// HandleBridgeDowntime slashes and jails a validator that confirmed less than the min signed per window
+// of the last bridge signing window of valsets, batches or logic calls, for graduated bridge slashing.
+// The counters start over on a jailing and the jail time grows with every bridge downtime jailing, until
+// a full window of valsets is created since its last jailing without the validator being down again.
+// Jailed validators are left alone, they were already slashed for the downtime that got them jailed
+func (k Keeper) HandleBridgeDowntime(ctx sdk.Context, val stakingtypes.Validator, params types.Params) {
+	info, found := k.GetValidatorBridgeSigningInfo(ctx, val.GetOperator())
+	if !found || val.IsJailed() {
+		return
+	}
+	minSigned := params.MinSignedPerWindow.MulInt64(int64(params.BridgeSigningWindow)).RoundInt64()
+	maxMissed := params.BridgeSigningWindow - uint64(minSigned)
+
+	counters := []*types.BridgeSigningCounter{&info.Valsets, &info.Batches, &info.LogicCalls}
+	down := false
+	for _, counter := range counters {
+		if counter.MissedCounter > maxMissed {
+			down = true
+		}
+	}
+	// validators left out of capped valsets aren't recorded for them, so the window is counted in valsets
+	// created rather than valsets recorded. Records from before the jailing valset nonce was kept only
+	// have the valsets counter to go by
+	latestValsetNonce := k.GetLatestValsetNonce(ctx)
+	cleanWindow := info.Valsets.Recorded() >= params.BridgeSigningWindow
+	if info.JailedValsetNonce > 0 {
+		cleanWindow = latestValsetNonce >= info.JailedValsetNonce+params.BridgeSigningWindow
+	}
+	if info.DowntimeJailings > 0 && cleanWindow {
+		info.DowntimeJailings = 0
+		k.SetValidatorBridgeSigningInfo(ctx, info)
+	}
+	if !down {
+		return
+	}
+
+	for _, counter := range counters {
+		counter.StartOver()
+	}
+	info.DowntimeJailings++
+	info.JailedValsetNonce = latestValsetNonce
+	k.SetValidatorBridgeSigningInfo(ctx, info)
+	cons, _ := val.GetConsAddr()
+	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionBridgeDowntime)
+	k.StakingKeeper.Jail(ctx, cons)
+	jailedUntil := ctx.BlockTime().Add(params.BridgeJailDuration * time.Duration(info.DowntimeJailings))
+	// the slashing module only keeps the unjail time of validators it has signing info for, a longer
+	// jailing it already has is kept
+	if signingInfo, found := k.SlashingKeeper.GetValidatorSigningInfo(ctx, cons); found {
+		if signingInfo.JailedUntil.After(jailedUntil) {
+			jailedUntil = signingInfo.JailedUntil
+		}
+		k.SlashingKeeper.JailUntil(ctx, cons, jailedUntil)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeDowntime,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
+		sdk.NewAttribute(types.AttributeKeyJailedUntil, jailedUntil.String()),
+	))
+}
+
+// ValidatorBridgeSigningInfo queries how often a validator missed bridge confirms and claims
+func (k Keeper) ValidatorBridgeSigningInfo(
+	c context.Context,
+	req *types.QueryValidatorBridgeSigningInfoRequest) (*types.QueryValidatorBridgeSigningInfoResponse, error) {
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "validator address")
+	}
+	info, found := k.GetValidatorBridgeSigningInfo(sdk.UnwrapSDKContext(c), val)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no bridge signing info for validator")
+	}
+	return &types.QueryValidatorBridgeSigningInfoResponse{Info: info}, nil
+}
+
+// ValidatorBridgeSigningInfos queries the bridge signing info of all validators
+func (k Keeper) ValidatorBridgeSigningInfos(
+	c context.Context,
+	req *types.QueryValidatorBridgeSigningInfosRequest) (*types.QueryValidatorBridgeSigningInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorBridgeSigningInfoKey)
+
+	var infos []types.ValidatorBridgeSigningInfo
+	pageRes, err := query.Paginate(store, limitPageRequest(req.Pagination, MaxResults), func(_, value []byte) error {
+		var info types.ValidatorBridgeSigningInfo
+		if err := k.cdc.UnmarshalBinaryBare(value, &info); err != nil {
+			return err
+		}
+		infos = append(infos, info)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryValidatorBridgeSigningInfosResponse{Infos: infos, Pagination: pageRes}, nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestValidatorBridgeSigningInfo(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	window := k.GetParams(ctx).BridgeSigningWindow
+
+	_, found := k.GetValidatorBridgeSigningInfo(ctx, ValAddrs[0])
+	assert.False(t, found)
+
+	k.RecordBatchConfirm(ctx, ValAddrs[0], true)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.RecordBatchConfirm(ctx, ValAddrs[0], false)
+	k.RecordLogicCallConfirm(ctx, ValAddrs[0], true)
+	k.RecordValsetConfirm(ctx, ValAddrs[1], false)
+	k.RecordClaim(ctx, ValAddrs[1], true)
+
+	info, found := k.GetValidatorBridgeSigningInfo(ctx, ValAddrs[0])
+	require.True(t, found)
+	// the start height is the block the first record was made at
+	assert.Equal(t, ctx.BlockHeight()-1, info.StartHeight)
+	assert.Equal(t, uint64(1), info.Batches.MissedCounter)
+	assert.Equal(t, uint64(2), info.Batches.IndexOffset)
+	assert.Equal(t, window, info.Batches.Window)
+	assert.Equal(t, uint64(1), info.LogicCalls.MissedCounter)
+	assert.Equal(t, uint64(0), info.Valsets.IndexOffset)
+
+	// the batch missed first drops out of the window
+	for i := uint64(0); i < window; i++ {
+		k.RecordBatchConfirm(ctx, ValAddrs[0], false)
+	}
+	info, _ = k.GetValidatorBridgeSigningInfo(ctx, ValAddrs[0])
+	assert.Equal(t, uint64(0), info.Batches.MissedCounter)
+	assert.Equal(t, uint64(1), info.Batches.TotalMissed)
+
+	c := sdk.WrapSDKContext(ctx)
+	res, err := k.ValidatorBridgeSigningInfo(c, &types.QueryValidatorBridgeSigningInfoRequest{ValidatorAddress: ValAddrs[1].String()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), res.Info.Claims.MissedCounter)
+	assert.Equal(t, uint64(1), res.Info.Valsets.IndexOffset)
+	_, err = k.ValidatorBridgeSigningInfo(c, &types.QueryValidatorBridgeSigningInfoRequest{ValidatorAddress: ValAddrs[2].String()})
+	assert.Error(t, err)
+	_, err = k.ValidatorBridgeSigningInfo(c, &types.QueryValidatorBridgeSigningInfoRequest{ValidatorAddress: "invalid"})
+	assert.Error(t, err)
+
+	all, err := k.ValidatorBridgeSigningInfos(c, &types.QueryValidatorBridgeSigningInfosRequest{})
+	require.NoError(t, err)
+	assert.Len(t, all.Infos, 2)
+	page, err := k.ValidatorBridgeSigningInfos(c, &types.QueryValidatorBridgeSigningInfosRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	assert.Len(t, page.Infos, 1)
+	assert.NotNil(t, page.Pagination.NextKey)
+}
+
+func TestBridgeDowntimeJailingsReset(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	params.GraduatedBridgeSlashing = true
+	params.BridgeSigningWindow = 4
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+	val, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	consAddr, _ := val.GetConsAddr()
+	handle := func(missed ...bool) types.ValidatorBridgeSigningInfo {
+		for _, m := range missed {
+			k.RecordBatchConfirm(ctx, ValAddrs[0], m)
+		}
+		val, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+		require.True(t, found)
+		k.HandleBridgeDowntime(ctx, val, params)
+		info, _ := k.GetValidatorBridgeSigningInfo(ctx, ValAddrs[0])
+		return info
+	}
+	unjail := func() {
+		input.StakingKeeper.Unjail(ctx, consAddr)
+	}
+	jailedUntil := func() time.Time {
+		signingInfo, found := input.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		return signingInfo.JailedUntil
+	}
+
+	k.SetValsetRequest(ctx)
+	info := handle(true, true, true)
+	assert.Equal(t, uint64(1), info.DowntimeJailings)
+	assert.Equal(t, uint64(1), info.JailedValsetNonce)
+	assert.Equal(t, uint64(0), info.Batches.IndexOffset)
+
+	// a jailed validator isn't slashed or jailed again
+	power := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()
+	info = handle(true, true, true)
+	assert.Equal(t, uint64(1), info.DowntimeJailings)
+	assert.Equal(t, power, input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens())
+
+	unjail()
+	info = handle(true, true, true)
+	assert.Equal(t, uint64(2), info.DowntimeJailings)
+	assert.Equal(t, ctx.BlockTime().Add(2*params.BridgeJailDuration), jailedUntil())
+
+	// the jailings are kept until a full window of valsets is created without being down, whether or not
+	// the validator was in them
+	unjail()
+	for i := uint64(0); i < params.BridgeSigningWindow-1; i++ {
+		k.SetValsetRequest(ctx)
+	}
+	info = handle(false)
+	assert.Equal(t, uint64(2), info.DowntimeJailings)
+	k.SetValsetRequest(ctx)
+	info = handle(false)
+	assert.Equal(t, uint64(0), info.DowntimeJailings)
+
+	// so the next jailing is as short as the first one, unless the validator is already jailed for longer
+	longer := ctx.BlockTime().Add(10 * params.BridgeJailDuration)
+	input.SlashingKeeper.JailUntil(ctx, consAddr, longer)
+	info = handle(true, true, true)
+	assert.Equal(t, uint64(1), info.DowntimeJailings)
+	assert.Equal(t, longer, jailedUntil())
+}
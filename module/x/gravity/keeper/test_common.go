@@ -152,11 +152,11 @@ var (
 
 	// TokenContractAddrs holds example token contract addresses
 	TokenContractAddrs = []string{
-		"0x6b175474e89094c44da98b954eedeac495271d0f", // DAI
-		"0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", // YFI
-		"0x1f9840a85d5af5bf1d1762f925bdaddc4201f984", // UNI
-		"0xc00e94cb662c3520282e6f5717214004a7f26888", // COMP
-		"0xc011a73ee8576fb46f5e1c5751ca3b9fe0af2a6f", // SNX
+		"0x6B175474E89094C44Da98b954EedeAC495271d0F", // DAI
+		"0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", // YFI
+		"0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984", // UNI
+		"0xc00e94Cb662C3520282E6f5717214004A7f26888", // COMP
+		"0xC011a73ee8576Fb46F5E1c5751cA3B9Fe0af2a6F", // SNX
 	}
 
 	// InitTokens holds the number of tokens to initialize an account with
@@ -200,6 +200,12 @@ var (
 			Denom:  "",
 			Amount: sdk.ZeroInt(),
 		},
+		BridgeSigningWindow:         10,
+		MinSignedPerWindow:          sdk.NewDecWithPrec(5, 1),
+		BridgeJailDuration:          time.Minute,
+		SlashFractionBridgeDowntime: sdk.NewDecWithPrec(1, 2),
+		ValsetPowerDiffThreshold:    sdk.NewDecWithPrec(5, 2),
+		MinBridgePowerShare:         sdk.NewDecWithPrec(9, 1),
 	}
 )
 
@@ -212,6 +218,7 @@ type TestInput struct {
 	DistKeeper     distrkeeper.Keeper
 	BankKeeper     bankkeeper.BaseKeeper
 	GovKeeper      govkeeper.Keeper
+	ParamsKey      sdk.StoreKey
 	Context        sdk.Context
 	Marshaler      codec.Marshaler
 	LegacyAmino    *codec.LegacyAmino
@@ -257,7 +264,7 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 
 	// Register eth addresses for each validator
 	for i, addr := range ValAddrs {
-		input.GravityKeeper.SetEthAddressForValidator(input.Context, addr, EthAddrs[i].String())
+		input.GravityKeeper.SetEthAddressForValidator(input.Context, addr, types.MustNewEthAddress(EthAddrs[i].String()))
 	}
 
 	// Return the test input
@@ -320,6 +327,8 @@ func CreateTestEnv(t *testing.T) TestInput {
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
+		types.ValsetRewardPoolName:     {authtypes.Burner},
+		types.DustPoolName:             nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -424,6 +433,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		SlashingKeeper: slashingKeeper,
 		DistKeeper:     distKeeper,
 		GovKeeper:      govKeeper,
+		ParamsKey:      keyParams,
 		Context:        ctx,
 		Marshaler:      marshaler,
 		LegacyAmino:    cdc,
@@ -461,7 +471,7 @@ func MakeTestMarshaler() codec.Marshaler {
 
 // MintVouchersFromAir creates new gravity vouchers given erc20tokens
 func MintVouchersFromAir(t *testing.T, ctx sdk.Context, k Keeper, dest sdk.AccAddress, amount types.ERC20Token) sdk.Coin {
-	coin := amount.GravityCoin()
+	coin := sdk.NewCoin(k.RegisterDenomTrace(ctx, amount.Contract).Denom, amount.Amount)
 	vouchers := sdk.Coins{coin}
 	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers)
 	require.NoError(t, err)
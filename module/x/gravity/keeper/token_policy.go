@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetTokenPolicy returns the policy for which Ethereum originated tokens are bridged to Cosmos,
+// a chain that never set one allows every token
+func (k Keeper) GetTokenPolicy(ctx sdk.Context) (policy types.TokenPolicy) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TokenPolicyKey)
+	if bz == nil {
+		return policy
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &policy)
+	return policy
+}
+
+// SetTokenPolicy sets the policy for which Ethereum originated tokens are bridged to Cosmos
+func (k Keeper) SetTokenPolicy(ctx sdk.Context, policy types.TokenPolicy) {
+	ctx.KVStore(k.storeKey).Set(types.TokenPolicyKey, k.cdc.MustMarshalBinaryBare(&policy))
+}
+
+// IsTokenAllowed returns true if deposits of the token are bridged to Cosmos, Cosmos originated
+// tokens are always allowed back
+func (k Keeper) IsTokenAllowed(ctx sdk.Context, tokenContract string) bool {
+	if _, exists := k.GetCosmosOriginatedDenom(ctx, tokenContract); exists {
+		return true
+	}
+	return k.GetTokenPolicy(ctx).Allows(tokenContract)
+}
+
+// HandleTokenPolicyProposal replaces the token policy with the one of a passed proposal. Deposits
+// held under the previous policy stay held until their receiver reclaims them
+func (k Keeper) HandleTokenPolicyProposal(ctx sdk.Context, p *types.TokenPolicyProposal) error {
+	if err := p.Policy.ValidateBasic(); err != nil {
+		return err
+	}
+	k.SetTokenPolicy(ctx, p.Policy)
+	return nil
+}
+
+// GetHeldDeposit returns a deposit held by the token policy
+func (k Keeper) GetHeldDeposit(ctx sdk.Context, id uint64) (deposit types.HeldDeposit, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetHeldDepositKey(id))
+	if bz == nil {
+		return deposit, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
+	return deposit, true
+}
+
+// SetHeldDeposit sets a deposit held by the token policy
+func (k Keeper) SetHeldDeposit(ctx sdk.Context, deposit types.HeldDeposit) {
+	ctx.KVStore(k.storeKey).Set(types.GetHeldDepositKey(deposit.Id), k.cdc.MustMarshalBinaryBare(&deposit))
+}
+
+// IterateHeldDeposits iterates over the deposits held by the token policy in id order
+func (k Keeper) IterateHeldDeposits(ctx sdk.Context, cb func(deposit types.HeldDeposit) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.HeldDepositKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.HeldDeposit
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &deposit)
+		// cb returns true to stop early
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// HoldDeposit stores an observed deposit of a token the policy does not allow instead of minting
+// vouchers for it. Nothing is minted until the receiver reclaims the deposit
+func (k Keeper) HoldDeposit(ctx sdk.Context, claim *types.MsgSendToCosmosClaim) types.HeldDeposit {
+	deposit := types.HeldDeposit{
+		Id:             k.autoIncrementID(ctx, types.KeyLastHeldDepositID),
+		EventNonce:     claim.EventNonce,
+		TokenContract:  claim.TokenContract,
+		Amount:         claim.Amount,
+		EthereumSender: claim.EthereumSender,
+		CosmosReceiver: claim.CosmosReceiver,
+		HeldHeight:     uint64(ctx.BlockHeight()),
+	}
+	k.SetHeldDeposit(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDepositHeld,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyHeldDepositID, fmt.Sprint(deposit.Id)),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(deposit.EventNonce)),
+		sdk.NewAttribute(types.AttributeKeyERC20, deposit.TokenContract),
+	))
+	return deposit
+}
+
+// ReclaimHeldDeposit settles a held deposit for its cosmos receiver. If the token is allowed by now the
+// vouchers are minted to the receiver, otherwise they are minted to the module and sent back to the
+// ethereum sender, paying the return fee out of the returned vouchers as the batch fee. The module is
+// the sender of the returned transfer so it can't be canceled into vouchers of the disallowed token
+func (k Keeper) ReclaimHeldDeposit(ctx sdk.Context, id uint64, receiver sdk.AccAddress, returnFee sdk.Int) error {
+	deposit, found := k.GetHeldDeposit(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknown, "held deposit %d", id)
+	}
+	if deposit.CosmosReceiver != receiver.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not the receiver of the held deposit")
+	}
+
+	outcome := types.ReclaimOutcomeReleased
+	if k.IsTokenAllowed(ctx, deposit.TokenContract) {
+		if err := k.creditDeposit(ctx, deposit.TokenContract, deposit.Amount, receiver); err != nil {
+			return err
+		}
+	} else {
+		outcome = types.ReclaimOutcomeReturned
+		coin, err := k.releaseDeposit(ctx, deposit.TokenContract, deposit.Amount)
+		if err != nil {
+			return err
+		}
+		if coin.IsPositive() {
+			if returnFee.GTE(coin.Amount) {
+				return sdkerrors.Wrapf(types.ErrInvalid, "return fee %s not below the returned %s", returnFee, coin)
+			}
+			module := authtypes.NewModuleAddress(types.ModuleName)
+			fee := sdk.NewCoin(coin.Denom, returnFee)
+			if _, err := k.AddToOutgoingPool(ctx, module, deposit.EthereumSender, coin.Sub(fee), fee); err != nil {
+				return sdkerrors.Wrap(err, "return deposit")
+			}
+		}
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetHeldDepositKey(id))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHeldDepositReclaimed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyHeldDepositID, fmt.Sprint(id)),
+		sdk.NewAttribute(types.AttributeKeyReclaimOutcome, outcome),
+	))
+	return nil
+}
+
+// TokenPolicy queries the policy for which Ethereum originated tokens are bridged to Cosmos
+func (k Keeper) TokenPolicy(
+	c context.Context,
+	req *types.QueryTokenPolicyRequest) (*types.QueryTokenPolicyResponse, error) {
+	return &types.QueryTokenPolicyResponse{Policy: k.GetTokenPolicy(sdk.UnwrapSDKContext(c))}, nil
+}
+
+// HeldDeposits pages over the deposits held by the token policy, optionally only those of one receiver
+func (k Keeper) HeldDeposits(
+	c context.Context,
+	req *types.QueryHeldDepositsRequest) (*types.QueryHeldDepositsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HeldDepositKey)
+	var deposits []types.HeldDeposit
+	pageRes, err := query.FilteredPaginate(store, limitPageRequest(req.Pagination, MaxResults), func(_, value []byte, accumulate bool) (bool, error) {
+		var deposit types.HeldDeposit
+		if err := k.cdc.UnmarshalBinaryBare(value, &deposit); err != nil {
+			return false, err
+		}
+		if req.CosmosReceiver != "" && deposit.CosmosReceiver != req.CosmosReceiver {
+			return false, nil
+		}
+		if accumulate {
+			deposits = append(deposits, deposit)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryHeldDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
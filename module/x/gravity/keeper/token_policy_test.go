@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestHeldDeposits(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	tokenContract := TokenContractAddrs[0]
+	denom := types.GravityDenom(tokenContract)
+	receiver := AccAddrs[0]
+
+	proposal := types.NewTokenPolicyProposal("title", "description", types.TokenPolicy{
+		Mode:           types.TOKEN_POLICY_MODE_DENY_LIST,
+		TokenContracts: []string{tokenContract},
+	})
+	require.NoError(t, k.HandleTokenPolicyProposal(ctx, proposal))
+	assert.False(t, k.IsTokenAllowed(ctx, tokenContract))
+	assert.True(t, k.IsTokenAllowed(ctx, TokenContractAddrs[1]))
+
+	// deposits of the denied token are held instead of minted
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract,
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: receiver.String(),
+		}
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+		assert.True(t, hasEvent(ctx, types.EventTypeDepositHeld))
+	}
+	assert.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).IsZero())
+	assert.True(t, input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom).IsZero())
+
+	c := sdk.WrapSDKContext(ctx)
+	res, err := k.HeldDeposits(c, &types.QueryHeldDepositsRequest{CosmosReceiver: receiver.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Deposits, 2)
+	assert.Equal(t, uint64(1), res.Deposits[0].Id)
+	assert.Equal(t, uint64(2), res.Deposits[1].EventNonce)
+	res, err = k.HeldDeposits(c, &types.QueryHeldDepositsRequest{CosmosReceiver: AccAddrs[1].String()})
+	require.NoError(t, err)
+	assert.Empty(t, res.Deposits)
+
+	// only the receiver can reclaim
+	assert.Error(t, k.ReclaimHeldDeposit(ctx, 1, AccAddrs[1], sdk.ZeroInt()))
+	assert.Error(t, k.ReclaimHeldDeposit(ctx, 3, receiver, sdk.ZeroInt()))
+	// the return fee is paid out of the returned tokens
+	cacheCtx, _ := ctx.CacheContext()
+	assert.Error(t, k.ReclaimHeldDeposit(cacheCtx, 1, receiver, sdk.NewInt(100)))
+
+	// a still denied token is returned to the ethereum sender by a transfer nobody can cancel
+	require.NoError(t, k.ReclaimHeldDeposit(ctx, 1, receiver, sdk.NewInt(5)))
+	_, found := k.GetHeldDeposit(ctx, 1)
+	assert.False(t, found)
+	pool := k.GetPoolTransactions(ctx)
+	require.Len(t, pool, 1)
+	assert.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), pool[0].Sender)
+	assert.Equal(t, EthAddrs[0].String(), pool[0].DestAddress)
+	assert.Equal(t, sdk.NewInt(95), pool[0].Erc20Token.Amount)
+	assert.Equal(t, sdk.NewInt(5), pool[0].Erc20Fee.Amount)
+	assert.True(t, input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom).IsZero())
+
+	// once the token is allowed the vouchers are released to the receiver
+	require.NoError(t, k.HandleTokenPolicyProposal(ctx, types.NewTokenPolicyProposal("title", "description", types.TokenPolicy{})))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ReclaimHeldDeposit(ctx, 2, receiver, sdk.ZeroInt()))
+	assert.True(t, hasEvent(ctx, types.EventTypeHeldDepositReclaimed))
+	assert.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount)
+	assert.Len(t, k.GetPoolTransactions(ctx), 1)
+	assert.Error(t, k.ReclaimHeldDeposit(ctx, 2, receiver, sdk.ZeroInt()))
+}
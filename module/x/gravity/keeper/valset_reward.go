@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetValsetRewardPoolAddress returns the address of the module account Ethereum originated valset rewards
+// are paid from, anyone can fund it by sending it the vouchers of the reward token
+func (k Keeper) GetValsetRewardPoolAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ValsetRewardPoolName)
+}
+
+// GetValsetRewardPoolBalance returns the amount of the given denom in the valset reward pool
+func (k Keeper) GetValsetRewardPoolBalance(ctx sdk.Context, denom string) sdk.Int {
+	return k.bankKeeper.GetBalance(ctx, k.GetValsetRewardPoolAddress(), denom).Amount
+}
+
+// GetEscrowedValsetRewards returns the amount of the given denom in the valset reward pool that is escrowed for
+// the Ethereum originated rewards of the valsets that can still be relayed. A valset escrows its reward from the
+// moment it is stored, the escrow is released once a valset with the same or a higher nonce is observed, since
+// the Gravity contract only accepts valsets with a higher nonce than the last one
+func (k Keeper) GetEscrowedValsetRewards(ctx sdk.Context, denom string) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetEscrowedValsetRewardsKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var escrowed sdk.Coin
+	k.cdc.MustUnmarshalBinaryBare(bz, &escrowed)
+	return escrowed.Amount
+}
+
+// setEscrowedValsetRewards sets the total of the escrowed valset rewards of a denom
+func (k Keeper) setEscrowedValsetRewards(ctx sdk.Context, escrowed sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	if escrowed.IsZero() {
+		store.Delete(types.GetEscrowedValsetRewardsKey(escrowed.Denom))
+		return
+	}
+	store.Set(types.GetEscrowedValsetRewardsKey(escrowed.Denom), k.cdc.MustMarshalBinaryBare(&escrowed))
+}
+
+// escrowValsetReward escrows the Ethereum originated reward of a stored valset, the escrow is kept with the
+// valset nonce so that it is released in the denom it was escrowed in
+func (k Keeper) escrowValsetReward(ctx sdk.Context, valset *types.Valset) {
+	if valset.RewardAmount.IsNil() || !valset.RewardAmount.IsPositive() {
+		return
+	}
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, valset.RewardToken)
+	if isCosmosOriginated {
+		return
+	}
+	amount, _ := k.GetTokenScaling(ctx, valset.RewardToken).FromERC20(valset.RewardAmount)
+	if !amount.IsPositive() {
+		return
+	}
+	escrow := sdk.NewCoin(denom, amount)
+	ctx.KVStore(k.storeKey).Set(types.GetValsetRewardEscrowKey(valset.Nonce), k.cdc.MustMarshalBinaryBare(&escrow))
+	k.setEscrowedValsetRewards(ctx, sdk.NewCoin(denom, k.GetEscrowedValsetRewards(ctx, denom).Add(amount)))
+}
+
+// releaseValsetRewardEscrows releases the escrowed rewards of the valsets up to and including the given nonce,
+// which the Gravity contract won't accept any longer
+func (k Keeper) releaseValsetRewardEscrows(ctx sdk.Context, nonce uint64) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRewardEscrowKey)
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(nonce+1))
+	var keys [][]byte
+	var escrows []sdk.Coin
+	for ; iter.Valid(); iter.Next() {
+		var escrow sdk.Coin
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &escrow)
+		keys = append(keys, iter.Key())
+		escrows = append(escrows, escrow)
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		prefixStore.Delete(key)
+		escrowed := sdk.NewCoin(escrows[i].Denom, k.GetEscrowedValsetRewards(ctx, escrows[i].Denom))
+		k.setEscrowedValsetRewards(ctx, escrowed.Sub(escrows[i]))
+	}
+}
+
+// BackfillValsetRewardEscrows escrows the Ethereum originated rewards of the valsets that can still be relayed,
+// which were only counted by walking the valsets before the escrow was kept in the store. It returns the number
+// of valsets that escrow a reward
+func (k Keeper) BackfillValsetRewardEscrows(ctx sdk.Context) (escrowed int) {
+	var lastObservedNonce uint64
+	if lastObserved := k.GetLastObservedValset(ctx); lastObserved != nil {
+		lastObservedNonce = lastObserved.Nonce
+	}
+	var valsets []*types.Valset
+	// valsets are iterated from the highest nonce down
+	k.IterateValsets(ctx, func(_ []byte, valset *types.Valset) bool {
+		if valset.Nonce <= lastObservedNonce {
+			return true
+		}
+		valsets = append(valsets, valset)
+		return false
+	})
+	for _, valset := range valsets {
+		if ctx.KVStore(k.storeKey).Has(types.GetValsetRewardEscrowKey(valset.Nonce)) {
+			continue
+		}
+		k.escrowValsetReward(ctx, valset)
+		if ctx.KVStore(k.storeKey).Has(types.GetValsetRewardEscrowKey(valset.Nonce)) {
+			escrowed++
+		}
+	}
+	return escrowed
+}
+
+// isValsetRewardFunded returns true if the reward can be paid when the valset is relayed. Cosmos originated
+// rewards are minted and always funded, Ethereum originated ones need enough vouchers in the reward pool
+// besides the ones escrowed for the rewards of the valsets that can still be relayed
+func (k Keeper) isValsetRewardFunded(ctx sdk.Context, reward sdk.Coin) bool {
+	isCosmosOriginated, _, err := k.DenomToERC20Lookup(ctx, reward.Denom)
+	if err != nil {
+		// an untraced gravity voucher has no ERC20 to be paid in, so it is paused like an unfunded reward,
+		// any other unknown denom is left to RewardToERC20Lookup to reject
+		return !strings.HasPrefix(reward.Denom, types.GravityDenomPrefix)
+	}
+	if isCosmosOriginated {
+		return true
+	}
+	available := k.GetValsetRewardPoolBalance(ctx, reward.Denom).Sub(k.GetEscrowedValsetRewards(ctx, reward.Denom))
+	return available.GTE(reward.Amount)
+}
+
+// payEthereumOriginatedValsetReward burns the vouchers of a relayed Ethereum originated reward from the
+// reward pool, so that we don't think we have more in the bridge than we actually do. The reward was escrowed
+// when the valset was created, a shortfall is only possible for valsets created before rewards were escrowed,
+// then only what is left is burned and the shortfall is reported with an event
+func (k Keeper) payEthereumOriginatedValsetReward(ctx sdk.Context, denom string, amount sdk.Int) {
+	balance := k.GetValsetRewardPoolBalance(ctx, denom)
+	burn := sdk.MinInt(balance, amount)
+	if burn.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ValsetRewardPoolName, sdk.NewCoins(sdk.NewCoin(denom, burn))); err != nil {
+			panic(err)
+		}
+	}
+	if burn.LT(amount) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeValsetRewardShortfall,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyReward, sdk.NewCoin(denom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyRewardPoolBalance, sdk.NewCoin(denom, balance).String()),
+		))
+	}
+}
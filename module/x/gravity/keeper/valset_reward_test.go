@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestUntracedValsetReward(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	// a gravity voucher without a trace has no ERC20 to be paid in, the reward is paused rather than panicking
+	params := k.GetParams(ctx)
+	params.ValsetReward = sdk.NewInt64Coin(types.GravityDenom(TokenContractAddrs[0]), 100)
+	k.SetParams(ctx, params)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	valset := k.SetValsetRequest(ctx)
+	assert.True(t, valset.RewardAmount.IsZero())
+	assert.True(t, hasEvent(ctx, types.EventTypeValsetRewardPaused))
+}
+
+func TestEthereumOriginatedValsetReward(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	tokenContract := TokenContractAddrs[0]
+	denom := k.RegisterDenomTrace(ctx, tokenContract).Denom
+
+	params := k.GetParams(ctx)
+	params.ValsetReward = sdk.NewInt64Coin(denom, 100)
+	k.SetParams(ctx, params)
+
+	// an empty pool pauses the reward
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	valset := k.SetValsetRequest(ctx)
+	assert.True(t, valset.RewardAmount.IsZero())
+	assert.Equal(t, "0x0000000000000000000000000000000000000000", valset.RewardToken)
+	assert.True(t, hasEvent(ctx, types.EventTypeValsetRewardPaused))
+
+	// funding the pool resumes it
+	funds := sdk.NewCoins(sdk.NewInt64Coin(denom, 150))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ValsetRewardPoolName, funds))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	valset = k.SetValsetRequest(ctx)
+	assert.Equal(t, sdk.NewInt(100), valset.RewardAmount)
+	assert.Equal(t, tokenContract, valset.RewardToken)
+	assert.False(t, hasEvent(ctx, types.EventTypeValsetRewardPaused))
+
+	// the reward of that valset is escrowed, the rest of the pool can't pay another one
+	assert.Equal(t, sdk.NewInt(100), k.GetEscrowedValsetRewards(ctx, denom))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	assert.True(t, k.SetValsetRequest(ctx).RewardAmount.IsZero())
+	assert.True(t, hasEvent(ctx, types.EventTypeValsetRewardPaused))
+	funds = sdk.NewCoins(sdk.NewInt64Coin(denom, 50))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ValsetRewardPoolName, funds))
+	later := k.SetValsetRequest(ctx)
+	assert.Equal(t, sdk.NewInt(100), later.RewardAmount)
+	assert.Equal(t, sdk.NewInt(200), k.GetEscrowedValsetRewards(ctx, denom))
+
+	observe := func(eventNonce uint64, valset *types.Valset) {
+		claim := &types.MsgValsetUpdatedClaim{
+			EventNonce:   eventNonce,
+			ValsetNonce:  valset.Nonce,
+			BlockHeight:  eventNonce,
+			RewardAmount: valset.RewardAmount,
+			RewardToken:  valset.RewardToken,
+		}
+		require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+	}
+	// the relayed reward is burned from the pool, the later valset keeps its escrow
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	observe(1, valset)
+	assert.Equal(t, sdk.NewInt(100), k.GetValsetRewardPoolBalance(ctx, denom))
+	assert.Equal(t, sdk.NewInt(100), k.GetEscrowedValsetRewards(ctx, denom))
+	assert.False(t, hasEvent(ctx, types.EventTypeValsetRewardShortfall))
+
+	// observing a higher nonce releases the escrow of the valsets it superseded
+	observe(2, &types.Valset{Nonce: later.Nonce + 1, RewardAmount: sdk.ZeroInt()})
+	assert.True(t, k.GetEscrowedValsetRewards(ctx, denom).IsZero())
+	assert.Equal(t, sdk.NewInt(100), k.SetValsetRequest(ctx).RewardAmount)
+
+	// a valset created before rewards were escrowed pays what is left instead of halting the chain
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	observe(3, &types.Valset{Nonce: later.Nonce + 10, RewardAmount: sdk.NewInt(150), RewardToken: tokenContract})
+	assert.True(t, k.GetValsetRewardPoolBalance(ctx, denom).IsZero())
+	assert.True(t, hasEvent(ctx, types.EventTypeValsetRewardShortfall))
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package gravity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// NewGravityProposalHandler returns a handler for the governance proposals of the gravity module
+func NewGravityProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ERC20WhitelistProposal:
+			return k.HandleERC20WhitelistProposal(ctx, c)
+		case *types.ERC20RemapProposal:
+			return k.HandleERC20RemapProposal(ctx, c)
+		case *types.TokenPolicyProposal:
+			return k.HandleTokenPolicyProposal(ctx, c)
+		case *types.ERC20MetadataProposal:
+			return k.HandleERC20MetadataProposal(ctx, c)
+		case *types.TokenScalingProposal:
+			return k.HandleTokenScalingProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package gravity
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestERC20DeploymentWhitelist(t *testing.T) {
+	tv := initializeTestingVars(t)
+	setDenomMetadata(tv)
+	k := tv.input.GravityKeeper
+	proposalHandler := NewGravityProposalHandler(k)
+	deployer := "0x3c9289da00b02dC623d0D8D907619890301D26d4"
+	params := k.GetParams(tv.ctx)
+	params.RequireErc20DeploymentApproval = true
+	k.SetParams(tv.ctx, params)
+
+	// claims only keep the deployer once extended claims are enabled, an approval can't expect one before
+	assert.Error(t, proposalHandler(tv.ctx, types.NewERC20WhitelistProposal("approve", "approve atom", []types.ERC20DeploymentApproval{
+		{Denom: tv.denom, Erc20: tv.erc20, Deployer: deployer},
+	})))
+	_, found := k.GetERC20DeploymentApproval(tv.ctx, tv.denom)
+	assert.False(t, found)
+	params.ExtendedClaims = true
+	k.SetParams(tv.ctx, params)
+
+	nonce, decimals := uint64(0), uint64(6)
+	deploy := func(erc20, deployer string) {
+		nonce++
+		tv.ctx = tv.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := tv.h(tv.ctx, &types.MsgERC20DeployedClaim{
+			CosmosDenom:   tv.denom,
+			TokenContract: erc20,
+			Name:          "atom",
+			Symbol:        "atom",
+			Decimals:      decimals,
+			EventNonce:    nonce,
+			Orchestrator:  tv.myOrchestratorAddr.String(),
+			Deployer:      deployer,
+		})
+		require.NoError(t, err)
+		EndBlocker(tv.ctx, k)
+	}
+	rejected := func() bool {
+		for _, event := range tv.ctx.EventManager().Events() {
+			if event.Type == types.EventTypeERC20DeploymentRejected {
+				return true
+			}
+		}
+		return false
+	}
+
+	// nothing is approved yet
+	deploy(tv.erc20, deployer)
+	assert.True(t, rejected())
+	_, exists := k.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	assert.False(t, exists)
+
+	require.NoError(t, proposalHandler(tv.ctx, types.NewERC20WhitelistProposal("approve", "approve atom", []types.ERC20DeploymentApproval{
+		{Denom: tv.denom, Erc20: tv.erc20, Deployer: deployer},
+	})))
+
+	// a look-alike and a deployment by someone else are rejected
+	deploy("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8", deployer)
+	assert.True(t, rejected())
+	deploy(tv.erc20, "")
+	assert.True(t, rejected())
+
+	// so is one with other decimals than the denom until governance approves the scaling between them
+	decimals = 18
+	deploy(tv.erc20, deployer)
+	_, exists = k.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	assert.False(t, exists)
+	require.NoError(t, proposalHandler(tv.ctx, types.NewERC20WhitelistProposal("approve", "scale atom", []types.ERC20DeploymentApproval{
+		{Denom: tv.denom, Erc20: tv.erc20, Deployer: deployer, Exponent: 12},
+	})))
+
+	deploy(tv.erc20, deployer)
+	assert.False(t, rejected())
+	erc20, exists := k.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	require.True(t, exists)
+	assert.Equal(t, types.CanonicalEthAddress(tv.erc20), erc20)
+	assert.Equal(t, int64(12), k.GetTokenScaling(tv.ctx, tv.erc20).Exponent)
+
+	// the denom is moved to the ERC20 its token was migrated to
+	migrated := "0x2a24af0501a534fca004ee1bd667b783f205a546"
+	require.NoError(t, proposalHandler(tv.ctx, types.NewERC20RemapProposal("remap", "migrate atom", tv.denom, migrated, 0)))
+	erc20, _ = k.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	assert.Equal(t, types.CanonicalEthAddress(migrated), erc20)
+	// deposits of the previous ERC20 still unlock the denom
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(tv.ctx, tv.erc20)
+	assert.True(t, isCosmosOriginated)
+	assert.Equal(t, tv.denom, denom)
+	approval, _ := k.GetERC20DeploymentApproval(tv.ctx, tv.denom)
+	assert.Equal(t, migrated, approval.Erc20)
+	// the migrated ERC20 has the decimals of the denom, the previous one keeps its scaling
+	assert.Equal(t, int64(0), k.GetTokenScaling(tv.ctx, migrated).Exponent)
+	assert.Equal(t, int64(0), approval.Exponent)
+	assert.Equal(t, int64(12), k.GetTokenScaling(tv.ctx, tv.erc20).Exponent)
+
+	assert.Error(t, proposalHandler(tv.ctx, types.NewERC20RemapProposal("remap", "unknown denom", "unknown", migrated, 0)))
+	assert.Error(t, proposalHandler(tv.ctx, types.NewERC20RemapProposal("remap", "taken erc20", tv.denom, migrated, 0)))
+}
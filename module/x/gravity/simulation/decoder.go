@@ -0,0 +1,114 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding gravity type.
+func NewDecodeStore(cdc codec.BinaryMarshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		decode := func(a, b codec.ProtoMarshaler) string {
+			cdc.MustUnmarshalBinaryBare(kvA.Value, a)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, b)
+			return fmt.Sprintf("%v\n%v", a, b)
+		}
+
+		switch prefix := kvA.Key[:1]; {
+		case bytes.Equal(prefix, types.ValsetRequestKey), bytes.Equal(prefix, types.LastObservedValsetKey):
+			return decode(&types.Valset{}, &types.Valset{})
+
+		case bytes.Equal(prefix, types.ValsetConfirmKey):
+			return decode(&types.MsgValsetConfirm{}, &types.MsgValsetConfirm{})
+
+		case bytes.Equal(prefix, types.OracleAttestationKey):
+			return decode(&types.Attestation{}, &types.Attestation{})
+
+		case bytes.Equal(prefix, types.OutgoingTXPoolKey):
+			return decode(&types.OutgoingTransferTx{}, &types.OutgoingTransferTx{})
+
+		case bytes.Equal(prefix, types.SecondIndexOutgoingTXFeeKey):
+			return decode(&types.IDSet{}, &types.IDSet{})
+
+		case bytes.Equal(prefix, types.OutgoingTXBatchKey), bytes.Equal(prefix, types.OutgoingTXBatchBlockKey):
+			return decode(&types.OutgoingTxBatch{}, &types.OutgoingTxBatch{})
+
+		case bytes.Equal(prefix, types.BatchConfirmKey):
+			return decode(&types.MsgConfirmBatch{}, &types.MsgConfirmBatch{})
+
+		case bytes.Equal(prefix, types.KeyOutgoingLogicCall):
+			return decode(&types.OutgoingLogicCall{}, &types.OutgoingLogicCall{})
+
+		case bytes.Equal(prefix, types.KeyOutgoingLogicConfirm):
+			return decode(&types.MsgConfirmLogicCall{}, &types.MsgConfirmLogicCall{})
+
+		case bytes.Equal(prefix, types.PastEthSignatureCheckpointInfoKey):
+			return decode(&types.PastEthSignatureCheckpoint{}, &types.PastEthSignatureCheckpoint{})
+
+		case bytes.Equal(prefix, types.ValidatorBridgeSigningInfoKey):
+			return decode(&types.ValidatorBridgeSigningInfo{}, &types.ValidatorBridgeSigningInfo{})
+
+		case bytes.Equal(prefix, types.RelayerStatsKey):
+			return decode(&types.RelayerStats{}, &types.RelayerStats{})
+
+		case bytes.Equal(prefix, types.ERC20DeploymentApprovalKey):
+			return decode(&types.ERC20DeploymentApproval{}, &types.ERC20DeploymentApproval{})
+
+		case bytes.Equal(prefix, types.TokenPolicyKey):
+			return decode(&types.TokenPolicy{}, &types.TokenPolicy{})
+
+		case bytes.Equal(prefix, types.HeldDepositKey):
+			return decode(&types.HeldDeposit{}, &types.HeldDeposit{})
+
+		case bytes.Equal(prefix, types.TokenScalingKey):
+			return decode(&types.TokenScaling{}, &types.TokenScaling{})
+
+		case bytes.Equal(prefix, types.DustKey):
+			return decode(&types.ERC20Token{}, &types.ERC20Token{})
+
+		case bytes.Equal(prefix, types.DenomTraceKey):
+			return decode(&types.DenomTrace{}, &types.DenomTrace{})
+
+		case bytes.Equal(prefix, types.LastObservedEthereumBlockHeightKey):
+			return decode(&types.LastObservedEthereumBlockHeight{}, &types.LastObservedEthereumBlockHeight{})
+
+		case bytes.Equal(prefix, types.ValidatorByEthAddressKey), bytes.Equal(prefix, types.KeyOrchestratorAddress):
+			return fmt.Sprintf("%s\n%s", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
+
+		case bytes.Equal(prefix, types.EthAddressByValidatorKey),
+			bytes.Equal(prefix, types.DenomToERC20Key),
+			bytes.Equal(prefix, types.ERC20ToDenomKey),
+			bytes.Equal(prefix, types.DenomTraceByERC20Key):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(prefix, types.LastEventNonceByValidatorKey),
+			bytes.Equal(prefix, types.LastObservedEventNonceKey),
+			bytes.Equal(prefix, types.SequenceKeyPrefix),
+			bytes.Equal(prefix, types.LastSlashedValsetNonce),
+			bytes.Equal(prefix, types.LatestValsetNonce),
+			bytes.Equal(prefix, types.LastSlashedBatchBlock),
+			bytes.Equal(prefix, types.LastSlashedLogicCallBlock),
+			bytes.Equal(prefix, types.LastUnBondingBlockHeight),
+			bytes.Equal(prefix, types.LastEthAddressChangeHeightKey),
+			bytes.Equal(prefix, types.StoreVersionKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(prefix, types.PastEthSignatureCheckpointKey),
+			bytes.Equal(prefix, types.OracleClaimKey),
+			bytes.Equal(prefix, types.DenomiatorPrefix),
+			bytes.Equal(prefix, types.SecondIndexNonceByClaimKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid gravity key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/simulation"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	valAddr := sdk.ValAddress([]byte("validator"))
+	accAddr := sdk.AccAddress([]byte("orchestrator"))
+	ethAddress := "0x2a24af0501a534fca004ee1bd667b783f205a546"
+	contract := types.MustNewEthAddress(ethAddress)
+	valset := types.Valset{Nonce: 1, Height: 10}
+	batch := types.OutgoingTxBatch{BatchNonce: 2, TokenContract: ethAddress}
+	height := types.LastObservedEthereumBlockHeight{CosmosBlockHeight: 5, EthereumBlockHeight: 6}
+	checkpoint := types.PastEthSignatureCheckpoint{Checkpoint: []byte{0x1}, Type: types.CHECKPOINT_TYPE_BATCH, Nonce: 2, TokenContract: ethAddress}
+	signingInfo := types.ValidatorBridgeSigningInfo{ValidatorAddress: valAddr.String(), StartHeight: 3}
+	relayerStats := types.RelayerStats{Relayer: ethAddress, Batches: 4, LastRelayedHeight: 8}
+	approval := types.ERC20DeploymentApproval{Denom: "stake", Erc20: ethAddress}
+	policy := types.TokenPolicy{Mode: types.TOKEN_POLICY_MODE_DENY_LIST, TokenContracts: []string{ethAddress}}
+	deposit := types.HeldDeposit{Id: 1, TokenContract: ethAddress, Amount: sdk.NewInt(5), CosmosReceiver: accAddr.String()}
+	scaling := types.TokenScaling{TokenContract: ethAddress, Exponent: 12}
+	dust := types.ERC20Token{Contract: ethAddress, Amount: sdk.NewInt(9)}
+	trace := types.NewDenomTrace(ethAddress)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetValsetKey(valset.Nonce), Value: cdc.MustMarshalBinaryBare(&valset)},
+			{Key: types.GetOutgoingTxBatchKey(contract, batch.BatchNonce), Value: cdc.MustMarshalBinaryBare(&batch)},
+			{Key: types.LastObservedEthereumBlockHeightKey, Value: cdc.MustMarshalBinaryBare(&height)},
+			{Key: types.GetPastEthSignatureCheckpointInfoKey(checkpoint.Checkpoint), Value: cdc.MustMarshalBinaryBare(&checkpoint)},
+			{Key: types.GetValidatorBridgeSigningInfoKey(valAddr), Value: cdc.MustMarshalBinaryBare(&signingInfo)},
+			{Key: types.GetRelayerStatsKey(contract), Value: cdc.MustMarshalBinaryBare(&relayerStats)},
+			{Key: types.GetERC20DeploymentApprovalKey(approval.Denom), Value: cdc.MustMarshalBinaryBare(&approval)},
+			{Key: types.TokenPolicyKey, Value: cdc.MustMarshalBinaryBare(&policy)},
+			{Key: types.GetHeldDepositKey(deposit.Id), Value: cdc.MustMarshalBinaryBare(&deposit)},
+			{Key: types.GetTokenScalingKey(contract), Value: cdc.MustMarshalBinaryBare(&scaling)},
+			{Key: types.GetDustKey(contract), Value: cdc.MustMarshalBinaryBare(&dust)},
+			{Key: types.GetDenomTraceKey(trace.Denom), Value: cdc.MustMarshalBinaryBare(&trace)},
+			{Key: types.GetDenomTraceByERC20Key(contract), Value: []byte(trace.Denom)},
+			{Key: types.GetOrchestratorAddressKey(accAddr), Value: valAddr.Bytes()},
+			{Key: types.GetEthAddressByValidatorKey(valAddr), Value: []byte(ethAddress)},
+			{Key: types.LastObservedEventNonceKey, Value: types.UInt64Bytes(7)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Valset", fmt.Sprintf("%v\n%v", &valset, &valset)},
+		{"OutgoingTxBatch", fmt.Sprintf("%v\n%v", &batch, &batch)},
+		{"LastObservedEthereumBlockHeight", fmt.Sprintf("%v\n%v", &height, &height)},
+		{"PastEthSignatureCheckpointInfo", fmt.Sprintf("%v\n%v", &checkpoint, &checkpoint)},
+		{"ValidatorBridgeSigningInfo", fmt.Sprintf("%v\n%v", &signingInfo, &signingInfo)},
+		{"RelayerStats", fmt.Sprintf("%v\n%v", &relayerStats, &relayerStats)},
+		{"ERC20DeploymentApproval", fmt.Sprintf("%v\n%v", &approval, &approval)},
+		{"TokenPolicy", fmt.Sprintf("%v\n%v", &policy, &policy)},
+		{"HeldDeposit", fmt.Sprintf("%v\n%v", &deposit, &deposit)},
+		{"TokenScaling", fmt.Sprintf("%v\n%v", &scaling, &scaling)},
+		{"Dust", fmt.Sprintf("%v\n%v", &dust, &dust)},
+		{"DenomTrace", fmt.Sprintf("%v\n%v", &trace, &trace)},
+		{"DenomTraceByERC20", fmt.Sprintf("%s\n%s", trace.Denom, trace.Denom)},
+		{"OrchestratorAddress", fmt.Sprintf("%s\n%s", valAddr, valAddr)},
+		{"EthAddressByValidator", fmt.Sprintf("%s\n%s", ethAddress, ethAddress)},
+		{"LastObservedEventNonce", "7\n7"},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
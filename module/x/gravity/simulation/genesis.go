@@ -0,0 +1,299 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Simulation parameter constants
+const (
+	SignedValsetsWindow          = "signed_valsets_window"
+	SignedBatchesWindow          = "signed_batches_window"
+	TargetBatchTimeout           = "target_batch_timeout"
+	SlashFractionValset          = "slash_fraction_valset"
+	SlashFractionBatch           = "slash_fraction_batch"
+	UnbondSlashingValsetsWindow  = "unbond_slashing_valsets_window"
+	SlashFractionBadEthSignature = "slash_fraction_bad_eth_signature"
+	AttestationRetentionBlocks   = "attestation_retention_blocks"
+	BridgeSigningWindow          = "bridge_signing_window"
+	GraduatedBridgeSlashing      = "graduated_bridge_slashing"
+	MinSignedPerWindow           = "min_signed_per_window"
+	BridgeJailDuration           = "bridge_jail_duration"
+	SlashFractionBridgeDowntime  = "slash_fraction_bridge_downtime"
+	ValsetMaxAge                 = "valset_max_age"
+	ValsetMinInterval            = "valset_min_interval"
+	ValsetOnEthAddressChange     = "valset_on_eth_address_change"
+	MaxBridgeValidators          = "max_bridge_validators"
+	MinBridgePowerShare          = "min_bridge_power_share"
+	RequireERC20Approval         = "require_erc20_deployment_approval"
+	ExtendedClaims               = "extended_claims"
+)
+
+const (
+	// CosmosTokenContract is the ERC20 representing the bond denom on the simulated Ethereum
+	CosmosTokenContract = "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+
+	// EthereumTokenContract is the Ethereum originated ERC20 deposited by synthetic claims
+	EthereumTokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+)
+
+// GenSignedValsetsWindow randomized SignedValsetsWindow
+func GenSignedValsetsWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 10, 1000))
+}
+
+// GenSignedBatchesWindow randomized SignedBatchesWindow
+func GenSignedBatchesWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 10, 1000))
+}
+
+// GenTargetBatchTimeout randomized TargetBatchTimeout
+func GenTargetBatchTimeout(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 60000, 43200000))
+}
+
+// GenSlashFraction randomized slash fraction
+func GenSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(1000) + 1)))
+}
+
+// GenUnbondSlashingValsetsWindow randomized UnbondSlashingValsetsWindow
+func GenUnbondSlashingValsetsWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 10, 1000))
+}
+
+// GenAttestationRetentionBlocks randomized AttestationRetentionBlocks
+func GenAttestationRetentionBlocks(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenBridgeSigningWindow randomized BridgeSigningWindow
+func GenBridgeSigningWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 200))
+}
+
+// GenGraduatedBridgeSlashing randomized GraduatedBridgeSlashing
+func GenGraduatedBridgeSlashing(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenMinSignedPerWindow randomized MinSignedPerWindow
+func GenMinSignedPerWindow(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(10)), 1)
+}
+
+// GenBridgeJailDuration randomized BridgeJailDuration
+func GenBridgeJailDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 60, 60*60*24)) * time.Second
+}
+
+// GenValsetMaxAge randomized ValsetMaxAge, half the time the max age is disabled
+func GenValsetMaxAge(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 10, 1000))
+}
+
+// GenValsetMinInterval randomized ValsetMinInterval
+func GenValsetMinInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20))
+}
+
+// GenValsetOnEthAddressChange randomized ValsetOnEthAddressChange
+func GenValsetOnEthAddressChange(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenMaxBridgeValidators randomized MaxBridgeValidators, half the time valsets are uncapped
+func GenMaxBridgeValidators(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenMinBridgePowerShare randomized MinBridgePowerShare
+func GenMinBridgePowerShare(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 50, 101)), 2)
+}
+
+// GenRequireERC20DeploymentApproval randomized RequireErc20DeploymentApproval
+func GenRequireERC20DeploymentApproval(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenExtendedClaims randomized ExtendedClaims
+func GenExtendedClaims(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenLogicCalls randomized outgoing logic calls for the orchestrators to sign, other modules
+// create logic calls so there is no simulated message that makes them
+func GenLogicCalls(r *rand.Rand, accs []simtypes.Account) []*types.OutgoingLogicCall {
+	calls := make([]*types.OutgoingLogicCall, r.Intn(4))
+	for i := range calls {
+		logicContract, _ := simtypes.RandomAcc(r, accs)
+		calls[i] = &types.OutgoingLogicCall{
+			Transfers:            []*types.ERC20Token{types.NewERC20Token(uint64(simtypes.RandIntBetween(r, 1, 1000000)), EthereumTokenContract)},
+			Fees:                 []*types.ERC20Token{types.NewERC20Token(uint64(simtypes.RandIntBetween(r, 1, 1000)), EthereumTokenContract)},
+			LogicContractAddress: EthereumAddress(logicContract),
+			Payload:              []byte(simtypes.RandStringOfLength(r, 32)),
+			Timeout:              uint64(simtypes.RandIntBetween(r, 1000, 1000000)),
+			InvalidationId:       []byte(simtypes.RandStringOfLength(r, 32)),
+			InvalidationNonce:    uint64(i + 1),
+		}
+	}
+	return calls
+}
+
+// EthereumKey returns the Ethereum key of a simulated orchestrator, it's the secp256k1
+// key of the simulated account so the account can sign for both chains
+func EthereumKey(acc simtypes.Account) *ecdsa.PrivateKey {
+	key, err := ethcrypto.ToECDSA(acc.PrivKey.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// EthereumAddress returns the Ethereum address of a simulated orchestrator
+func EthereumAddress(acc simtypes.Account) string {
+	return ethcrypto.PubkeyToAddress(EthereumKey(acc).PublicKey).Hex()
+}
+
+// RandomizedGenState generates a random GenesisState for gravity, every genesis validator
+// is its own orchestrator
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+	params.GravityId = "simgravityid"
+	params.BridgeChainId = 1
+	params.AverageBlockTime = 5000
+	params.AverageEthereumBlockTime = 15000
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedValsetsWindow, &params.SignedValsetsWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedValsetsWindow = GenSignedValsetsWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedBatchesWindow, &params.SignedBatchesWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedBatchesWindow = GenSignedBatchesWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBatchTimeout, &params.TargetBatchTimeout, simState.Rand,
+		func(r *rand.Rand) { params.TargetBatchTimeout = GenTargetBatchTimeout(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionValset, &params.SlashFractionValset, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionValset = GenSlashFraction(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionBatch, &params.SlashFractionBatch, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionBatch = GenSlashFraction(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UnbondSlashingValsetsWindow, &params.UnbondSlashingValsetsWindow, simState.Rand,
+		func(r *rand.Rand) { params.UnbondSlashingValsetsWindow = GenUnbondSlashingValsetsWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionBadEthSignature, &params.SlashFractionBadEthSignature, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionBadEthSignature = GenSlashFraction(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AttestationRetentionBlocks, &params.AttestationRetentionBlocks, simState.Rand,
+		func(r *rand.Rand) { params.AttestationRetentionBlocks = GenAttestationRetentionBlocks(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BridgeSigningWindow, &params.BridgeSigningWindow, simState.Rand,
+		func(r *rand.Rand) { params.BridgeSigningWindow = GenBridgeSigningWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GraduatedBridgeSlashing, &params.GraduatedBridgeSlashing, simState.Rand,
+		func(r *rand.Rand) { params.GraduatedBridgeSlashing = GenGraduatedBridgeSlashing(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinSignedPerWindow, &params.MinSignedPerWindow, simState.Rand,
+		func(r *rand.Rand) { params.MinSignedPerWindow = GenMinSignedPerWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BridgeJailDuration, &params.BridgeJailDuration, simState.Rand,
+		func(r *rand.Rand) { params.BridgeJailDuration = GenBridgeJailDuration(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionBridgeDowntime, &params.SlashFractionBridgeDowntime, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionBridgeDowntime = GenSlashFraction(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ValsetMaxAge, &params.ValsetMaxAge, simState.Rand,
+		func(r *rand.Rand) { params.ValsetMaxAge = GenValsetMaxAge(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ValsetMinInterval, &params.ValsetMinInterval, simState.Rand,
+		func(r *rand.Rand) { params.ValsetMinInterval = GenValsetMinInterval(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ValsetOnEthAddressChange, &params.ValsetOnEthAddressChange, simState.Rand,
+		func(r *rand.Rand) { params.ValsetOnEthAddressChange = GenValsetOnEthAddressChange(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxBridgeValidators, &params.MaxBridgeValidators, simState.Rand,
+		func(r *rand.Rand) { params.MaxBridgeValidators = GenMaxBridgeValidators(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinBridgePowerShare, &params.MinBridgePowerShare, simState.Rand,
+		func(r *rand.Rand) { params.MinBridgePowerShare = GenMinBridgePowerShare(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RequireERC20Approval, &params.RequireErc20DeploymentApproval, simState.Rand,
+		func(r *rand.Rand) { params.RequireErc20DeploymentApproval = GenRequireERC20DeploymentApproval(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ExtendedClaims, &params.ExtendedClaims, simState.Rand,
+		func(r *rand.Rand) { params.ExtendedClaims = GenExtendedClaims(r) },
+	)
+	params.SignedLogicCallsWindow = params.SignedBatchesWindow
+	params.SlashFractionLogicCall = params.SlashFractionBatch
+
+	// the staking module bonds the first NumBonded accounts as validators
+	var delegateKeys []*types.MsgSetOrchestratorAddress
+	for _, acc := range simState.Accounts[:simState.NumBonded] {
+		delegateKeys = append(delegateKeys, &types.MsgSetOrchestratorAddress{
+			Validator:    sdk.ValAddress(acc.Address).String(),
+			Orchestrator: acc.Address.String(),
+			EthAddress:   EthereumAddress(acc),
+		})
+	}
+
+	gravityGenesis := types.GenesisState{
+		Params:       params,
+		DelegateKeys: delegateKeys,
+		LogicCalls:   GenLogicCalls(simState.Rand, simState.Accounts),
+		Erc20ToDenoms: []*types.ERC20ToDenom{{
+			Erc20: CosmosTokenContract,
+			Denom: sdk.DefaultBondDenom,
+		}},
+		Erc20DeploymentApprovals: []types.ERC20DeploymentApproval{{
+			Denom: sdk.DefaultBondDenom,
+			Erc20: CosmosTokenContract,
+		}},
+	}
+
+	bz, err := json.MarshalIndent(params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated gravity parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&gravityGenesis)
+}
@@ -0,0 +1,420 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSetOrchestratorAddress = "op_weight_msg_set_orchestrator_address"
+	OpWeightMsgSendToEth              = "op_weight_msg_send_to_eth"
+	OpWeightMsgCancelSendToEth        = "op_weight_msg_cancel_send_to_eth"
+	OpWeightMsgRequestBatch           = "op_weight_msg_request_batch"
+	OpWeightMsgValsetConfirm          = "op_weight_msg_valset_confirm"
+	OpWeightMsgConfirmBatch           = "op_weight_msg_confirm_batch"
+	OpWeightMsgConfirmLogicCall       = "op_weight_msg_confirm_logic_call"
+	OpWeightMsgSendToCosmosClaim      = "op_weight_msg_send_to_cosmos_claim"
+)
+
+// Default simulation operation weights
+const (
+	DefaultWeightMsgSetOrchestratorAddress = 20
+	DefaultWeightMsgSendToEth              = 100
+	DefaultWeightMsgCancelSendToEth        = 30
+	DefaultWeightMsgRequestBatch           = 30
+	DefaultWeightMsgValsetConfirm          = 100
+	DefaultWeightMsgConfirmBatch           = 100
+	DefaultWeightMsgConfirmLogicCall       = 100
+	DefaultWeightMsgSendToCosmosClaim      = 100
+)
+
+// batchGas is the gas limit of simulated transactions, building a full batch touches
+// every transaction in it so it needs more than the default
+const batchGas = 10 * helpers.DefaultGenTxGas
+
+var (
+	typeMsgSetOrchestratorAddress = (&types.MsgSetOrchestratorAddress{}).Type()
+	typeMsgSendToEth              = (&types.MsgSendToEth{}).Type()
+	typeMsgCancelSendToEth        = (&types.MsgCancelSendToEth{}).Type()
+	typeMsgRequestBatch           = (&types.MsgRequestBatch{}).Type()
+	typeMsgValsetConfirm          = (&types.MsgValsetConfirm{}).Type()
+	typeMsgConfirmBatch           = (&types.MsgConfirmBatch{}).Type()
+	typeMsgConfirmLogicCall       = (&types.MsgConfirmLogicCall{}).Type()
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler, ak types.AccountKeeper,
+	bk bankkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) (weight int) {
+		appParams.GetOrGenerate(cdc, key, &weight, nil,
+			func(_ *rand.Rand) { weight = defaultWeight },
+		)
+		return weight
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetOrchestratorAddress, DefaultWeightMsgSetOrchestratorAddress),
+			SimulateMsgSetOrchestratorAddress(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSendToEth, DefaultWeightMsgSendToEth),
+			SimulateMsgSendToEth(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCancelSendToEth, DefaultWeightMsgCancelSendToEth),
+			SimulateMsgCancelSendToEth(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRequestBatch, DefaultWeightMsgRequestBatch),
+			SimulateMsgRequestBatch(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgValsetConfirm, DefaultWeightMsgValsetConfirm),
+			SimulateMsgValsetConfirm(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgConfirmBatch, DefaultWeightMsgConfirmBatch),
+			SimulateMsgConfirmBatch(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgConfirmLogicCall, DefaultWeightMsgConfirmLogicCall),
+			SimulateMsgConfirmLogicCall(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSendToCosmosClaim, DefaultWeightMsgSendToCosmosClaim),
+			SimulateMsgSendToCosmosClaim(ak, k),
+		),
+	}
+}
+
+// SimulateMsgSetOrchestratorAddress generates a MsgSetOrchestratorAddress for a validator that
+// doesn't have delegate keys yet, the validator becomes its own orchestrator
+func SimulateMsgSetOrchestratorAddress(ak types.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var candidates []simtypes.Account
+		for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+			if _, found := k.GetEthAddressByValidator(ctx, validator.GetOperator()); found {
+				continue
+			}
+			acc, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+			if _, taken := k.GetOrchestratorValidator(ctx, acc.Address); found && !taken {
+				candidates = append(candidates, acc)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgSetOrchestratorAddress, "no validator without delegate keys"), nil, nil
+		}
+		simAccount := candidates[r.Intn(len(candidates))]
+
+		msg := types.NewMsgSetOrchestratorAddress(sdk.ValAddress(simAccount.Address), simAccount.Address, EthereumAddress(simAccount))
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, simAccount.Address))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+		return deliver(app, ctx, ak, msg, fees, simAccount, chainID)
+	}
+}
+
+// SimulateMsgSendToEth generates a MsgSendToEth of a random amount of a bridged coin
+func SimulateMsgSendToEth(ak types.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		var bridged sdk.Coins
+		for _, coin := range spendable {
+			if _, _, err := k.DenomToERC20Lookup(ctx, coin.Denom); err == nil && coin.Amount.GT(sdk.NewInt(1)) {
+				bridged = append(bridged, coin)
+			}
+		}
+		if len(bridged) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgSendToEth, "no bridged coins"), nil, nil
+		}
+		coin := bridged[r.Intn(len(bridged))]
+
+		// leave at least half of the balance to pay fees
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount.QuoRaw(2))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgSendToEth, "unable to generate amount"), nil, err
+		}
+		fee := sdk.ZeroInt()
+		if remaining := coin.Amount.QuoRaw(2).Sub(amount); remaining.IsPositive() {
+			fee = simtypes.RandomAmount(r, remaining)
+		}
+		ethDest, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgSendToEth(simAccount.Address, EthereumAddress(ethDest), sdk.NewCoin(coin.Denom, amount), sdk.NewCoin(coin.Denom, fee))
+		fees, err := simtypes.RandomFees(r, ctx, spendable.Sub(sdk.NewCoins(msg.Amount.Add(msg.BridgeFee))))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+		return deliver(app, ctx, ak, msg, fees, simAccount, chainID)
+	}
+}
+
+// SimulateMsgCancelSendToEth generates a MsgCancelSendToEth for a random unbatched transfer
+func SimulateMsgCancelSendToEth(ak types.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		unbatched := k.GetPoolTransactions(ctx)
+		if len(unbatched) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgCancelSendToEth, "no unbatched transfers"), nil, nil
+		}
+		tx := unbatched[r.Intn(len(unbatched))]
+		sender, _ := sdk.AccAddressFromBech32(tx.Sender)
+		simAccount, found := simtypes.FindAccount(accs, sender)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgCancelSendToEth, "unable to find sender"), nil, nil
+		}
+
+		msg := types.NewMsgCancelSendToEth(sender, tx.Id)
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, simAccount.Address))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+		return deliver(app, ctx, ak, msg, fees, simAccount, chainID)
+	}
+}
+
+// SimulateMsgRequestBatch generates a MsgRequestBatch for a token with unbatched transfers whose
+// batch would be more profitable than the last one
+func SimulateMsgRequestBatch(ak types.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var contracts []string
+		for _, fees := range k.GetAllBatchFees(ctx, keeper.OutgoingTxBatchSize) {
+			last := k.GetLastOutgoingBatchByTokenType(ctx, fees.Token)
+			if last == nil || !last.GetFees().GT(fees.TotalFees) {
+				contracts = append(contracts, fees.Token)
+			}
+		}
+		if len(contracts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgRequestBatch, "no batch to request"), nil, nil
+		}
+		_, denom := k.ERC20ToDenomLookup(ctx, contracts[r.Intn(len(contracts))])
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgRequestBatch{Sender: simAccount.Address.String(), Denom: denom}
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, simAccount.Address))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+		return deliver(app, ctx, ak, msg, fees, simAccount, chainID)
+	}
+}
+
+// SimulateMsgValsetConfirm generates a MsgValsetConfirm for a valset a simulated orchestrator
+// hasn't signed yet
+func SimulateMsgValsetConfirm(ak types.AccountKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgValsetConfirm, "no simulated orchestrator"), nil, nil
+		}
+
+		var unsigned []*types.Valset
+		for _, valset := range k.GetValsets(ctx) {
+			if k.GetValsetConfirm(ctx, valset.Nonce, orchestrator.Address) == nil {
+				unsigned = append(unsigned, valset)
+			}
+		}
+		if len(unsigned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgValsetConfirm, "no unsigned valset"), nil, nil
+		}
+		valset := unsigned[r.Intn(len(unsigned))]
+
+		signature, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), EthereumKey(orchestrator))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgValsetConfirm, "unable to sign valset"), nil, err
+		}
+		msg := types.NewMsgValsetConfirm(valset.Nonce, EthereumAddress(orchestrator), orchestrator.Address, hex.EncodeToString(signature))
+		return deliver(app, ctx, ak, msg, nil, orchestrator, chainID)
+	}
+}
+
+// SimulateMsgConfirmBatch generates a MsgConfirmBatch for a batch a simulated orchestrator
+// hasn't signed yet
+func SimulateMsgConfirmBatch(ak types.AccountKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgConfirmBatch, "no simulated orchestrator"), nil, nil
+		}
+
+		var unsigned []*types.OutgoingTxBatch
+		for _, batch := range k.GetOutgoingTxBatches(ctx) {
+			if k.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, orchestrator.Address) == nil {
+				unsigned = append(unsigned, batch)
+			}
+		}
+		if len(unsigned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgConfirmBatch, "no unsigned batch"), nil, nil
+		}
+		batch := unsigned[r.Intn(len(unsigned))]
+
+		signature, err := types.NewEthereumSignature(batch.GetCheckpoint(k.GetGravityID(ctx)), EthereumKey(orchestrator))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgConfirmBatch, "unable to sign batch"), nil, err
+		}
+		msg := &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: batch.TokenContract,
+			EthSigner:     EthereumAddress(orchestrator),
+			Orchestrator:  orchestrator.Address.String(),
+			Signature:     hex.EncodeToString(signature),
+		}
+		return deliver(app, ctx, ak, msg, nil, orchestrator, chainID)
+	}
+}
+
+// SimulateMsgConfirmLogicCall generates a MsgConfirmLogicCall for a logic call a simulated orchestrator
+// hasn't signed yet
+func SimulateMsgConfirmLogicCall(ak types.AccountKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgConfirmLogicCall, "no simulated orchestrator"), nil, nil
+		}
+
+		var unsigned []*types.OutgoingLogicCall
+		for _, call := range k.GetOutgoingLogicCalls(ctx) {
+			if k.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, orchestrator.Address) == nil {
+				unsigned = append(unsigned, call)
+			}
+		}
+		if len(unsigned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgConfirmLogicCall, "no unsigned logic call"), nil, nil
+		}
+		call := unsigned[r.Intn(len(unsigned))]
+
+		signature, err := types.NewEthereumSignature(call.GetCheckpoint(k.GetGravityID(ctx)), EthereumKey(orchestrator))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsgConfirmLogicCall, "unable to sign logic call"), nil, err
+		}
+		msg := &types.MsgConfirmLogicCall{
+			InvalidationId:    hex.EncodeToString(call.InvalidationId),
+			InvalidationNonce: call.InvalidationNonce,
+			EthSigner:         EthereumAddress(orchestrator),
+			Orchestrator:      orchestrator.Address.String(),
+			Signature:         hex.EncodeToString(signature),
+		}
+		return deliver(app, ctx, ak, msg, nil, orchestrator, chainID)
+	}
+}
+
+// SimulateMsgSendToCosmosClaim generates a claim for the next event a simulated orchestrator
+// hasn't claimed yet, see syntheticDeposit
+func SimulateMsgSendToCosmosClaim(ak types.AccountKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSendToCosmosClaim, "no simulated orchestrator"), nil, nil
+		}
+		validator, _ := k.GetOrchestratorValidator(ctx, orchestrator.Address)
+
+		// like an orchestrator, skip the events that were observed and pruned while the validator lagged behind
+		eventNonce := k.GetLastEventNonceByValidator(ctx, validator.GetOperator()) + 1
+		if k.IsPrunedEventNonce(ctx, eventNonce) {
+			eventNonce = k.GetLastObservedEventNonce(ctx)
+		}
+		msg := syntheticDeposit(eventNonce, accs)
+		msg.Orchestrator = orchestrator.Address.String()
+		return deliver(app, ctx, ak, msg, nil, orchestrator, chainID)
+	}
+}
+
+// syntheticDeposit returns the deposit of the simulated Ethereum chain at eventNonce, it only
+// depends on the nonce so every orchestrator claims the same event and it can be observed
+func syntheticDeposit(eventNonce uint64, accs []simtypes.Account) *types.MsgSendToCosmosClaim {
+	r := rand.New(rand.NewSource(int64(eventNonce)))
+	sender, _ := simtypes.RandomAcc(r, accs)
+	receiver, _ := simtypes.RandomAcc(r, accs)
+	return &types.MsgSendToCosmosClaim{
+		EventNonce:     eventNonce,
+		BlockHeight:    eventNonce,
+		TokenContract:  EthereumTokenContract,
+		Amount:         sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000000))),
+		EthereumSender: EthereumAddress(sender),
+		CosmosReceiver: receiver.Address.String(),
+	}
+}
+
+// randomOrchestrator returns the account of a random bonded validator that is its own orchestrator
+// with the Ethereum key of a simulated account, only these can sign confirms
+func randomOrchestrator(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	var orchestrators []simtypes.Account
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		acc, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			continue
+		}
+		orchestratorOf, found := k.GetOrchestratorValidator(ctx, acc.Address)
+		if !found || !orchestratorOf.GetOperator().Equals(validator.GetOperator()) {
+			continue
+		}
+		if ethAddress, found := k.GetEthAddressByValidator(ctx, validator.GetOperator()); found && ethAddress.GetAddress() == EthereumAddress(acc) {
+			orchestrators = append(orchestrators, acc)
+		}
+	}
+	if len(orchestrators) == 0 {
+		return simtypes.Account{}, false
+	}
+	return orchestrators[r.Intn(len(orchestrators))], true
+}
+
+// deliver signs msg with simAccount and delivers it, orchestrator messages need no fees
+func deliver(
+	app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, msg sdk.Msg, fees sdk.Coins,
+	simAccount simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		batchGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
@@ -0,0 +1,70 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamsStoreKeySignedValsetsWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSignedValsetsWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamsStoreKeySignedBatchesWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSignedBatchesWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamsStoreSlashFractionValset),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSlashFraction(r))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreAttestationRetentionBlocks),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenAttestationRetentionBlocks(r))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreBridgeSigningWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBridgeSigningWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreGraduatedBridgeSlashing),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", GenGraduatedBridgeSlashing(r))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreMinSignedPerWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMinSignedPerWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreValsetMinInterval),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenValsetMinInterval(r))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreRequireERC20DeploymentApproval),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", GenRequireERC20DeploymentApproval(r))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreExtendedClaims),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", GenExtendedClaims(r))
+			},
+		),
+	}
+}
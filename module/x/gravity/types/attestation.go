@@ -0,0 +1,70 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &Attestation{}
+	_ codectypes.UnpackInterfacesMessage = &QueryAttestationsResponse{}
+)
+
+// NewAttestationTally returns an empty tally for an attestation judged against
+// the provided total power, the required power is AttestationVotesPowerThreshold
+// percent of the total
+func NewAttestationTally(totalPower sdk.Int) AttestationTally {
+	return AttestationTally{
+		VotesPower:    sdk.ZeroInt(),
+		TotalPower:    totalPower,
+		RequiredPower: AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100)),
+	}
+}
+
+// AddVote records a vote by the given validator with the power it held when the vote was cast
+func (a *Attestation) AddVote(validator sdk.ValAddress, power int64) {
+	a.Votes = append(a.Votes, validator.String())
+	a.VotePowers = append(a.VotePowers, power)
+	a.Tally.VotesPower = a.Tally.VotesPower.AddRaw(power)
+}
+
+// CapTotalPower lowers the total power the attestation is judged against to the given total power if
+// that is lower than the snapshot, returning true if it did. The bonded power can drop far enough after
+// the first vote that the snapshot threshold can't be reached by the validators that are left
+func (a *Attestation) CapTotalPower(totalPower sdk.Int) bool {
+	if !a.Tally.TotalPower.GT(totalPower) {
+		return false
+	}
+	votesPower := a.Tally.VotesPower
+	a.Tally = NewAttestationTally(totalPower)
+	a.Tally.VotesPower = votesPower
+	return true
+}
+
+// HasTally returns false for attestations stored before votes were snapshotted,
+// these have no total power to be judged against
+func (a Attestation) HasTally() bool {
+	return !a.Tally.TotalPower.IsNil() && len(a.VotePowers) == len(a.Votes)
+}
+
+// PassedThreshold returns true if the power that has voted for this attestation
+// is greater than or equal to the required power
+func (a Attestation) PassedThreshold() bool {
+	return a.Tally.VotesPower.GTE(a.Tally.RequiredPower)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a Attestation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var claim EthereumClaim
+	return unpacker.UnpackAny(a.Claim, &claim)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryAttestationsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, att := range m.Attestations {
+		if err := att.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
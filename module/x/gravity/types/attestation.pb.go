@@ -77,11 +77,24 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 // the key in which the attestation is stored is keyed on the exact details of the claim
 // but there is no reason to store those exact details becuause the next message sender
 // will kindly provide you with them.
+//
+// VOTE_POWERS:
+// The consensus power of each validator in votes, in the same order, as it was
+// in the block the vote was cast. Votes are tallied against these snapshots
+// so that power changes after a vote can't flip the outcome
+// TALLY:
+// The running tally of this attestation, see AttestationTally
+// OBSERVED_HEIGHT:
+// The Cosmos block height at which this attestation was observed, attestations
+// are retained for AttestationRetentionBlocks after this height
 type Attestation struct {
-	Observed bool       `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
-	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
-	Height   uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Claim    *types.Any `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	Observed       bool             `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
+	Votes          []string         `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	Height         uint64           `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Claim          *types.Any       `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	VotePowers     []int64          `protobuf:"varint,5,rep,packed,name=vote_powers,json=votePowers,proto3" json:"vote_powers,omitempty"`
+	Tally          AttestationTally `protobuf:"bytes,6,opt,name=tally,proto3" json:"tally"`
+	ObservedHeight uint64           `protobuf:"varint,7,opt,name=observed_height,json=observedHeight,proto3" json:"observed_height,omitempty"`
 }
 
 func (m *Attestation) Reset()         { *m = Attestation{} }
@@ -145,6 +158,75 @@ func (m *Attestation) GetClaim() *types.Any {
 	return nil
 }
 
+func (m *Attestation) GetVotePowers() []int64 {
+	if m != nil {
+		return m.VotePowers
+	}
+	return nil
+}
+
+func (m *Attestation) GetTally() AttestationTally {
+	if m != nil {
+		return m.Tally
+	}
+	return AttestationTally{}
+}
+
+func (m *Attestation) GetObservedHeight() uint64 {
+	if m != nil {
+		return m.ObservedHeight
+	}
+	return 0
+}
+
+// AttestationTally records why an attestation is or isn't observed.
+// TOTAL_POWER:
+// The total bonded power when the first vote was cast, fixed for the
+// lifetime of the attestation
+// REQUIRED_POWER:
+// The power that must vote for the attestation to be observed, derived
+// from total_power and AttestationVotesPowerThreshold
+// VOTES_POWER:
+// The sum of vote_powers
+type AttestationTally struct {
+	VotesPower    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=votes_power,json=votesPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"votes_power"`
+	TotalPower    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_power,json=totalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_power"`
+	RequiredPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=required_power,json=requiredPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"required_power"`
+}
+
+func (m *AttestationTally) Reset()         { *m = AttestationTally{} }
+func (m *AttestationTally) String() string { return proto.CompactTextString(m) }
+func (*AttestationTally) ProtoMessage()    {}
+func (*AttestationTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{1}
+}
+func (m *AttestationTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationTally.Merge(m, src)
+}
+func (m *AttestationTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationTally proto.InternalMessageInfo
+
 // ERC20Token unique identifier for an Ethereum ERC20 token.
 // CONTRACT:
 // The contract address on ETH of the token, this could be a Cosmos
@@ -159,7 +241,7 @@ func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
 func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20Token) ProtoMessage()    {}
 func (*ERC20Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{1}
+	return fileDescriptor_e3205613bbab7525, []int{2}
 }
 func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,43 +280,52 @@ func (m *ERC20Token) GetContract() string {
 func init() {
 	proto.RegisterEnum("gravity.v1.ClaimType", ClaimType_name, ClaimType_value)
 	proto.RegisterType((*Attestation)(nil), "gravity.v1.Attestation")
+	proto.RegisterType((*AttestationTally)(nil), "gravity.v1.AttestationTally")
 	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
 }
 
 func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
 
 var fileDescriptor_e3205613bbab7525 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6f, 0x9b, 0x30,
-	0x18, 0xc6, 0x71, 0xfe, 0xa9, 0x71, 0x2f, 0x91, 0x15, 0x55, 0x69, 0xd4, 0xd1, 0x28, 0x87, 0x29,
-	0xaa, 0x14, 0xbc, 0x76, 0x9f, 0x80, 0x80, 0xbb, 0x46, 0xa2, 0x25, 0x02, 0x32, 0xad, 0xd3, 0x24,
-	0x04, 0xc4, 0x23, 0xa8, 0x01, 0x47, 0xe0, 0xa0, 0x71, 0xde, 0x65, 0xc7, 0x7d, 0x87, 0x7d, 0x99,
-	0x1e, 0x73, 0x9c, 0x76, 0xa8, 0xa6, 0xe4, 0x8b, 0x4c, 0x01, 0x92, 0x45, 0x3d, 0xc1, 0xe3, 0xdf,
-	0xeb, 0xd7, 0x8f, 0x1f, 0xbf, 0xf0, 0xc2, 0x8f, 0x9d, 0x34, 0xe0, 0x19, 0x4e, 0xaf, 0xb1, 0xc3,
-	0x39, 0x4d, 0xb8, 0xc3, 0x03, 0x16, 0x49, 0xcb, 0x98, 0x71, 0x86, 0x60, 0x49, 0xa5, 0xf4, 0xba,
-	0xdb, 0xf6, 0x99, 0xcf, 0xf2, 0x65, 0xbc, 0xfb, 0x2b, 0x2a, 0xba, 0xe7, 0x3e, 0x63, 0xfe, 0x82,
-	0xe2, 0x5c, 0xb9, 0xab, 0xaf, 0xd8, 0x89, 0xb2, 0x02, 0xf5, 0xbf, 0x03, 0x78, 0x2a, 0xff, 0x6f,
-	0x89, 0xba, 0xf0, 0x84, 0xb9, 0x09, 0x8d, 0x53, 0x3a, 0xeb, 0x80, 0x1e, 0x18, 0x9c, 0x18, 0x07,
-	0x8d, 0xda, 0xb0, 0x9e, 0x32, 0x4e, 0x93, 0x4e, 0xa5, 0x57, 0x1d, 0x34, 0x8d, 0x42, 0xa0, 0x33,
-	0xd8, 0x98, 0xd3, 0xc0, 0x9f, 0xf3, 0x4e, 0xb5, 0x07, 0x06, 0x35, 0xa3, 0x54, 0xe8, 0x0a, 0xd6,
-	0xbd, 0x85, 0x13, 0x84, 0x9d, 0x5a, 0x0f, 0x0c, 0x4e, 0x6f, 0xda, 0x52, 0x61, 0x42, 0xda, 0x9b,
-	0x90, 0xe4, 0x28, 0x33, 0x8a, 0x92, 0xfe, 0x12, 0x42, 0x62, 0x28, 0x37, 0xef, 0x2c, 0xf6, 0x44,
-	0x73, 0x0f, 0x1e, 0x8b, 0x78, 0xec, 0x78, 0x3c, 0xf7, 0xd0, 0x34, 0x0e, 0x1a, 0xdd, 0xc2, 0x86,
-	0x13, 0xb2, 0x55, 0xc4, 0x3b, 0x95, 0x1d, 0x19, 0x49, 0xcf, 0x2f, 0x97, 0xc2, 0x9f, 0x97, 0xcb,
-	0xb7, 0x7e, 0xc0, 0xe7, 0x2b, 0x57, 0xf2, 0x58, 0x88, 0x3d, 0x96, 0x84, 0x2c, 0x29, 0x3f, 0xc3,
-	0x64, 0xf6, 0x84, 0x79, 0xb6, 0xa4, 0x89, 0x34, 0x8e, 0xb8, 0x51, 0xee, 0xbe, 0x5a, 0x03, 0xd8,
-	0x54, 0x76, 0x67, 0x5b, 0xd9, 0x92, 0xa2, 0x2e, 0x3c, 0x53, 0x34, 0x79, 0x7c, 0x6f, 0x5b, 0x8f,
-	0x13, 0x62, 0x4f, 0x1f, 0xcc, 0x09, 0x51, 0xc6, 0xb7, 0x63, 0xa2, 0xb6, 0x04, 0xf4, 0x06, 0x9e,
-	0x1f, 0x31, 0x93, 0x3c, 0xa8, 0xb6, 0xa5, 0xdb, 0x8a, 0x6e, 0xde, 0xeb, 0x66, 0x0b, 0xa0, 0x1e,
-	0xbc, 0x38, 0xc2, 0x23, 0xd9, 0x52, 0xee, 0x0e, 0x45, 0xc4, 0xba, 0x6b, 0x55, 0x5e, 0x35, 0xc8,
-	0xef, 0x69, 0xab, 0x64, 0xa2, 0xe9, 0x8f, 0x44, 0x6d, 0x55, 0x51, 0x1f, 0x8a, 0x47, 0x58, 0xd3,
-	0x3f, 0x8c, 0x15, 0x5b, 0x91, 0x35, 0xcd, 0x26, 0x9f, 0x88, 0x32, 0xb5, 0x88, 0xda, 0xaa, 0xbd,
-	0x6a, 0xf1, 0x51, 0xd6, 0x4c, 0x62, 0xd9, 0xd3, 0x89, 0x2a, 0xef, 0x70, 0xbd, 0x5b, 0xfb, 0xf1,
-	0x4b, 0x14, 0x46, 0x5f, 0x9e, 0x37, 0x22, 0x58, 0x6f, 0x44, 0xf0, 0x77, 0x23, 0x82, 0x9f, 0x5b,
-	0x51, 0x58, 0x6f, 0x45, 0xe1, 0xf7, 0x56, 0x14, 0x3e, 0x8f, 0x8e, 0xc2, 0x71, 0x16, 0x7c, 0x4e,
-	0x9d, 0x61, 0x44, 0xf9, 0x3e, 0xa0, 0x72, 0x7c, 0x86, 0x6e, 0x1c, 0xcc, 0x7c, 0x8a, 0x43, 0x36,
-	0x5b, 0x2d, 0x28, 0xfe, 0x86, 0xf7, 0x43, 0x97, 0x87, 0xe7, 0x36, 0xf2, 0x77, 0x7b, 0xff, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x0f, 0x0b, 0x25, 0x26, 0x8c, 0x02, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6a, 0xdb, 0x4c,
+	0x14, 0x85, 0x2d, 0xcb, 0xf6, 0x9f, 0x4c, 0xf8, 0x53, 0x31, 0x84, 0xa0, 0x98, 0x54, 0x11, 0x5e,
+	0xb4, 0x26, 0x10, 0xa9, 0x49, 0x37, 0xdd, 0xca, 0xd2, 0xa4, 0x31, 0x28, 0x91, 0x91, 0xe5, 0xd2,
+	0x94, 0x82, 0x90, 0xed, 0xa9, 0x2c, 0x22, 0x6b, 0x5c, 0x69, 0xec, 0x56, 0x4f, 0xd0, 0x42, 0x37,
+	0x7d, 0x87, 0xbe, 0x4c, 0x96, 0x59, 0x96, 0x2e, 0x42, 0x49, 0x5e, 0xa4, 0x68, 0x46, 0x4e, 0x4c,
+	0x96, 0x5e, 0xd9, 0xf7, 0x9e, 0x3b, 0x1f, 0xe7, 0x5c, 0xae, 0xc0, 0x7e, 0x98, 0x06, 0x8b, 0x88,
+	0xe6, 0xfa, 0xe2, 0x58, 0x0f, 0x28, 0xc5, 0x19, 0x0d, 0x68, 0x44, 0x12, 0x6d, 0x96, 0x12, 0x4a,
+	0x20, 0x28, 0x55, 0x6d, 0x71, 0xdc, 0xdc, 0x09, 0x49, 0x48, 0x58, 0x5b, 0x2f, 0xfe, 0xf1, 0x89,
+	0xe6, 0x5e, 0x48, 0x48, 0x18, 0x63, 0x9d, 0x55, 0xc3, 0xf9, 0x27, 0x3d, 0x48, 0x72, 0x2e, 0xb5,
+	0xbe, 0x55, 0xc1, 0x96, 0xf1, 0x88, 0x84, 0x4d, 0xb0, 0x41, 0x86, 0x19, 0x4e, 0x17, 0x78, 0x2c,
+	0x0b, 0xaa, 0xd0, 0xde, 0x70, 0x1f, 0x6a, 0xb8, 0x03, 0xea, 0x0b, 0x42, 0x71, 0x26, 0x57, 0x55,
+	0xb1, 0xbd, 0xe9, 0xf2, 0x02, 0xee, 0x82, 0xc6, 0x04, 0x47, 0xe1, 0x84, 0xca, 0xa2, 0x2a, 0xb4,
+	0x6b, 0x6e, 0x59, 0xc1, 0x43, 0x50, 0x1f, 0xc5, 0x41, 0x34, 0x95, 0x6b, 0xaa, 0xd0, 0xde, 0x3a,
+	0xd9, 0xd1, 0xb8, 0x09, 0x6d, 0x69, 0x42, 0x33, 0x92, 0xdc, 0xe5, 0x23, 0xf0, 0x00, 0x6c, 0x15,
+	0x30, 0x7f, 0x46, 0xbe, 0xe0, 0x34, 0x93, 0xeb, 0xaa, 0xd8, 0x16, 0x5d, 0x50, 0xb4, 0x7a, 0xac,
+	0x03, 0xdf, 0x80, 0x3a, 0x0d, 0xe2, 0x38, 0x97, 0x1b, 0x0c, 0xb6, 0xaf, 0x3d, 0x66, 0xd6, 0x56,
+	0xec, 0x7b, 0xc5, 0x4c, 0xa7, 0x76, 0x7d, 0x7b, 0x50, 0x71, 0xf9, 0x03, 0xf8, 0x12, 0x3c, 0x5b,
+	0x06, 0xf0, 0x4b, 0x9f, 0xff, 0x31, 0x9f, 0xdb, 0xcb, 0xf6, 0x19, 0xeb, 0xb6, 0x7e, 0x54, 0x81,
+	0xf4, 0x14, 0x05, 0x1d, 0x6e, 0x2c, 0xe3, 0xce, 0xd8, 0x46, 0x36, 0x3b, 0x5a, 0xc1, 0xff, 0x73,
+	0x7b, 0xf0, 0x22, 0x8c, 0xe8, 0x64, 0x3e, 0xd4, 0x46, 0x64, 0xaa, 0x8f, 0x48, 0x36, 0x25, 0x59,
+	0xf9, 0x73, 0x94, 0x8d, 0xaf, 0x74, 0x9a, 0xcf, 0x70, 0xa6, 0x75, 0x13, 0xca, 0x83, 0x64, 0x2c,
+	0x49, 0x01, 0xa4, 0x84, 0x06, 0x71, 0x09, 0xac, 0xae, 0x07, 0x64, 0x08, 0x0e, 0x1c, 0x80, 0xed,
+	0x14, 0x7f, 0x9e, 0x47, 0x29, 0x1e, 0x97, 0x4c, 0x71, 0x2d, 0xe6, 0xff, 0x4b, 0x0a, 0xc3, 0xb6,
+	0x66, 0x00, 0x20, 0xd7, 0x3c, 0x79, 0xe5, 0x91, 0x2b, 0xcc, 0xae, 0x62, 0x44, 0x12, 0x9a, 0x06,
+	0x23, 0xca, 0x77, 0xe0, 0x3e, 0xd4, 0xf0, 0x14, 0x34, 0x82, 0x29, 0x99, 0x27, 0x74, 0xcd, 0x30,
+	0xe5, 0xeb, 0xc3, 0x1b, 0x01, 0x6c, 0x9a, 0xc5, 0x35, 0x78, 0xf9, 0x0c, 0xc3, 0x26, 0xd8, 0x35,
+	0x6d, 0xa3, 0x7b, 0xee, 0x7b, 0x97, 0x3d, 0xe4, 0x0f, 0x2e, 0xfa, 0x3d, 0x64, 0x76, 0x4f, 0xbb,
+	0xc8, 0x92, 0x2a, 0xf0, 0x39, 0xd8, 0x5b, 0xd1, 0xfa, 0xe8, 0xc2, 0xf2, 0x3d, 0xc7, 0x37, 0x9d,
+	0xfe, 0xb9, 0xd3, 0x97, 0x04, 0xa8, 0x82, 0xfd, 0x15, 0xb9, 0x63, 0x78, 0xe6, 0xd9, 0xc3, 0x10,
+	0xf2, 0xce, 0xa4, 0xea, 0x13, 0x00, 0xcb, 0xe9, 0x5b, 0xa8, 0x67, 0x3b, 0x97, 0xc8, 0x92, 0x44,
+	0xd8, 0x02, 0xca, 0x8a, 0x6c, 0x3b, 0x6f, 0xbb, 0xa6, 0x6f, 0x1a, 0xb6, 0xed, 0xa3, 0xf7, 0xc8,
+	0x1c, 0x78, 0xc8, 0x92, 0x6a, 0x4f, 0x10, 0xef, 0x0c, 0xbb, 0x8f, 0x3c, 0x7f, 0xd0, 0xb3, 0x8c,
+	0x42, 0xae, 0x37, 0x6b, 0xdf, 0x7f, 0x29, 0x95, 0xce, 0xc7, 0xeb, 0x3b, 0x45, 0xb8, 0xb9, 0x53,
+	0x84, 0xbf, 0x77, 0x8a, 0xf0, 0xf3, 0x5e, 0xa9, 0xdc, 0xdc, 0x2b, 0x95, 0xdf, 0xf7, 0x4a, 0xe5,
+	0x43, 0x67, 0x65, 0x39, 0x41, 0x4c, 0x27, 0x38, 0x38, 0x4a, 0x30, 0x5d, 0x2e, 0xa8, 0x3c, 0xee,
+	0xa3, 0x61, 0x1a, 0x8d, 0x43, 0xac, 0x4f, 0xc9, 0x78, 0x1e, 0x63, 0xfd, 0xab, 0x5e, 0xf6, 0xf9,
+	0xf2, 0x86, 0x0d, 0xf6, 0x25, 0xbd, 0xfe, 0x37, 0x00, 0x94, 0x69, 0x0b, 0x8f, 0x1e, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Attestation) Marshal() (dAtA []byte, err error) {
@@ -257,6 +348,40 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ObservedHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.ObservedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.VotePowers) > 0 {
+		dAtA3 := make([]byte, len(m.VotePowers)*10)
+		var j2 int
+		for _, num1 := range m.VotePowers {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintAttestation(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Claim != nil {
 		{
 			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
@@ -296,6 +421,59 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RequiredPower.Size()
+		i -= size
+		if _, err := m.RequiredPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.VotesPower.Size()
+		i -= size
+		if _, err := m.VotesPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -369,6 +547,33 @@ func (m *Attestation) Size() (n int) {
 		l = m.Claim.Size()
 		n += 1 + l + sovAttestation(uint64(l))
 	}
+	if len(m.VotePowers) > 0 {
+		l = 0
+		for _, e := range m.VotePowers {
+			l += sovAttestation(uint64(e))
+		}
+		n += 1 + sovAttestation(uint64(l)) + l
+	}
+	l = m.Tally.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	if m.ObservedHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.ObservedHeight))
+	}
+	return n
+}
+
+func (m *AttestationTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotesPower.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	l = m.TotalPower.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	l = m.RequiredPower.Size()
+	n += 1 + l + sovAttestation(uint64(l))
 	return n
 }
 
@@ -529,6 +734,289 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttestation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VotePowers = append(m.VotePowers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttestation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAttestation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAttestation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VotePowers) == 0 {
+					m.VotePowers = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAttestation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VotePowers = append(m.VotePowers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePowers", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedHeight", wireType)
+			}
+			m.ObservedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotesPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequiredPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
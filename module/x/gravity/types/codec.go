@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // ModuleCdc is the codec for the module
@@ -29,7 +30,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgLogicCallExecutedClaim{},
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
+		&MsgReclaimHeldDeposit{},
 		&MsgSubmitBadSignatureEvidence{},
+		&MsgSubmitClaims{},
+		&MsgSubmitConfirms{},
 	)
 
 	registry.RegisterInterface(
@@ -42,6 +46,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgValsetUpdatedClaim{},
 	)
 
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&ERC20WhitelistProposal{},
+		&ERC20RemapProposal{},
+		&TokenPolicyProposal{},
+		&ERC20MetadataProposal{},
+		&TokenScalingProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -62,9 +74,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgValsetUpdatedClaim{}, "gravity/MsgValsetUpdatedClaim", nil)
 	cdc.RegisterConcrete(&OutgoingTxBatch{}, "gravity/OutgoingTxBatch", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEth{}, "gravity/MsgCancelSendToEth", nil)
+	cdc.RegisterConcrete(&MsgReclaimHeldDeposit{}, "gravity/MsgReclaimHeldDeposit", nil)
 	cdc.RegisterConcrete(&OutgoingTransferTx{}, "gravity/OutgoingTransferTx", nil)
 	cdc.RegisterConcrete(&ERC20Token{}, "gravity/ERC20Token", nil)
 	cdc.RegisterConcrete(&IDSet{}, "gravity/IDSet", nil)
 	cdc.RegisterConcrete(&Attestation{}, "gravity/Attestation", nil)
 	cdc.RegisterConcrete(&MsgSubmitBadSignatureEvidence{}, "gravity/MsgSubmitBadSignatureEvidence", nil)
+	cdc.RegisterConcrete(&MsgSubmitClaims{}, "gravity/MsgSubmitClaims", nil)
+	cdc.RegisterConcrete(&MsgSubmitConfirms{}, "gravity/MsgSubmitConfirms", nil)
 }
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewDenomTrace returns the trace of the vouchers of an ERC20 that have no alias
+func NewDenomTrace(tokenContract string) DenomTrace {
+	return DenomTrace{Denom: GravityDenom(tokenContract), TokenContract: CanonicalEthAddress(tokenContract)}
+}
+
+// ValidateBasic checks the denom is a gravity denom and the token contract is checksummed
+func (t DenomTrace) ValidateBasic() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if !strings.HasPrefix(t.Denom, GravityDenomPrefix) {
+		return sdkerrors.Wrapf(ErrInvalid, "denom %s without the %s prefix", t.Denom, GravityDenomPrefix)
+	}
+	if err := ValidateEthAddress(t.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	if t.TokenContract != CanonicalEthAddress(t.TokenContract) {
+		return sdkerrors.Wrapf(ErrInvalid, "token contract %s is not checksummed", t.TokenContract)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomTrace(t *testing.T) {
+	checksummed := "0x6B175474E89094C44Da98b954EedeAC495271d0F"
+	lower := strings.ToLower(checksummed)
+
+	// every casing of a contract gives the same voucher denom
+	trace := NewDenomTrace(lower)
+	assert.Equal(t, checksummed, trace.TokenContract)
+	assert.Equal(t, GravityDenom(checksummed), trace.Denom)
+	assert.Equal(t, GravityDenom("0x"+strings.ToUpper(lower[2:])), trace.Denom)
+	assert.True(t, strings.HasPrefix(trace.Denom, GravityDenomPrefix+GravityDenomSeparator))
+	assert.Len(t, trace.Denom, GravityDenomLen)
+	require.NoError(t, trace.ValidateBasic())
+
+	// vouchers minted before denom traces keep their denom as an alias
+	require.NoError(t, DenomTrace{Denom: GravityDenomPrefix + lower, TokenContract: checksummed}.ValidateBasic())
+	contract, err := LegacyGravityDenomToERC20(GravityDenomPrefix + lower)
+	require.NoError(t, err)
+	assert.Equal(t, lower, contract)
+	_, err = LegacyGravityDenomToERC20(trace.Denom)
+	assert.Error(t, err)
+
+	assert.Error(t, DenomTrace{Denom: trace.Denom, TokenContract: lower}.ValidateBasic())
+	assert.Error(t, DenomTrace{Denom: "stake", TokenContract: checksummed}.ValidateBasic())
+	assert.Error(t, DenomTrace{Denom: "gravity!", TokenContract: checksummed}.ValidateBasic())
+	assert.Error(t, DenomTrace{Denom: trace.Denom, TokenContract: "invalid"}.ValidateBasic())
+}
@@ -2,12 +2,14 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"regexp"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -15,13 +17,16 @@ const (
 	GravityDenomPrefix = ModuleName
 
 	// GravityDenomSeparator is the separator for gravity denoms
-	GravityDenomSeparator = ""
+	GravityDenomSeparator = "/"
 
 	// ETHContractAddressLen is the length of contract address strings
 	ETHContractAddressLen = 42
 
 	// GravityDenomLen is the length of the denoms generated by the gravity module
-	GravityDenomLen = len(GravityDenomPrefix) + len(GravityDenomSeparator) + ETHContractAddressLen
+	GravityDenomLen = len(GravityDenomPrefix) + len(GravityDenomSeparator) + 2*sha256.Size
+
+	// LegacyGravityDenomLen is the length of the gravity0x... denoms of vouchers minted before denom traces
+	LegacyGravityDenomLen = len(GravityDenomPrefix) + ETHContractAddressLen
 )
 
 // EthAddrLessThan migrates the Ethereum address less than function
@@ -43,6 +48,75 @@ func ValidateEthAddress(a string) error {
 	return nil
 }
 
+// CanonicalEthAddress returns the EIP-55 checksummed form of a valid ethereum address
+func CanonicalEthAddress(a string) string {
+	return gethcommon.HexToAddress(a).Hex()
+}
+
+/////////////////////////
+//     EthAddress      //
+/////////////////////////
+
+// EthAddress is a validated ethereum address held in its EIP-55 checksummed form, so addresses
+// that only differ in casing compare equal and are stored under the same keys
+type EthAddress struct {
+	address string
+}
+
+// NewEthAddress validates an ethereum address string and normalizes it to its checksummed form
+func NewEthAddress(address string) (*EthAddress, error) {
+	if err := ValidateEthAddress(address); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid input address")
+	}
+	return &EthAddress{address: CanonicalEthAddress(address)}, nil
+}
+
+// MustNewEthAddress is NewEthAddress for addresses that were validated before, it panics on invalid ones
+func MustNewEthAddress(address string) EthAddress {
+	ethAddress, err := NewEthAddress(address)
+	if err != nil {
+		panic(err)
+	}
+	return *ethAddress
+}
+
+// ZeroAddress returns the ethereum address made of zero bytes
+func ZeroAddress() EthAddress {
+	return EthAddress{address: gethcommon.Address{}.Hex()}
+}
+
+// GetAddress returns the checksummed address string
+func (ea EthAddress) GetAddress() string {
+	return ea.address
+}
+
+// String implements fmt.Stringer
+func (ea EthAddress) String() string {
+	return ea.address
+}
+
+// SameEthAddress returns true if both strings are valid ethereum addresses of the same account, whatever
+// their casing
+func SameEthAddress(a, b string) bool {
+	ethA, err := NewEthAddress(a)
+	if err != nil {
+		return false
+	}
+	ethB, err := NewEthAddress(b)
+	return err == nil && *ethA == *ethB
+}
+
+// ValidateBasic checks that the address was built by NewEthAddress
+func (ea EthAddress) ValidateBasic() error {
+	if err := ValidateEthAddress(ea.address); err != nil {
+		return err
+	}
+	if ea.address != CanonicalEthAddress(ea.address) {
+		return fmt.Errorf("address(%s) is not checksummed", ea.address)
+	}
+	return nil
+}
+
 /////////////////////////
 //     ERC20Token      //
 /////////////////////////
@@ -56,13 +130,11 @@ func NewSDKIntERC20Token(amount sdk.Int, contract string) *ERC20Token {
 	return &ERC20Token{Amount: amount, Contract: contract}
 }
 
-// GravityCoin returns the gravity representation of the ERC20
-func (e *ERC20Token) GravityCoin() sdk.Coin {
-	return sdk.NewCoin(GravityDenom(e.Contract), e.Amount)
-}
-
+// GravityDenom returns the denom of the vouchers of an ERC20 without an alias, see DenomTrace. It is
+// named after the hash of the checksummed contract address so every casing of it gives the same denom
 func GravityDenom(tokenContract string) string {
-	return fmt.Sprintf("%s%s%s", GravityDenomPrefix, GravityDenomSeparator, tokenContract)
+	hash := sha256.Sum256([]byte(CanonicalEthAddress(tokenContract)))
+	return fmt.Sprintf("%s%s%X", GravityDenomPrefix, GravityDenomSeparator, hash)
 }
 
 // ValidateBasic permforms stateless validation
@@ -87,18 +159,18 @@ func (e *ERC20Token) Add(o *ERC20Token) *ERC20Token {
 	return NewERC20Token(sum.Uint64(), e.Contract)
 }
 
-func GravityDenomToERC20(denom string) (string, error) {
-	fullPrefix := GravityDenomPrefix + GravityDenomSeparator
-	if !strings.HasPrefix(denom, fullPrefix) {
-		return "", fmt.Errorf("denom prefix(%s) not equal to expected(%s)", denom, fullPrefix)
+// LegacyGravityDenomToERC20 parses the ERC20 out of the gravity0x... denom of vouchers minted before denom traces
+func LegacyGravityDenomToERC20(denom string) (string, error) {
+	if !strings.HasPrefix(denom, GravityDenomPrefix) {
+		return "", fmt.Errorf("denom prefix(%s) not equal to expected(%s)", denom, GravityDenomPrefix)
 	}
-	contract := strings.TrimPrefix(denom, fullPrefix)
+	contract := strings.TrimPrefix(denom, GravityDenomPrefix)
 	err := ValidateEthAddress(contract)
 	switch {
 	case err != nil:
 		return "", fmt.Errorf("error(%s) validating ethereum contract address", err)
-	case len(denom) != GravityDenomLen:
-		return "", fmt.Errorf("len(denom)(%d) not equal to GravityDenomLen(%d)", len(denom), GravityDenomLen)
+	case len(denom) != LegacyGravityDenomLen:
+		return "", fmt.Errorf("len(denom)(%d) not equal to LegacyGravityDenomLen(%d)", len(denom), LegacyGravityDenomLen)
 	default:
 		return contract, nil
 	}
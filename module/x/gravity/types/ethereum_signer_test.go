@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,11 @@ func TestValsetConfirmSig(t *testing.T) {
 			srcSignature: correctSig,
 			srcETHAddr:   ethAddress,
 		},
+		"lowercase eth address": {
+			srcHash:      hash,
+			srcSignature: correctSig,
+			srcETHAddr:   strings.ToLower(ethAddress),
+		},
 		"invalid signature": {
 			srcHash:      hash,
 			srcSignature: invalidSig,
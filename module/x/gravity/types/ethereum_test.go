@@ -0,0 +1,33 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthAddress(t *testing.T) {
+	checksummed := "0x6B175474E89094C44Da98b954EedeAC495271d0F"
+	lower := strings.ToLower(checksummed)
+
+	// every casing of an address normalizes to the checksummed form
+	for _, address := range []string{checksummed, lower, "0x" + strings.ToUpper(lower[2:])} {
+		ethAddress, err := NewEthAddress(address)
+		require.NoError(t, err)
+		assert.Equal(t, checksummed, ethAddress.GetAddress())
+		assert.Equal(t, MustNewEthAddress(checksummed), *ethAddress)
+		require.NoError(t, ethAddress.ValidateBasic())
+	}
+
+	for _, address := range []string{"", "invalid", lower[:40], lower + "00", "0x" + strings.Repeat("g", 40)} {
+		_, err := NewEthAddress(address)
+		assert.Error(t, err, address)
+		assert.Panics(t, func() { MustNewEthAddress(address) }, address)
+	}
+
+	assert.Equal(t, "0x0000000000000000000000000000000000000000", ZeroAddress().GetAddress())
+	assert.Error(t, EthAddress{}.ValidateBasic())
+	assert.Error(t, EthAddress{address: lower}.ValidateBasic())
+}
@@ -6,9 +6,19 @@ const (
 	EventTypeMultisigUpdateRequest     = "multisig_update_request"
 	EventTypeOutgoingBatchCanceled     = "outgoing_batch_canceled"
 	EventTypeOutgoingLogicCallCanceled = "outgoing_logic_call_canceled"
+	EventTypeOutgoingLogicCallExecuted = "outgoing_logic_call_executed"
 	EventTypeBridgeWithdrawalReceived  = "withdrawal_received"
 	EventTypeBridgeDepositReceived     = "deposit_received"
 	EventTypeBridgeWithdrawCanceled    = "withdraw_canceled"
+	EventTypeBridgeDowntime            = "bridge_downtime"
+	EventTypeValsetRewardPaused        = "valset_reward_paused"
+	EventTypeValsetRewardShortfall     = "valset_reward_shortfall"
+	EventTypeERC20DeploymentRejected   = "erc20_deployment_rejected"
+	EventTypeERC20Remapped             = "erc20_remapped"
+	EventTypeDepositHeld               = "deposit_held"
+	EventTypeHeldDepositReclaimed      = "held_deposit_reclaimed"
+	EventTypeDenomMetadataSet          = "denom_metadata_set"
+	EventTypeTokenScalingSet           = "token_scaling_set"
 
 	AttributeKeyAttestationID          = "attestation_id"
 	AttributeKeyBatchConfirmKey        = "batch_confirm_key"
@@ -27,4 +37,33 @@ const (
 	AttributeKeyInvalidationNonce      = "logic_call_invalidation_nonce"
 	AttributeKeyBadEthSignature        = "bad_eth_signature"
 	AttributeKeyBadEthSignatureSubject = "bad_eth_signature_subject"
+	AttributeKeyValidator              = "validator"
+	AttributeKeyJailedUntil            = "jailed_until"
+	AttributeKeyValsetReason           = "valset_reason"
+	AttributeKeyReward                 = "reward"
+	AttributeKeyRewardPoolBalance      = "reward_pool_balance"
+	AttributeKeyDenom                  = "denom"
+	AttributeKeyERC20                  = "erc20"
+	AttributeKeyPreviousERC20          = "previous_erc20"
+	AttributeKeyReason                 = "reason"
+	AttributeKeyHeldDepositID          = "held_deposit_id"
+	AttributeKeyReclaimOutcome         = "reclaim_outcome"
+	AttributeKeyExponent               = "exponent"
+)
+
+// The reasons a new valset is requested for, given in the valset_reason attribute of
+// the multisig_update_request event
+const (
+	ValsetReasonNoValset         = "no_valset"
+	ValsetReasonUnbonding        = "validator_unbonding"
+	ValsetReasonPowerChange      = "power_change"
+	ValsetReasonMaxAge           = "max_age"
+	ValsetReasonEthAddressChange = "eth_address_change"
+)
+
+// The outcomes of reclaiming a held deposit, given in the reclaim_outcome attribute of
+// the held_deposit_reclaimed event
+const (
+	ReclaimOutcomeReleased = "released"
+	ReclaimOutcomeReturned = "returned"
 )
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -66,6 +67,48 @@ var (
 	// to a relayer when they relay a valset
 	ParamStoreValsetRewardAmount = []byte("ValsetReward")
 
+	// ParamStoreAttestationRetentionBlocks stores how long observed attestations are kept
+	ParamStoreAttestationRetentionBlocks = []byte("AttestationRetentionBlocks")
+
+	// ParamStoreBridgeSigningWindow stores how many items of each kind the bridge signing info keeps track of
+	ParamStoreBridgeSigningWindow = []byte("BridgeSigningWindow")
+
+	// ParamStoreGraduatedBridgeSlashing stores whether validators are only slashed for missing too many confirms
+	ParamStoreGraduatedBridgeSlashing = []byte("GraduatedBridgeSlashing")
+
+	// ParamStoreMinSignedPerWindow stores the share of the bridge signing window a validator has to confirm
+	ParamStoreMinSignedPerWindow = []byte("MinSignedPerWindow")
+
+	// ParamStoreBridgeJailDuration stores how long a validator is jailed for bridge downtime
+	ParamStoreBridgeJailDuration = []byte("BridgeJailDuration")
+
+	// ParamStoreSlashFractionBridgeDowntime stores the amount by which a validator is slashed for bridge downtime
+	ParamStoreSlashFractionBridgeDowntime = []byte("SlashFractionBridgeDowntime")
+
+	// ParamStoreValsetPowerDiffThreshold stores the power change that triggers a new valset
+	ParamStoreValsetPowerDiffThreshold = []byte("ValsetPowerDiffThreshold")
+
+	// ParamStoreValsetMaxAge stores the age in blocks at which the latest valset is refreshed
+	ParamStoreValsetMaxAge = []byte("ValsetMaxAge")
+
+	// ParamStoreValsetMinInterval stores the min number of blocks between optional valset requests
+	ParamStoreValsetMinInterval = []byte("ValsetMinInterval")
+
+	// ParamStoreValsetOnEthAddressChange stores whether registering an Ethereum address triggers a new valset
+	ParamStoreValsetOnEthAddressChange = []byte("ValsetOnEthAddressChange")
+
+	// ParamStoreMaxBridgeValidators stores the number of most powerful validators valsets are capped at
+	ParamStoreMaxBridgeValidators = []byte("MaxBridgeValidators")
+
+	// ParamStoreMinBridgePowerShare stores the share of power the validators in a valset have to hold at least
+	ParamStoreMinBridgePowerShare = []byte("MinBridgePowerShare")
+
+	// ParamStoreRequireERC20DeploymentApproval stores whether ERC20 deployments need a governance approval
+	ParamStoreRequireERC20DeploymentApproval = []byte("RequireERC20DeploymentApproval")
+
+	// ParamStoreExtendedClaims stores whether claims keep the fields older orchestrators don't report
+	ParamStoreExtendedClaims = []byte("ExtendedClaims")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -76,6 +119,42 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	for _, approval := range s.Erc20DeploymentApprovals {
+		if err := approval.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "erc20 deployment approval")
+		}
+	}
+	if err := s.TokenPolicy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "token policy")
+	}
+	for _, deposit := range s.HeldDeposits {
+		if err := deposit.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "held deposit %d", deposit.Id)
+		}
+		if deposit.Id == 0 || deposit.Id >= s.NextHeldDepositId {
+			return sdkerrors.Wrapf(ErrInvalid, "held deposit id %d not below next id %d", deposit.Id, s.NextHeldDepositId)
+		}
+	}
+	for _, scaling := range s.TokenScalings {
+		if err := scaling.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "token scaling")
+		}
+	}
+	for _, dust := range s.Dust {
+		if err := dust.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "dust")
+		}
+	}
+	traced := make(map[string]bool, len(s.DenomTraces))
+	for _, trace := range s.DenomTraces {
+		if err := trace.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "denom trace %s", trace.Denom)
+		}
+		if traced[trace.Denom] || traced[trace.TokenContract] {
+			return sdkerrors.Wrapf(ErrDuplicate, "denom trace %s of %s", trace.Denom, trace.TokenContract)
+		}
+		traced[trace.Denom], traced[trace.TokenContract] = true, true
+	}
 	return nil
 }
 
@@ -107,6 +186,22 @@ func DefaultParams() *Params {
 			Denom:  "",
 			Amount: sdk.ZeroInt(),
 		},
+		AttestationRetentionBlocks:  10000,
+		BridgeSigningWindow:         100,
+		GraduatedBridgeSlashing:     false,
+		MinSignedPerWindow:          sdk.NewDecWithPrec(5, 1),
+		BridgeJailDuration:          10 * time.Minute,
+		SlashFractionBridgeDowntime: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		ValsetPowerDiffThreshold:    sdk.NewDecWithPrec(5, 2),
+		ValsetMaxAge:                0,
+		ValsetMinInterval:           0,
+		ValsetOnEthAddressChange:    false,
+		MaxBridgeValidators:         0,
+		MinBridgePowerShare:         sdk.NewDecWithPrec(9, 1),
+		// ERC20 deployments are accepted without an approval until governance enables this
+		RequireErc20DeploymentApproval: false,
+		// claims are reduced to what every orchestrator reports until governance enables this
+		ExtendedClaims: false,
 	}
 }
 
@@ -160,6 +255,48 @@ func (p Params) ValidateBasic() error {
 	if err := validateValsetRewardAmount(p.ValsetReward); err != nil {
 		return sdkerrors.Wrap(err, "ValsetReward amount")
 	}
+	if err := validateAttestationRetentionBlocks(p.AttestationRetentionBlocks); err != nil {
+		return sdkerrors.Wrap(err, "attestation retention blocks")
+	}
+	if err := validateBridgeSigningWindow(p.BridgeSigningWindow); err != nil {
+		return sdkerrors.Wrap(err, "bridge signing window")
+	}
+	if err := validateGraduatedBridgeSlashing(p.GraduatedBridgeSlashing); err != nil {
+		return sdkerrors.Wrap(err, "graduated bridge slashing")
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return sdkerrors.Wrap(err, "min signed per window")
+	}
+	if err := validateBridgeJailDuration(p.BridgeJailDuration); err != nil {
+		return sdkerrors.Wrap(err, "bridge jail duration")
+	}
+	if err := validateSlashFractionBridgeDowntime(p.SlashFractionBridgeDowntime); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction bridge downtime")
+	}
+	if err := validateValsetPowerDiffThreshold(p.ValsetPowerDiffThreshold); err != nil {
+		return sdkerrors.Wrap(err, "valset power diff threshold")
+	}
+	if err := validateValsetMaxAge(p.ValsetMaxAge); err != nil {
+		return sdkerrors.Wrap(err, "valset max age")
+	}
+	if err := validateValsetMinInterval(p.ValsetMinInterval); err != nil {
+		return sdkerrors.Wrap(err, "valset min interval")
+	}
+	if err := validateValsetOnEthAddressChange(p.ValsetOnEthAddressChange); err != nil {
+		return sdkerrors.Wrap(err, "valset on eth address change")
+	}
+	if err := validateMaxBridgeValidators(p.MaxBridgeValidators); err != nil {
+		return sdkerrors.Wrap(err, "max bridge validators")
+	}
+	if err := validateMinBridgePowerShare(p.MinBridgePowerShare); err != nil {
+		return sdkerrors.Wrap(err, "min bridge power share")
+	}
+	if err := validateRequireERC20DeploymentApproval(p.RequireErc20DeploymentApproval); err != nil {
+		return sdkerrors.Wrap(err, "require erc20 deployment approval")
+	}
+	if err := validateExtendedClaims(p.ExtendedClaims); err != nil {
+		return sdkerrors.Wrap(err, "extended claims")
+	}
 
 	return nil
 }
@@ -188,6 +325,20 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingValsetsWindow, &p.UnbondSlashingValsetsWindow, validateUnbondSlashingValsetsWindow),
 		paramtypes.NewParamSetPair(ParamStoreSlashFractionBadEthSignature, &p.SlashFractionBadEthSignature, validateSlashFractionBadEthSignature),
 		paramtypes.NewParamSetPair(ParamStoreValsetRewardAmount, &p.ValsetReward, validateValsetRewardAmount),
+		paramtypes.NewParamSetPair(ParamStoreAttestationRetentionBlocks, &p.AttestationRetentionBlocks, validateAttestationRetentionBlocks),
+		paramtypes.NewParamSetPair(ParamStoreBridgeSigningWindow, &p.BridgeSigningWindow, validateBridgeSigningWindow),
+		paramtypes.NewParamSetPair(ParamStoreGraduatedBridgeSlashing, &p.GraduatedBridgeSlashing, validateGraduatedBridgeSlashing),
+		paramtypes.NewParamSetPair(ParamStoreMinSignedPerWindow, &p.MinSignedPerWindow, validateMinSignedPerWindow),
+		paramtypes.NewParamSetPair(ParamStoreBridgeJailDuration, &p.BridgeJailDuration, validateBridgeJailDuration),
+		paramtypes.NewParamSetPair(ParamStoreSlashFractionBridgeDowntime, &p.SlashFractionBridgeDowntime, validateSlashFractionBridgeDowntime),
+		paramtypes.NewParamSetPair(ParamStoreValsetPowerDiffThreshold, &p.ValsetPowerDiffThreshold, validateValsetPowerDiffThreshold),
+		paramtypes.NewParamSetPair(ParamStoreValsetMaxAge, &p.ValsetMaxAge, validateValsetMaxAge),
+		paramtypes.NewParamSetPair(ParamStoreValsetMinInterval, &p.ValsetMinInterval, validateValsetMinInterval),
+		paramtypes.NewParamSetPair(ParamStoreValsetOnEthAddressChange, &p.ValsetOnEthAddressChange, validateValsetOnEthAddressChange),
+		paramtypes.NewParamSetPair(ParamStoreMaxBridgeValidators, &p.MaxBridgeValidators, validateMaxBridgeValidators),
+		paramtypes.NewParamSetPair(ParamStoreMinBridgePowerShare, &p.MinBridgePowerShare, validateMinBridgePowerShare),
+		paramtypes.NewParamSetPair(ParamStoreRequireERC20DeploymentApproval, &p.RequireErc20DeploymentApproval, validateRequireERC20DeploymentApproval),
+		paramtypes.NewParamSetPair(ParamStoreExtendedClaims, &p.ExtendedClaims, validateExtendedClaims),
 	}
 }
 
@@ -340,6 +491,129 @@ func validateValsetRewardAmount(i interface{}) error {
 	return nil
 }
 
+func validateAttestationRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBridgeSigningWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("bridge signing window must be positive")
+	}
+	return nil
+}
+
+func validateGraduatedBridgeSlashing(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinSignedPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min signed per window must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateBridgeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("bridge jail duration must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateSlashFractionBridgeDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction bridge downtime must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateValsetPowerDiffThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// the power diff of two valsets is at most 2
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.NewDec(2)) {
+		return fmt.Errorf("valset power diff threshold must be between 0 and 2: %s", v)
+	}
+	return nil
+}
+
+func validateValsetMaxAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateValsetMinInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateValsetOnEthAddressChange(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxBridgeValidators(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinBridgePowerShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min bridge power share must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateRequireERC20DeploymentApproval(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateExtendedClaims(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
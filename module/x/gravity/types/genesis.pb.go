@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -45,23 +49,100 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // the token you are using for validator set rewards valset updates will fail and the bridge
 // will be vulnerable to highjacking. For these paramaters the zero values are special and indicate
 // not to attempt any reward. This is the default for bootstrapping.
+// Ethereum originated rewards are paid out of the gravity_valset_reward_pool module account,
+// which governance or a community pool spend has to fund with the vouchers of the token. While the
+// pool holds less than the reward new validator sets are created without a reward.
+//
+// attestation_retention_blocks
+//
+// The number of blocks observed attestations, along with every other attestation for the same
+// event nonce, are kept in the store after they are observed. This gives evidence handling and
+// liveness checks a record of who voted for what, after this they are pruned a few at a time.
+// Zero prunes attestations as soon as a later event is observed.
+//
+// bridge_signing_window
+//
+// The number of valsets, batches, logic calls and observed claims the bridge signing info of each
+// validator keeps track of. Much like the signed blocks window of the slashing module the missed
+// counters only count misses within the last window of each kind.
+//
+// graduated_bridge_slashing
+// min_signed_per_window
+// bridge_jail_duration
+// slash_fraction_bridge_downtime
+//
+// With graduated bridge slashing off every missed valset, batch or logic call confirm is slashed
+// by its slash fraction and jails the validator. With it on a validator is only slashed by the
+// downtime fraction and jailed once it signed less than min_signed_per_window of the last bridge
+// signing window of valsets, batches or logic calls, much like downtime in the slashing module.
+// The validator can unjail after the jail duration times the number of times it was jailed for
+// bridge downtime, so repeat offenders stay jailed longer.
+//
+// valset_power_diff_threshold
+// valset_max_age
+// valset_min_interval
+// valset_on_eth_address_change
+//
+// A new valset is requested when there is none yet, when a validator starts unbonding, when the
+// normalized power of the current set differs from the latest valset by more than the power diff
+// threshold, when the latest valset is valset_max_age blocks old and, with valset_on_eth_address_change
+// set, when a validator registered an Ethereum address after the latest valset. All but the first
+// two wait until valset_min_interval blocks have passed since the latest valset, to limit the gas
+// spent relaying valsets. Zero disables the max age and the min interval.
+//
+// max_bridge_validators
+// min_bridge_power_share
+//
+// Valsets only take the max_bridge_validators validators with the most power, to bound the gas spent
+// checking signatures on Ethereum. If those hold less than min_bridge_power_share of the power of
+// every bonded validator with an Ethereum address the next most powerful ones are added until they
+// do. Only validators in a valset are slashed for not confirming it. Zero max_bridge_validators
+// leaves valsets uncapped.
+//
+// require_erc20_deployment_approval
+//
+// When set only ERC20 deployments for denoms a governance ERC20WhitelistProposal approved are
+// accepted. Deployments that don't match the ERC20 or deployer an approval expects are rejected
+// whether or not this is set.
+//
+// extended_claims
+//
+// Orchestrators report fields older ones don't, like the relayer of a batch, logic call or valset, and
+// these are part of the claim hash. So that validators running older orchestrators vote on the same
+// claims these fields are dropped from claims until governance sets this, which should only happen
+// once every orchestrator was upgraded. Until then relayer stats aren't recorded, approvals that
+// expect a deployer reject every deployment and deposits don't set the metadata of vouchers.
 type Params struct {
-	GravityId                    string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
-	ContractSourceHash           string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress        string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId                uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedValsetsWindow          uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
-	SignedBatchesWindow          uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	SignedLogicCallsWindow       uint64                                 `protobuf:"varint,8,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
-	TargetBatchTimeout           uint64                                 `protobuf:"varint,9,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
-	AverageBlockTime             uint64                                 `protobuf:"varint,10,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime     uint64                                 `protobuf:"varint,11,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	SlashFractionValset          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
-	SlashFractionBatch           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
-	SlashFractionLogicCall       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
-	UnbondSlashingValsetsWindow  uint64                                 `protobuf:"varint,15,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
-	SlashFractionBadEthSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
-	ValsetReward                 types.Coin                             `protobuf:"bytes,17,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
+	GravityId                      string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	ContractSourceHash             string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
+	BridgeEthereumAddress          string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId                  uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	SignedValsetsWindow            uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
+	SignedBatchesWindow            uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	SignedLogicCallsWindow         uint64                                 `protobuf:"varint,8,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
+	TargetBatchTimeout             uint64                                 `protobuf:"varint,9,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
+	AverageBlockTime               uint64                                 `protobuf:"varint,10,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
+	AverageEthereumBlockTime       uint64                                 `protobuf:"varint,11,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
+	SlashFractionValset            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
+	SlashFractionBatch             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
+	SlashFractionLogicCall         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
+	UnbondSlashingValsetsWindow    uint64                                 `protobuf:"varint,15,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
+	SlashFractionBadEthSignature   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
+	ValsetReward                   types.Coin                             `protobuf:"bytes,17,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
+	AttestationRetentionBlocks     uint64                                 `protobuf:"varint,18,opt,name=attestation_retention_blocks,json=attestationRetentionBlocks,proto3" json:"attestation_retention_blocks,omitempty"`
+	BridgeSigningWindow            uint64                                 `protobuf:"varint,19,opt,name=bridge_signing_window,json=bridgeSigningWindow,proto3" json:"bridge_signing_window,omitempty"`
+	GraduatedBridgeSlashing        bool                                   `protobuf:"varint,20,opt,name=graduated_bridge_slashing,json=graduatedBridgeSlashing,proto3" json:"graduated_bridge_slashing,omitempty"`
+	MinSignedPerWindow             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window"`
+	BridgeJailDuration             time.Duration                          `protobuf:"bytes,22,opt,name=bridge_jail_duration,json=bridgeJailDuration,proto3,stdduration" json:"bridge_jail_duration"`
+	SlashFractionBridgeDowntime    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=slash_fraction_bridge_downtime,json=slashFractionBridgeDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bridge_downtime"`
+	ValsetPowerDiffThreshold       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,24,opt,name=valset_power_diff_threshold,json=valsetPowerDiffThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset_power_diff_threshold"`
+	ValsetMaxAge                   uint64                                 `protobuf:"varint,25,opt,name=valset_max_age,json=valsetMaxAge,proto3" json:"valset_max_age,omitempty"`
+	ValsetMinInterval              uint64                                 `protobuf:"varint,26,opt,name=valset_min_interval,json=valsetMinInterval,proto3" json:"valset_min_interval,omitempty"`
+	ValsetOnEthAddressChange       bool                                   `protobuf:"varint,27,opt,name=valset_on_eth_address_change,json=valsetOnEthAddressChange,proto3" json:"valset_on_eth_address_change,omitempty"`
+	MaxBridgeValidators            uint64                                 `protobuf:"varint,28,opt,name=max_bridge_validators,json=maxBridgeValidators,proto3" json:"max_bridge_validators,omitempty"`
+	MinBridgePowerShare            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,29,opt,name=min_bridge_power_share,json=minBridgePowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_bridge_power_share"`
+	RequireErc20DeploymentApproval bool                                   `protobuf:"varint,30,opt,name=require_erc20_deployment_approval,json=requireErc20DeploymentApproval,proto3" json:"require_erc20_deployment_approval,omitempty"`
+	ExtendedClaims                 bool                                   `protobuf:"varint,31,opt,name=extended_claims,json=extendedClaims,proto3" json:"extended_claims,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,20 +262,117 @@ func (m *Params) GetValsetReward() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetAttestationRetentionBlocks() uint64 {
+	if m != nil {
+		return m.AttestationRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeSigningWindow() uint64 {
+	if m != nil {
+		return m.BridgeSigningWindow
+	}
+	return 0
+}
+
+func (m *Params) GetGraduatedBridgeSlashing() bool {
+	if m != nil {
+		return m.GraduatedBridgeSlashing
+	}
+	return false
+}
+
+func (m *Params) GetBridgeJailDuration() time.Duration {
+	if m != nil {
+		return m.BridgeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetValsetMaxAge() uint64 {
+	if m != nil {
+		return m.ValsetMaxAge
+	}
+	return 0
+}
+
+func (m *Params) GetValsetMinInterval() uint64 {
+	if m != nil {
+		return m.ValsetMinInterval
+	}
+	return 0
+}
+
+func (m *Params) GetValsetOnEthAddressChange() bool {
+	if m != nil {
+		return m.ValsetOnEthAddressChange
+	}
+	return false
+}
+
+func (m *Params) GetMaxBridgeValidators() uint64 {
+	if m != nil {
+		return m.MaxBridgeValidators
+	}
+	return 0
+}
+
+func (m *Params) GetRequireErc20DeploymentApproval() bool {
+	if m != nil {
+		return m.RequireErc20DeploymentApproval
+	}
+	return false
+}
+
+func (m *Params) GetExtendedClaims() bool {
+	if m != nil {
+		return m.ExtendedClaims
+	}
+	return false
+}
+
 // GenesisState struct
 type GenesisState struct {
-	Params             *Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedNonce  uint64                       `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
-	Valsets            []*Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
-	ValsetConfirms     []*MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
-	Batches            []*OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
-	BatchConfirms      []MsgConfirmBatch            `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls         []*OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
-	LogicCallConfirms  []MsgConfirmLogicCall        `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations       []Attestation                `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys       []*MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms      []*ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedTransfers []*OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
+	Params                      *Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedNonce           uint64                          `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
+	Valsets                     []*Valset                       `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
+	ValsetConfirms              []*MsgValsetConfirm             `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
+	Batches                     []*OutgoingTxBatch              `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches,omitempty"`
+	BatchConfirms               []MsgConfirmBatch               `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls                  []*OutgoingLogicCall            `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
+	LogicCallConfirms           []MsgConfirmLogicCall           `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations                []Attestation                   `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys                []*MsgSetOrchestratorAddress    `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms               []*ERC20ToDenom                 `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedTransfers          []*OutgoingTransferTx           `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers,omitempty"`
+	LastObservedValset          *Valset                         `protobuf:"bytes,13,opt,name=last_observed_valset,json=lastObservedValset,proto3" json:"last_observed_valset,omitempty"`
+	LastObservedEthereumHeight  LastObservedEthereumBlockHeight `protobuf:"bytes,14,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height"`
+	LastEventNonces             []LastEventNonceByValidator     `protobuf:"bytes,15,rep,name=last_event_nonces,json=lastEventNonces,proto3" json:"last_event_nonces"`
+	PastEthSignatureCheckpoints [][]byte                        `protobuf:"bytes,16,rep,name=past_eth_signature_checkpoints,json=pastEthSignatureCheckpoints,proto3" json:"past_eth_signature_checkpoints,omitempty"`
+	LatestValsetNonce           uint64                          `protobuf:"varint,17,opt,name=latest_valset_nonce,json=latestValsetNonce,proto3" json:"latest_valset_nonce,omitempty"`
+	LastSlashedValsetNonce      uint64                          `protobuf:"varint,18,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
+	LastSlashedBatchBlock       uint64                          `protobuf:"varint,19,opt,name=last_slashed_batch_block,json=lastSlashedBatchBlock,proto3" json:"last_slashed_batch_block,omitempty"`
+	LastSlashedLogicCallBlock   uint64                          `protobuf:"varint,20,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
+	LastUnBondingBlockHeight    uint64                          `protobuf:"varint,21,opt,name=last_un_bonding_block_height,json=lastUnBondingBlockHeight,proto3" json:"last_un_bonding_block_height,omitempty"`
+	// the next ids handed out to pool transactions and batches, zero if none
+	// has been handed out yet
+	NextTxPoolId        uint64 `protobuf:"varint,22,opt,name=next_tx_pool_id,json=nextTxPoolId,proto3" json:"next_tx_pool_id,omitempty"`
+	NextOutgoingBatchId uint64 `protobuf:"varint,23,opt,name=next_outgoing_batch_id,json=nextOutgoingBatchId,proto3" json:"next_outgoing_batch_id,omitempty"`
+	// the valset, batch or logic call each past checkpoint was produced from,
+	// checkpoints without a record are only listed in past_eth_signature_checkpoints
+	PastEthSignatureCheckpointInfos []PastEthSignatureCheckpoint `protobuf:"bytes,24,rep,name=past_eth_signature_checkpoint_infos,json=pastEthSignatureCheckpointInfos,proto3" json:"past_eth_signature_checkpoint_infos"`
+	BridgeSigningInfos              []ValidatorBridgeSigningInfo `protobuf:"bytes,25,rep,name=bridge_signing_infos,json=bridgeSigningInfos,proto3" json:"bridge_signing_infos"`
+	LastEthAddressChangeHeight      uint64                       `protobuf:"varint,26,opt,name=last_eth_address_change_height,json=lastEthAddressChangeHeight,proto3" json:"last_eth_address_change_height,omitempty"`
+	RelayerStats                    []RelayerStats               `protobuf:"bytes,27,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
+	Erc20DeploymentApprovals        []ERC20DeploymentApproval    `protobuf:"bytes,28,rep,name=erc20_deployment_approvals,json=erc20DeploymentApprovals,proto3" json:"erc20_deployment_approvals"`
+	TokenPolicy                     TokenPolicy                  `protobuf:"bytes,29,opt,name=token_policy,json=tokenPolicy,proto3" json:"token_policy"`
+	HeldDeposits                    []HeldDeposit                `protobuf:"bytes,30,rep,name=held_deposits,json=heldDeposits,proto3" json:"held_deposits"`
+	NextHeldDepositId               uint64                       `protobuf:"varint,31,opt,name=next_held_deposit_id,json=nextHeldDepositId,proto3" json:"next_held_deposit_id,omitempty"`
+	TokenScalings                   []TokenScaling               `protobuf:"bytes,32,rep,name=token_scalings,json=tokenScalings,proto3" json:"token_scalings"`
+	// the ERC20 units of deposits that did not make up a Cosmos unit yet
+	Dust        []ERC20Token `protobuf:"bytes,33,rep,name=dust,proto3" json:"dust"`
+	DenomTraces []DenomTrace `protobuf:"bytes,34,rep,name=denom_traces,json=denomTraces,proto3" json:"denom_traces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -314,78 +492,347 @@ func (m *GenesisState) GetUnbatchedTransfers() []*OutgoingTransferTx {
 	return nil
 }
 
+func (m *GenesisState) GetLastObservedValset() *Valset {
+	if m != nil {
+		return m.LastObservedValset
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastObservedEthereumHeight() LastObservedEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
+	}
+	return LastObservedEthereumBlockHeight{}
+}
+
+func (m *GenesisState) GetLastEventNonces() []LastEventNonceByValidator {
+	if m != nil {
+		return m.LastEventNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPastEthSignatureCheckpoints() [][]byte {
+	if m != nil {
+		return m.PastEthSignatureCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLatestValsetNonce() uint64 {
+	if m != nil {
+		return m.LatestValsetNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastSlashedValsetNonce() uint64 {
+	if m != nil {
+		return m.LastSlashedValsetNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastSlashedBatchBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedBatchBlock
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastSlashedLogicCallBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedLogicCallBlock
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastUnBondingBlockHeight() uint64 {
+	if m != nil {
+		return m.LastUnBondingBlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNextTxPoolId() uint64 {
+	if m != nil {
+		return m.NextTxPoolId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNextOutgoingBatchId() uint64 {
+	if m != nil {
+		return m.NextOutgoingBatchId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPastEthSignatureCheckpointInfos() []PastEthSignatureCheckpoint {
+	if m != nil {
+		return m.PastEthSignatureCheckpointInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeSigningInfos() []ValidatorBridgeSigningInfo {
+	if m != nil {
+		return m.BridgeSigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastEthAddressChangeHeight() uint64 {
+	if m != nil {
+		return m.LastEthAddressChangeHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20DeploymentApprovals() []ERC20DeploymentApproval {
+	if m != nil {
+		return m.Erc20DeploymentApprovals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenPolicy() TokenPolicy {
+	if m != nil {
+		return m.TokenPolicy
+	}
+	return TokenPolicy{}
+}
+
+func (m *GenesisState) GetHeldDeposits() []HeldDeposit {
+	if m != nil {
+		return m.HeldDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextHeldDepositId() uint64 {
+	if m != nil {
+		return m.NextHeldDepositId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTokenScalings() []TokenScaling {
+	if m != nil {
+		return m.TokenScalings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDust() []ERC20Token {
+	if m != nil {
+		return m.Dust
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTraces() []DenomTrace {
+	if m != nil {
+		return m.DenomTraces
+	}
+	return nil
+}
+
+// LastEventNonceByValidator is the event nonce of the last claim a validator
+// submitted
+type LastEventNonceByValidator struct {
+	Validator  string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	EventNonce uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *LastEventNonceByValidator) Reset()         { *m = LastEventNonceByValidator{} }
+func (m *LastEventNonceByValidator) String() string { return proto.CompactTextString(m) }
+func (*LastEventNonceByValidator) ProtoMessage()    {}
+func (*LastEventNonceByValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *LastEventNonceByValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastEventNonceByValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastEventNonceByValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastEventNonceByValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastEventNonceByValidator.Merge(m, src)
+}
+func (m *LastEventNonceByValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastEventNonceByValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastEventNonceByValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastEventNonceByValidator proto.InternalMessageInfo
+
+func (m *LastEventNonceByValidator) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *LastEventNonceByValidator) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*LastEventNonceByValidator)(nil), "gravity.v1.LastEventNonceByValidator")
 }
 
 func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
 
 var fileDescriptor_387b0aba880adb60 = []byte{
-	// 993 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdf, 0x4e, 0x23, 0x37,
-	0x14, 0xc6, 0x93, 0x6e, 0x36, 0x80, 0x93, 0xc0, 0xe2, 0x00, 0x6b, 0xfe, 0x6c, 0x88, 0x56, 0xea,
-	0x0a, 0x55, 0xcb, 0x0c, 0xa4, 0x6a, 0xa5, 0x56, 0x6a, 0x55, 0x12, 0x68, 0x77, 0xdb, 0x6e, 0xa9,
-	0x26, 0xb4, 0x95, 0xaa, 0x4a, 0xae, 0x67, 0xc6, 0xcc, 0x8c, 0x98, 0xd8, 0xc8, 0x76, 0x02, 0xdc,
-	0xf5, 0x11, 0x7a, 0xdb, 0x07, 0xe9, 0x3b, 0xec, 0xe5, 0x5e, 0x56, 0x55, 0xb5, 0xaa, 0xe0, 0x45,
-	0x56, 0x63, 0x7b, 0x26, 0x43, 0x96, 0x2b, 0xae, 0x60, 0xfc, 0x7d, 0xbf, 0x73, 0x4e, 0x8e, 0xed,
-	0x63, 0x80, 0x22, 0x41, 0x26, 0x89, 0xba, 0x72, 0x27, 0xfb, 0x6e, 0x44, 0x19, 0x95, 0x89, 0x74,
-	0xce, 0x05, 0x57, 0x1c, 0x02, 0xab, 0x38, 0x93, 0xfd, 0x8d, 0x95, 0x88, 0x47, 0x5c, 0x2f, 0xbb,
-	0xd9, 0x7f, 0xc6, 0xb1, 0xb1, 0x56, 0x62, 0xd5, 0xd5, 0x39, 0xb5, 0xe4, 0xc6, 0x6a, 0x69, 0x7d,
-	0x24, 0x23, 0x79, 0x87, 0xdd, 0x27, 0x2a, 0x88, 0xed, 0xfa, 0x56, 0x69, 0x9d, 0x28, 0x45, 0xa5,
-	0x22, 0x2a, 0xe1, 0xcc, 0xaa, 0x9d, 0x80, 0xcb, 0x11, 0x97, 0xae, 0x4f, 0x24, 0x75, 0x27, 0xfb,
-	0x3e, 0x55, 0x64, 0xdf, 0x0d, 0x78, 0x62, 0xf5, 0xa7, 0x7f, 0xcf, 0x83, 0xfa, 0x8f, 0x44, 0x90,
-	0x91, 0x84, 0x4f, 0x40, 0x5e, 0x33, 0x4e, 0x42, 0x54, 0xed, 0x56, 0x77, 0x16, 0xbc, 0x05, 0xbb,
-	0xf2, 0x32, 0x84, 0x7b, 0x60, 0x25, 0xe0, 0x4c, 0x09, 0x12, 0x28, 0x2c, 0xf9, 0x58, 0x04, 0x14,
-	0xc7, 0x44, 0xc6, 0xe8, 0x03, 0x6d, 0x84, 0xb9, 0x36, 0xd4, 0xd2, 0x0b, 0x22, 0x63, 0xf8, 0x29,
-	0x78, 0xec, 0x8b, 0x24, 0x8c, 0x28, 0xa6, 0x2a, 0xa6, 0x82, 0x8e, 0x47, 0x98, 0x84, 0xa1, 0xa0,
-	0x52, 0xa2, 0x9a, 0x86, 0x56, 0x8d, 0x7c, 0x64, 0xd5, 0x03, 0x23, 0xc2, 0x67, 0x60, 0xc9, 0x72,
-	0x41, 0x4c, 0x12, 0x96, 0x55, 0xf3, 0xb0, 0x5b, 0xdd, 0xa9, 0x79, 0x2d, 0xb3, 0x3c, 0xc8, 0x56,
-	0x5f, 0x86, 0xb0, 0x07, 0x56, 0x65, 0x12, 0x31, 0x1a, 0xe2, 0x09, 0x49, 0x25, 0x55, 0x12, 0x5f,
-	0x24, 0x2c, 0xe4, 0x17, 0xa8, 0xae, 0xdd, 0x6d, 0x23, 0xfe, 0x6c, 0xb4, 0x5f, 0xb4, 0x54, 0x62,
-	0x74, 0x0f, 0x69, 0xc1, 0xcc, 0x95, 0x99, 0xbe, 0xd1, 0x2c, 0xf3, 0x19, 0x58, 0xb7, 0x4c, 0xca,
-	0xa3, 0x24, 0xc0, 0x01, 0x49, 0xd3, 0x82, 0x9b, 0xd7, 0xdc, 0x9a, 0x31, 0x7c, 0x9f, 0xe9, 0x83,
-	0x4c, 0xb6, 0xe8, 0x1e, 0x58, 0x51, 0x44, 0x44, 0x54, 0x99, 0x74, 0x58, 0x25, 0x23, 0xca, 0xc7,
-	0x0a, 0x2d, 0x68, 0x0a, 0x1a, 0x4d, 0x67, 0x3b, 0x31, 0x0a, 0x7c, 0x0e, 0x20, 0x99, 0x50, 0x41,
-	0x22, 0x8a, 0xfd, 0x94, 0x07, 0x67, 0x1a, 0x41, 0x40, 0xfb, 0x1f, 0x59, 0xa5, 0x9f, 0x09, 0x19,
-	0x00, 0xbf, 0x00, 0x9b, 0xb9, 0xbb, 0xe8, 0x71, 0x09, 0x6b, 0x68, 0x0c, 0x59, 0x4b, 0xde, 0xe7,
-	0x29, 0xee, 0x83, 0x55, 0x99, 0x12, 0x19, 0xe3, 0xd3, 0x6c, 0xeb, 0x12, 0xce, 0x6c, 0x27, 0x51,
-	0xb3, 0x5b, 0xdd, 0x69, 0xf6, 0x9d, 0xd7, 0x6f, 0xb7, 0x2b, 0xff, 0xbe, 0xdd, 0x7e, 0x16, 0x25,
-	0x2a, 0x1e, 0xfb, 0x4e, 0xc0, 0x47, 0xae, 0x3d, 0x4f, 0xe6, 0xcf, 0xae, 0x0c, 0xcf, 0xec, 0xd9,
-	0x3d, 0xa4, 0x81, 0xd7, 0xd6, 0xc1, 0xbe, 0xb6, 0xb1, 0x4c, 0xe3, 0xe1, 0xef, 0x60, 0x65, 0x26,
-	0x87, 0x6e, 0x05, 0x6a, 0xdd, 0x2b, 0x05, 0xbc, 0x95, 0x42, 0x77, 0x0e, 0x26, 0x60, 0x7d, 0x26,
-	0xc3, 0x74, 0x9f, 0xd0, 0xe2, 0xbd, 0xd2, 0xac, 0xdd, 0x4a, 0x53, 0x6c, 0x2b, 0x1c, 0x80, 0xce,
-	0x98, 0xf9, 0x9c, 0x85, 0x58, 0x1b, 0x12, 0x16, 0xcd, 0x9e, 0xbd, 0x25, 0xdd, 0xf2, 0x4d, 0xe3,
-	0x1a, 0x5a, 0xd3, 0xed, 0x33, 0x38, 0x01, 0xdd, 0xf7, 0x3a, 0x12, 0x66, 0xfb, 0x87, 0xb3, 0x53,
-	0x44, 0xd4, 0x58, 0x50, 0xf4, 0xe8, 0x5e, 0x65, 0x6f, 0xcd, 0x74, 0x27, 0x3c, 0x52, 0xf1, 0x30,
-	0x8f, 0x09, 0x0f, 0x41, 0xcb, 0x14, 0x8b, 0x05, 0xbd, 0x20, 0x22, 0x44, 0xcb, 0xdd, 0xea, 0x4e,
-	0xa3, 0xb7, 0xee, 0x98, 0x58, 0x4e, 0x36, 0x23, 0x1c, 0x3b, 0x23, 0x9c, 0x01, 0x4f, 0x58, 0xbf,
-	0x96, 0xe5, 0xf7, 0x9a, 0x86, 0xf2, 0x34, 0xf4, 0x79, 0xed, 0x8f, 0xff, 0xba, 0x95, 0xa7, 0x7f,
-	0xd5, 0x41, 0xf3, 0x1b, 0x33, 0xf0, 0x86, 0x8a, 0x28, 0x0a, 0x3f, 0x02, 0xf5, 0x73, 0x3d, 0x47,
-	0xf4, 0xe4, 0x68, 0xf4, 0xa0, 0x33, 0x1d, 0x80, 0x8e, 0x99, 0x30, 0x9e, 0x75, 0x40, 0x07, 0xb4,
-	0x53, 0x22, 0x15, 0xe6, 0xbe, 0xa4, 0x62, 0x42, 0x43, 0xcc, 0x38, 0x0b, 0xa8, 0x9e, 0x24, 0x35,
-	0x6f, 0x39, 0x93, 0x8e, 0xad, 0xf2, 0x43, 0x26, 0xc0, 0xe7, 0x60, 0xce, 0x76, 0x19, 0x3d, 0xe8,
-	0x3e, 0x98, 0x0d, 0x6e, 0x9a, 0xeb, 0xe5, 0x16, 0x78, 0x04, 0x96, 0xec, 0xcf, 0x0c, 0x38, 0x3b,
-	0x4d, 0xc4, 0x28, 0x1b, 0x37, 0x19, 0xb5, 0x55, 0xa6, 0x5e, 0x49, 0xbb, 0x2b, 0x03, 0x63, 0xf2,
-	0x16, 0x27, 0xe5, 0x4f, 0x09, 0x3f, 0x01, 0x73, 0x76, 0x44, 0xa0, 0x87, 0x1a, 0xdf, 0x2c, 0xe3,
-	0xc7, 0x63, 0x15, 0xf1, 0x84, 0x45, 0x27, 0x97, 0xfa, 0x0c, 0x7a, 0xb9, 0x17, 0xbe, 0x00, 0x8b,
-	0xe6, 0xaa, 0x17, 0xc9, 0xeb, 0xef, 0xd3, 0xaf, 0x64, 0x64, 0xf3, 0x68, 0xda, 0xf6, 0xb9, 0xa5,
-	0xc1, 0xa2, 0x80, 0x2f, 0x41, 0xa3, 0x34, 0x6f, 0xd0, 0x9c, 0x0e, 0xf3, 0xe4, 0xae, 0x22, 0x8a,
-	0xf3, 0xe9, 0x81, 0xb4, 0x98, 0x40, 0xf0, 0x27, 0xd0, 0x9e, 0xf2, 0xd3, 0x72, 0xe6, 0x75, 0x9c,
-	0xed, 0xbb, 0xcb, 0x29, 0x22, 0xd9, 0x92, 0x96, 0x8b, 0x78, 0x45, 0x59, 0x07, 0xa0, 0x59, 0x7a,
-	0x66, 0x24, 0x5a, 0xd0, 0xf1, 0x1e, 0x97, 0xe3, 0x1d, 0x4c, 0xf5, 0xfc, 0x08, 0x95, 0x11, 0xf8,
-	0x2d, 0x68, 0x85, 0x34, 0xa5, 0x11, 0x51, 0x14, 0x9f, 0xd1, 0x2b, 0x89, 0x80, 0x8e, 0xf1, 0xe1,
-	0x4c, 0x4d, 0x43, 0xaa, 0x8e, 0x45, 0xd6, 0x54, 0x25, 0x88, 0xe2, 0xc2, 0x3e, 0x0f, 0x5e, 0x33,
-	0x67, 0xbf, 0xa3, 0x57, 0x12, 0x7e, 0x05, 0x96, 0xa8, 0x08, 0x7a, 0x7b, 0x58, 0x71, 0x1c, 0x52,
-	0xc6, 0x47, 0x12, 0x35, 0x74, 0x34, 0x54, 0x8e, 0x76, 0xe4, 0x0d, 0x7a, 0x7b, 0x27, 0xfc, 0x30,
-	0x33, 0x78, 0x2d, 0x0d, 0xd8, 0x2f, 0x09, 0x8f, 0x41, 0x7b, 0xcc, 0xcc, 0xf6, 0x85, 0x58, 0x09,
-	0xc2, 0xe4, 0x29, 0x15, 0x12, 0x35, 0x75, 0x94, 0xce, 0x9d, 0x9b, 0x6e, 0x4d, 0x27, 0x97, 0x1e,
-	0x2c, 0xd0, 0x7c, 0x51, 0xf6, 0x7f, 0x7b, 0x7d, 0xdd, 0xa9, 0xbe, 0xb9, 0xee, 0x54, 0xff, 0xbf,
-	0xee, 0x54, 0xff, 0xbc, 0xe9, 0x54, 0xde, 0xdc, 0x74, 0x2a, 0xff, 0xdc, 0x74, 0x2a, 0xbf, 0xf6,
-	0x4b, 0xf7, 0x98, 0xa4, 0x2a, 0xa6, 0x64, 0x97, 0x51, 0x95, 0xdf, 0x65, 0x9b, 0x69, 0xd7, 0xbc,
-	0x72, 0xee, 0x88, 0x87, 0xe3, 0x94, 0xba, 0x97, 0x6e, 0xfe, 0xc0, 0xeb, 0x7b, 0xee, 0xd7, 0xf5,
-	0xc3, 0xfd, 0xf1, 0xbb, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0x7b, 0x92, 0x4a, 0x7b, 0x08, 0x00,
-	0x00,
+	// 1959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x72, 0x1b, 0xb7,
+	0x15, 0x36, 0x6b, 0x45, 0xb6, 0x20, 0x4a, 0xb2, 0xa0, 0x1f, 0x83, 0xfa, 0xa1, 0x18, 0xa7, 0x71,
+	0x35, 0x6d, 0x4c, 0xca, 0xca, 0xb4, 0x9d, 0x64, 0xa6, 0xae, 0x45, 0x52, 0xad, 0x95, 0xc6, 0x95,
+	0x66, 0x29, 0x27, 0x33, 0x99, 0x4e, 0x51, 0x70, 0x17, 0xdc, 0xdd, 0x6a, 0x09, 0xb0, 0x0b, 0x90,
+	0xa2, 0x7a, 0xd5, 0x47, 0xe8, 0x65, 0x1f, 0xa1, 0x0f, 0xd0, 0x87, 0xc8, 0x65, 0x2e, 0x3b, 0x9d,
+	0x4e, 0xda, 0xb1, 0x5f, 0xa4, 0x83, 0x03, 0xec, 0x72, 0x49, 0xca, 0xbd, 0xd0, 0x95, 0x97, 0xf8,
+	0xbe, 0xef, 0x1c, 0xe0, 0xe0, 0xe0, 0x9c, 0x23, 0x23, 0x12, 0xa6, 0x6c, 0x14, 0xeb, 0x9b, 0xc6,
+	0xe8, 0x79, 0x23, 0xe4, 0x82, 0xab, 0x58, 0xd5, 0x07, 0xa9, 0xd4, 0x12, 0x23, 0x87, 0xd4, 0x47,
+	0xcf, 0x77, 0x36, 0x43, 0x19, 0x4a, 0x58, 0x6e, 0x98, 0x2f, 0xcb, 0xd8, 0xd9, 0x2e, 0x68, 0xf5,
+	0xcd, 0x80, 0x3b, 0xe5, 0x4e, 0xa5, 0xb0, 0x3e, 0x48, 0xe5, 0x40, 0x2a, 0x96, 0x38, 0x68, 0xab,
+	0x00, 0xf5, 0x55, 0xa8, 0x6e, 0xb1, 0xd4, 0x65, 0xda, 0x8f, 0xdc, 0xfa, 0x5e, 0x61, 0x9d, 0x69,
+	0xcd, 0x95, 0x66, 0x3a, 0x96, 0xc2, 0xa1, 0x55, 0x5f, 0xaa, 0xbe, 0x54, 0x8d, 0x2e, 0x53, 0xbc,
+	0x31, 0x7a, 0xde, 0xe5, 0x9a, 0x3d, 0x6f, 0xf8, 0x32, 0xce, 0xf1, 0x50, 0xca, 0x30, 0xe1, 0x0d,
+	0xf8, 0xd5, 0x1d, 0xf6, 0x1a, 0xc1, 0x30, 0x2d, 0xe8, 0x9f, 0xfc, 0xfd, 0x11, 0x5a, 0xbc, 0x60,
+	0x29, 0xeb, 0x2b, 0xbc, 0x8f, 0xb2, 0xe3, 0xd2, 0x38, 0x20, 0xa5, 0x5a, 0xe9, 0x70, 0xc9, 0x5b,
+	0x72, 0x2b, 0x67, 0x01, 0x3e, 0x42, 0x9b, 0xbe, 0x14, 0x3a, 0x65, 0xbe, 0xa6, 0x4a, 0x0e, 0x53,
+	0x9f, 0xd3, 0x88, 0xa9, 0x88, 0xfc, 0x00, 0x88, 0x38, 0xc3, 0x3a, 0x00, 0xbd, 0x62, 0x2a, 0xc2,
+	0x3f, 0x43, 0x8f, 0xbb, 0x69, 0x1c, 0x84, 0x9c, 0x72, 0x1d, 0xf1, 0x94, 0x0f, 0xfb, 0x94, 0x05,
+	0x41, 0xca, 0x95, 0x22, 0x0b, 0x20, 0xda, 0xb2, 0xf0, 0xa9, 0x43, 0x4f, 0x2c, 0x88, 0x9f, 0xa2,
+	0x35, 0xa7, 0xf3, 0x23, 0x16, 0x0b, 0xb3, 0x9b, 0x0f, 0x6a, 0xa5, 0xc3, 0x05, 0x6f, 0xc5, 0x2e,
+	0xb7, 0xcc, 0xea, 0x59, 0x80, 0x8f, 0xd1, 0x96, 0x8a, 0x43, 0xc1, 0x03, 0x3a, 0x62, 0x89, 0xe2,
+	0x5a, 0xd1, 0xeb, 0x58, 0x04, 0xf2, 0x9a, 0x2c, 0x02, 0x7b, 0xc3, 0x82, 0x5f, 0x59, 0xec, 0x6b,
+	0x80, 0x0a, 0x1a, 0x88, 0x31, 0xcf, 0x35, 0x0f, 0x8a, 0x9a, 0xa6, 0xc5, 0x9c, 0xe6, 0x33, 0x54,
+	0x71, 0x9a, 0x44, 0x86, 0xb1, 0x4f, 0x7d, 0x96, 0x24, 0xb9, 0xee, 0x21, 0xe8, 0xb6, 0x2d, 0xe1,
+	0x4b, 0x83, 0xb7, 0x0c, 0xec, 0xa4, 0x47, 0x68, 0x53, 0xb3, 0x34, 0xe4, 0xda, 0xba, 0xa3, 0x3a,
+	0xee, 0x73, 0x39, 0xd4, 0x64, 0x09, 0x54, 0xd8, 0x62, 0xe0, 0xed, 0xd2, 0x22, 0xf8, 0x13, 0x84,
+	0xd9, 0x88, 0xa7, 0x2c, 0xe4, 0xb4, 0x9b, 0x48, 0xff, 0x0a, 0x24, 0x04, 0x01, 0xff, 0x91, 0x43,
+	0x9a, 0x06, 0x30, 0x02, 0xfc, 0x0b, 0xb4, 0x9b, 0xb1, 0xf3, 0x18, 0x17, 0x64, 0xcb, 0x20, 0x23,
+	0x8e, 0x92, 0xc5, 0x79, 0x22, 0xef, 0xa2, 0x2d, 0x95, 0x30, 0x15, 0xd1, 0x9e, 0xb9, 0xba, 0x58,
+	0x0a, 0x17, 0x49, 0x52, 0xae, 0x95, 0x0e, 0xcb, 0xcd, 0xfa, 0xb7, 0xdf, 0x1f, 0xdc, 0xfb, 0xd7,
+	0xf7, 0x07, 0x4f, 0xc3, 0x58, 0x47, 0xc3, 0x6e, 0xdd, 0x97, 0xfd, 0x86, 0xcb, 0x37, 0xfb, 0xcf,
+	0x33, 0x15, 0x5c, 0xb9, 0xb4, 0x6f, 0x73, 0xdf, 0xdb, 0x00, 0x63, 0xbf, 0x72, 0xb6, 0x6c, 0xe0,
+	0xf1, 0x1f, 0xd0, 0xe6, 0x8c, 0x0f, 0x08, 0x05, 0x59, 0xb9, 0x93, 0x0b, 0x3c, 0xe5, 0x02, 0x22,
+	0x87, 0x63, 0x54, 0x99, 0xf1, 0x30, 0xb9, 0x27, 0xb2, 0x7a, 0x27, 0x37, 0xdb, 0x53, 0x6e, 0xf2,
+	0x6b, 0xc5, 0x2d, 0x54, 0x1d, 0x8a, 0xae, 0x14, 0x01, 0x05, 0x42, 0x2c, 0xc2, 0xd9, 0xdc, 0x5b,
+	0x83, 0x90, 0xef, 0x5a, 0x56, 0xc7, 0x91, 0xa6, 0x73, 0x70, 0x84, 0x6a, 0x73, 0x11, 0x09, 0xcc,
+	0xfd, 0x51, 0x93, 0x45, 0x4c, 0x0f, 0x53, 0x4e, 0x1e, 0xdd, 0x69, 0xdb, 0x7b, 0x33, 0xd1, 0x09,
+	0x4e, 0x75, 0xd4, 0xc9, 0x6c, 0xe2, 0x36, 0x5a, 0xb1, 0x9b, 0xa5, 0x29, 0xbf, 0x66, 0x69, 0x40,
+	0xd6, 0x6b, 0xa5, 0xc3, 0xe5, 0xe3, 0x4a, 0xdd, 0xda, 0xaa, 0x9b, 0x1a, 0x52, 0x77, 0x35, 0xa4,
+	0xde, 0x92, 0xb1, 0x68, 0x2e, 0x18, 0xff, 0x5e, 0xd9, 0xaa, 0x3c, 0x10, 0xe1, 0x97, 0x68, 0xaf,
+	0x50, 0x86, 0x68, 0xca, 0x35, 0x17, 0xf6, 0x10, 0x26, 0xad, 0x14, 0xc1, 0x10, 0x80, 0x9d, 0x02,
+	0xc7, 0xcb, 0x28, 0x90, 0x78, 0xca, 0xbc, 0x41, 0xf7, 0xbe, 0xcd, 0x79, 0x4d, 0x0c, 0x5d, 0xec,
+	0x36, 0xec, 0x1b, 0xb4, 0x60, 0xc7, 0x62, 0x2e, 0x66, 0x9f, 0x23, 0x53, 0x51, 0x83, 0x21, 0xd3,
+	0xe6, 0xe9, 0x3a, 0xb5, 0x8b, 0x2e, 0xd9, 0xac, 0x95, 0x0e, 0x1f, 0x7a, 0x8f, 0x73, 0x42, 0xd3,
+	0x1a, 0x70, 0x30, 0x66, 0x68, 0xab, 0x1f, 0x0b, 0xea, 0xde, 0xf0, 0x80, 0xa7, 0x99, 0xbf, 0xad,
+	0xbb, 0xa5, 0x60, 0x3f, 0x16, 0x1d, 0xb0, 0x75, 0xc1, 0x53, 0xb7, 0xbd, 0x37, 0x68, 0xd3, 0x6d,
+	0xea, 0x8f, 0x2c, 0x4e, 0x68, 0x56, 0x64, 0xc9, 0xb6, 0x8b, 0xb0, 0xad, 0xc2, 0xf5, 0xac, 0x0a,
+	0xd7, 0xdb, 0x8e, 0xd0, 0x7c, 0x68, 0x9c, 0xff, 0xed, 0x3f, 0x07, 0x25, 0x0f, 0x5b, 0x03, 0x5f,
+	0xb0, 0x38, 0xc9, 0x50, 0xac, 0x50, 0x75, 0x36, 0x53, 0xac, 0x97, 0x40, 0x5e, 0x0b, 0x78, 0xe1,
+	0x8f, 0xef, 0x74, 0x84, 0xdd, 0xe9, 0x3c, 0x01, 0x9b, 0x6d, 0x67, 0x12, 0xf7, 0xd1, 0xae, 0x4b,
+	0x93, 0x81, 0xbc, 0xe6, 0x29, 0x0d, 0xe2, 0x5e, 0x8f, 0xea, 0x28, 0xe5, 0x2a, 0x92, 0x49, 0x40,
+	0xc8, 0x9d, 0x3c, 0x12, 0x6b, 0xf2, 0xc2, 0x58, 0x6c, 0xc7, 0xbd, 0xde, 0x65, 0x66, 0x0f, 0xff,
+	0x10, 0xad, 0x3a, 0x77, 0x7d, 0x36, 0xa6, 0x2c, 0xe4, 0xa4, 0x02, 0x69, 0xe0, 0xb2, 0xee, 0x35,
+	0x1b, 0x9f, 0x84, 0x1c, 0xd7, 0xd1, 0x46, 0xc6, 0x32, 0x1d, 0x41, 0x68, 0x9e, 0x8e, 0x58, 0x42,
+	0x76, 0x80, 0xba, 0xee, 0xa8, 0xb1, 0x38, 0x73, 0x00, 0x7e, 0x81, 0xf6, 0x1c, 0x5f, 0x0a, 0x78,
+	0x5a, 0xae, 0xf3, 0x98, 0x96, 0x22, 0x42, 0x4e, 0x76, 0x21, 0x65, 0xdc, 0xae, 0xce, 0xc5, 0xa9,
+	0x8e, 0x5c, 0xf7, 0x69, 0x01, 0x6e, 0x72, 0xd4, 0x6c, 0xc7, 0x85, 0x7b, 0xc4, 0x92, 0x38, 0x60,
+	0x5a, 0xa6, 0x8a, 0xec, 0xd9, 0x1c, 0xed, 0xb3, 0xb1, 0x0d, 0xdb, 0x57, 0x39, 0x84, 0x7d, 0xb4,
+	0x6d, 0x36, 0xe7, 0x34, 0x36, 0x78, 0x2a, 0x62, 0x29, 0x27, 0xfb, 0x77, 0x2b, 0xa7, 0xfd, 0xd8,
+	0xdd, 0x0d, 0x84, 0xad, 0x63, 0x4c, 0xe1, 0x33, 0xf4, 0x61, 0xca, 0xff, 0x34, 0x8c, 0x53, 0x4e,
+	0x79, 0xea, 0x1f, 0x1f, 0xd1, 0x80, 0x0f, 0x12, 0x79, 0xd3, 0xe7, 0x42, 0x53, 0x36, 0x18, 0xa4,
+	0xd2, 0x84, 0xa5, 0x0a, 0xa7, 0xab, 0x3a, 0xe2, 0xa9, 0xe1, 0xb5, 0x73, 0xda, 0x89, 0x63, 0xe1,
+	0x1f, 0xa1, 0x35, 0x3e, 0xd6, 0x5c, 0x04, 0x3c, 0xa0, 0x7e, 0xc2, 0xe2, 0xbe, 0x22, 0x07, 0x20,
+	0x5c, 0xcd, 0x96, 0x5b, 0xb0, 0xfa, 0xf9, 0xc2, 0x5f, 0xfe, 0x5d, 0xbb, 0xf7, 0xe4, 0x1f, 0x18,
+	0x95, 0x7f, 0x6d, 0xc7, 0xa3, 0x8e, 0x66, 0x9a, 0xe3, 0x1f, 0xa3, 0xc5, 0x01, 0x8c, 0x0e, 0x30,
+	0x2c, 0x2c, 0x1f, 0xe3, 0xfa, 0x64, 0x5c, 0xaa, 0xdb, 0xa1, 0xc2, 0x73, 0x0c, 0x73, 0x7f, 0x09,
+	0x53, 0x9a, 0xca, 0xae, 0xe2, 0xe9, 0x88, 0x07, 0x54, 0x48, 0xe1, 0x73, 0x18, 0x1e, 0x16, 0xbc,
+	0x75, 0x03, 0x9d, 0x3b, 0xe4, 0xb7, 0x06, 0xc0, 0x9f, 0xa0, 0x07, 0xae, 0xb0, 0x92, 0xfb, 0xb5,
+	0xfb, 0xb3, 0xc6, 0x6d, 0x3d, 0xf5, 0x32, 0x0a, 0x3e, 0x45, 0x6b, 0xf6, 0x93, 0xfa, 0x52, 0xf4,
+	0xe2, 0xb4, 0x6f, 0x26, 0x0c, 0xa3, 0xda, 0x2b, 0xaa, 0x5e, 0x2b, 0x57, 0x88, 0x5b, 0x96, 0xe4,
+	0xad, 0x8e, 0x8a, 0x3f, 0x15, 0xfe, 0x29, 0x7a, 0xe0, 0xa6, 0x02, 0xf2, 0x01, 0xc8, 0x77, 0x8b,
+	0xf2, 0xf3, 0xa1, 0x0e, 0x65, 0x2c, 0xc2, 0xcb, 0x31, 0xb4, 0x1d, 0x2f, 0xe3, 0xe2, 0x57, 0x68,
+	0x15, 0x3e, 0x27, 0xce, 0x17, 0xe7, 0xd5, 0xaf, 0x55, 0xe8, 0xfc, 0x80, 0xda, 0x95, 0xd6, 0x15,
+	0x10, 0xe6, 0x1b, 0x78, 0x81, 0x96, 0x0b, 0x23, 0x06, 0x79, 0x00, 0x66, 0xf6, 0x6f, 0xdb, 0x44,
+	0xde, 0x92, 0x3c, 0x94, 0x64, 0x9f, 0x0a, 0xbf, 0x41, 0x1b, 0x13, 0xfd, 0x64, 0x3b, 0x0f, 0xc1,
+	0xce, 0xc1, 0xed, 0xdb, 0xc9, 0x2d, 0xb9, 0x2d, 0xad, 0xe7, 0xf6, 0xf2, 0x6d, 0x9d, 0xa0, 0x72,
+	0xa1, 0x9c, 0x2b, 0xb2, 0x04, 0xf6, 0x1e, 0x17, 0xed, 0x9d, 0x4c, 0xf0, 0xac, 0x6b, 0x14, 0x25,
+	0xf8, 0x0b, 0xb4, 0x12, 0xf0, 0x84, 0x87, 0x4c, 0x73, 0x7a, 0xc5, 0x6f, 0x14, 0x41, 0x60, 0xe3,
+	0xe3, 0x99, 0x3d, 0x75, 0xb8, 0x3e, 0x4f, 0x4d, 0x50, 0x75, 0x6a, 0xde, 0x94, 0x7b, 0x93, 0x5e,
+	0x39, 0xd3, 0xfe, 0x86, 0xdf, 0x28, 0xfc, 0x12, 0xad, 0xd9, 0xd4, 0xd7, 0x92, 0x06, 0x5c, 0xc8,
+	0xbe, 0x22, 0xcb, 0x60, 0x8d, 0x14, 0xad, 0x9d, 0x7a, 0xad, 0xe3, 0xa3, 0x4b, 0xd9, 0x36, 0x04,
+	0x6f, 0x05, 0x04, 0xee, 0x97, 0xc2, 0xe7, 0x68, 0x63, 0x28, 0xec, 0xf5, 0x05, 0x54, 0xa7, 0x4c,
+	0xa8, 0x1e, 0x4f, 0x15, 0x29, 0x83, 0x95, 0xea, 0xad, 0x97, 0xee, 0x48, 0x97, 0x63, 0x0f, 0xe7,
+	0xd2, 0x6c, 0x51, 0xe1, 0x36, 0xda, 0x9c, 0x4e, 0x6f, 0x37, 0x47, 0xad, 0xcc, 0x3f, 0x0c, 0x97,
+	0xbb, 0xb8, 0x98, 0xf3, 0x76, 0x0d, 0x6b, 0xb4, 0x3f, 0x6d, 0x25, 0x9f, 0xe9, 0x22, 0x1e, 0x87,
+	0x91, 0x86, 0x61, 0x66, 0xf9, 0xf8, 0x27, 0x45, 0x73, 0x5f, 0x16, 0xcc, 0x4c, 0x0d, 0x78, 0xaf,
+	0x40, 0xe2, 0x2e, 0x63, 0x27, 0xb9, 0x85, 0x66, 0x19, 0xf8, 0x6b, 0x04, 0xef, 0x8f, 0xf2, 0x91,
+	0xa9, 0x21, 0xf0, 0x2e, 0x15, 0x59, 0x9b, 0xbf, 0x1e, 0xe3, 0xe9, 0xd4, 0x70, 0xe0, 0x85, 0x36,
+	0x6f, 0xf2, 0xca, 0xe7, 0x7c, 0xac, 0x25, 0x53, 0x04, 0x65, 0x86, 0xa5, 0x01, 0x18, 0x2e, 0x4e,
+	0x36, 0xd4, 0x8f, 0xb8, 0x7f, 0x35, 0x90, 0xb1, 0xd0, 0x8a, 0x3c, 0xaa, 0xdd, 0x3f, 0x2c, 0x7b,
+	0xbb, 0x86, 0x55, 0x9c, 0x54, 0x5a, 0x13, 0x8a, 0x2d, 0x1c, 0x26, 0x91, 0x5c, 0x48, 0x5d, 0xe1,
+	0x58, 0xcf, 0x0a, 0x87, 0x81, 0x6c, 0xf8, 0x6c, 0xe1, 0xf8, 0x0c, 0x55, 0xe0, 0x34, 0xd0, 0xe1,
+	0x78, 0x30, 0xad, 0xb2, 0xb3, 0xc9, 0xb6, 0x21, 0x74, 0x2c, 0x5e, 0x94, 0xfe, 0x1c, 0x91, 0x29,
+	0xa9, 0x7d, 0xd4, 0x30, 0xd6, 0xb8, 0xd1, 0x64, 0xab, 0xa0, 0xb4, 0xcf, 0xd8, 0x80, 0xf8, 0x25,
+	0xda, 0x9f, 0x12, 0x16, 0xde, 0xa0, 0x55, 0x6f, 0x82, 0xba, 0x52, 0x50, 0x4f, 0x5e, 0x1d, 0x58,
+	0x78, 0x81, 0xf6, 0xc0, 0xc2, 0x50, 0x50, 0x33, 0x37, 0x9a, 0x99, 0x08, 0x94, 0xd9, 0xc5, 0x6f,
+	0xd9, 0x41, 0xde, 0x70, 0xde, 0x88, 0xa6, 0x65, 0x14, 0x6e, 0x19, 0x7f, 0x8c, 0xd6, 0x04, 0x1f,
+	0x6b, 0xaa, 0xc7, 0x74, 0x20, 0x65, 0x62, 0xfe, 0x64, 0xda, 0xb6, 0x5d, 0xd4, 0x2c, 0x5f, 0x8e,
+	0x2f, 0xa4, 0x4c, 0xce, 0x02, 0xfc, 0x29, 0xda, 0x06, 0x9a, 0x74, 0x59, 0xed, 0x8e, 0x18, 0x07,
+	0x30, 0x47, 0x2c, 0x78, 0x1b, 0x06, 0xcd, 0x52, 0x1e, 0x0e, 0x78, 0x16, 0xe0, 0x3f, 0xa3, 0x8f,
+	0xfe, 0xef, 0x35, 0xd2, 0x58, 0xf4, 0xa4, 0x22, 0x04, 0x32, 0xe6, 0xe9, 0x74, 0x0f, 0x78, 0xdf,
+	0xbd, 0xba, 0x94, 0x39, 0x78, 0xff, 0xcd, 0x9f, 0x19, 0xa3, 0xf8, 0xf7, 0xf9, 0x5c, 0x95, 0x8d,
+	0x8a, 0xd6, 0x59, 0x65, 0xde, 0xd9, 0x24, 0x1d, 0x8b, 0xe3, 0xa3, 0x31, 0xe3, 0x9c, 0xe1, 0xee,
+	0x2c, 0xa0, 0x70, 0x13, 0x55, 0x93, 0xec, 0x6c, 0xd3, 0x13, 0x42, 0x16, 0x79, 0x3b, 0x61, 0xc0,
+	0xfb, 0x99, 0x1d, 0x12, 0x5c, 0xec, 0x5b, 0x68, 0x25, 0xe5, 0x09, 0xbb, 0x31, 0xdd, 0x5e, 0x33,
+	0xad, 0xc8, 0xee, 0x7c, 0x31, 0xf2, 0x2c, 0xc1, 0xf4, 0x4d, 0x95, 0xd5, 0xc7, 0xb4, 0xb0, 0x86,
+	0x43, 0xb4, 0xf3, 0xde, 0x76, 0x6e, 0x86, 0x0e, 0x63, 0xf1, 0xa3, 0xb9, 0xf2, 0x36, 0xdf, 0xd4,
+	0x9d, 0x71, 0xc2, 0x6f, 0xef, 0xf9, 0xa6, 0x78, 0x96, 0xb5, 0xbc, 0xe2, 0x82, 0x0e, 0x64, 0x12,
+	0xfb, 0x37, 0x30, 0x9a, 0xcc, 0xd4, 0xf2, 0x4b, 0x83, 0x5f, 0x00, 0xec, 0xcc, 0x2d, 0xeb, 0xc9,
+	0x12, 0x6e, 0xa2, 0x95, 0x88, 0x27, 0x81, 0xd9, 0xa9, 0x54, 0xb1, 0x56, 0xa4, 0x3a, 0xdf, 0x0e,
+	0x5e, 0xf1, 0x24, 0x68, 0x5b, 0x3c, 0x3b, 0x6e, 0x34, 0x59, 0x52, 0xb8, 0x81, 0x36, 0x21, 0x11,
+	0x8b, 0x86, 0x4c, 0x1a, 0x1e, 0xd8, 0x67, 0x6d, 0xb0, 0x82, 0x89, 0xb3, 0x00, 0x9f, 0xa2, 0x55,
+	0xbb, 0x6d, 0xe5, 0xb3, 0x24, 0x16, 0xa1, 0x22, 0xb5, 0xf9, 0x28, 0xc3, 0xc6, 0x3b, 0x96, 0x90,
+	0x35, 0x58, 0x5d, 0x58, 0x53, 0xf8, 0x08, 0x2d, 0x04, 0x43, 0xa5, 0xc9, 0x87, 0x20, 0xde, 0xbe,
+	0xa5, 0x5f, 0x5c, 0xf1, 0xac, 0x81, 0x01, 0x13, 0xff, 0x12, 0x95, 0xa1, 0xc7, 0x98, 0x36, 0x61,
+	0x0a, 0xe3, 0x93, 0x79, 0x25, 0x34, 0x95, 0x4b, 0x03, 0x67, 0xe1, 0x0a, 0xf2, 0x15, 0xf5, 0xe4,
+	0x1b, 0x54, 0x79, 0x6f, 0xe5, 0xc4, 0x7b, 0x68, 0x29, 0x9f, 0x2d, 0xb3, 0xff, 0x72, 0xc9, 0x17,
+	0xf0, 0x01, 0x5a, 0x2e, 0x14, 0x65, 0x37, 0x2c, 0x21, 0x3e, 0xb1, 0xf4, 0xbb, 0x6f, 0xdf, 0x56,
+	0x4b, 0xdf, 0xbd, 0xad, 0x96, 0xfe, 0xfb, 0xb6, 0x5a, 0xfa, 0xeb, 0xbb, 0xea, 0xbd, 0xef, 0xde,
+	0x55, 0xef, 0xfd, 0xf3, 0x5d, 0xf5, 0xde, 0x37, 0xcd, 0xc2, 0x8c, 0xc9, 0x12, 0x1d, 0x71, 0xf6,
+	0x4c, 0x70, 0x9d, 0xcd, 0x99, 0x6e, 0xf3, 0xcf, 0xec, 0x8b, 0x68, 0xf4, 0x65, 0x30, 0x4c, 0x78,
+	0x63, 0xdc, 0x70, 0xeb, 0x76, 0x06, 0xed, 0x2e, 0xc2, 0x9f, 0x2b, 0x9f, 0xfe, 0x6f, 0x00, 0x9e,
+	0xad, 0x66, 0x28, 0x20, 0x13, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -408,22 +855,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.ExtendedClaims {
+		i--
+		if m.ExtendedClaims {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.RequireErc20DeploymentApproval {
+		i--
+		if m.RequireErc20DeploymentApproval {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
 	{
-		size := m.SlashFractionBadEthSignature.Size()
+		size := m.MinBridgePowerShare.Size()
 		i -= size
-		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinBridgePowerShare.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
@@ -431,44 +890,173 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x82
-	if m.UnbondSlashingValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+	dAtA[i] = 0xea
+	if m.MaxBridgeValidators != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBridgeValidators))
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.ValsetOnEthAddressChange {
+		i--
+		if m.ValsetOnEthAddressChange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.ValsetMinInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetMinInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.ValsetMaxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetMaxAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
 	{
-		size := m.SlashFractionLogicCall.Size()
+		size := m.ValsetPowerDiffThreshold.Size()
 		i -= size
-		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ValsetPowerDiffThreshold.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x72
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
 	{
-		size := m.SlashFractionBatch.Size()
+		size := m.SlashFractionBridgeDowntime.Size()
 		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SlashFractionBridgeDowntime.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x6a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BridgeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BridgeJailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
 	{
-		size := m.SlashFractionValset.Size()
+		size := m.MinSignedPerWindow.Size()
 		i -= size
-		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x62
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.GraduatedBridgeSlashing {
+		i--
+		if m.GraduatedBridgeSlashing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.BridgeSigningWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeSigningWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.AttestationRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.SlashFractionBadEthSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.UnbondSlashingValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.SlashFractionLogicCall.Size()
+		i -= size
+		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
 		i--
 		dAtA[i] = 0x58
 	}
@@ -546,6 +1134,256 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomTraces) > 0 {
+		for iNdEx := len(m.DenomTraces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTraces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TokenScalings) > 0 {
+		for iNdEx := len(m.TokenScalings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenScalings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.NextHeldDepositId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextHeldDepositId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.HeldDeposits) > 0 {
+		for iNdEx := len(m.HeldDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	{
+		size, err := m.TokenPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if len(m.Erc20DeploymentApprovals) > 0 {
+		for iNdEx := len(m.Erc20DeploymentApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20DeploymentApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.LastEthAddressChangeHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEthAddressChangeHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.BridgeSigningInfos) > 0 {
+		for iNdEx := len(m.BridgeSigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeSigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.PastEthSignatureCheckpointInfos) > 0 {
+		for iNdEx := len(m.PastEthSignatureCheckpointInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PastEthSignatureCheckpointInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.NextOutgoingBatchId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOutgoingBatchId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.NextTxPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTxPoolId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.LastUnBondingBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnBondingBlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedLogicCallBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.LastSlashedBatchBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedBatchBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedValsetNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LatestValsetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestValsetNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for iNdEx := len(m.PastEthSignatureCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PastEthSignatureCheckpoints[iNdEx])
+			copy(dAtA[i:], m.PastEthSignatureCheckpoints[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PastEthSignatureCheckpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.LastEventNonces) > 0 {
+		for iNdEx := len(m.LastEventNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	{
+		size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.LastObservedValset != nil {
+		{
+			size, err := m.LastObservedValset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.UnbatchedTransfers) > 0 {
 		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +1544,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LastEventNonceByValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastEventNonceByValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastEventNonceByValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -769,7 +1642,44 @@ func (m *Params) Size() (n int) {
 	n += 2 + l + sovGenesis(uint64(l))
 	l = m.ValsetReward.Size()
 	n += 2 + l + sovGenesis(uint64(l))
-	return n
+	if m.AttestationRetentionBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestationRetentionBlocks))
+	}
+	if m.BridgeSigningWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.BridgeSigningWindow))
+	}
+	if m.GraduatedBridgeSlashing {
+		n += 3
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BridgeJailDuration)
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBridgeDowntime.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.ValsetPowerDiffThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ValsetMaxAge != 0 {
+		n += 2 + sovGenesis(uint64(m.ValsetMaxAge))
+	}
+	if m.ValsetMinInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.ValsetMinInterval))
+	}
+	if m.ValsetOnEthAddressChange {
+		n += 3
+	}
+	if m.MaxBridgeValidators != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBridgeValidators))
+	}
+	l = m.MinBridgePowerShare.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.RequireErc20DeploymentApproval {
+		n += 3
+	}
+	if m.ExtendedClaims {
+		n += 3
+	}
+	return n
 }
 
 func (m *GenesisState) Size() (n int) {
@@ -845,6 +1755,117 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastObservedValset != nil {
+		l = m.LastObservedValset.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.LastObservedEthereumHeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LastEventNonces) > 0 {
+		for _, e := range m.LastEventNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for _, b := range m.PastEthSignatureCheckpoints {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LatestValsetNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LatestValsetNonce))
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedValsetNonce))
+	}
+	if m.LastSlashedBatchBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedBatchBlock))
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
+	}
+	if m.LastUnBondingBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastUnBondingBlockHeight))
+	}
+	if m.NextTxPoolId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextTxPoolId))
+	}
+	if m.NextOutgoingBatchId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextOutgoingBatchId))
+	}
+	if len(m.PastEthSignatureCheckpointInfos) > 0 {
+		for _, e := range m.PastEthSignatureCheckpointInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeSigningInfos) > 0 {
+		for _, e := range m.BridgeSigningInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastEthAddressChangeHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastEthAddressChangeHeight))
+	}
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20DeploymentApprovals) > 0 {
+		for _, e := range m.Erc20DeploymentApprovals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TokenPolicy.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.HeldDeposits) > 0 {
+		for _, e := range m.HeldDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextHeldDepositId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextHeldDepositId))
+	}
+	if len(m.TokenScalings) > 0 {
+		for _, e := range m.TokenScalings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTraces) > 0 {
+		for _, e := range m.DenomTraces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LastEventNonceByValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
 	return n
 }
 
@@ -1182,7 +2203,1105 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
 			}
-			var byteLen int
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			}
+			m.UnbondSlashingValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRetentionBlocks", wireType)
+			}
+			m.AttestationRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSigningWindow", wireType)
+			}
+			m.BridgeSigningWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeSigningWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraduatedBridgeSlashing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GraduatedBridgeSlashing = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BridgeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBridgeDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBridgeDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetPowerDiffThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetPowerDiffThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMaxAge", wireType)
+			}
+			m.ValsetMaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetMaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMinInterval", wireType)
+			}
+			m.ValsetMinInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetMinInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetOnEthAddressChange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValsetOnEthAddressChange = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBridgeValidators", wireType)
+			}
+			m.MaxBridgeValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBridgeValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBridgePowerShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBridgePowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireErc20DeploymentApproval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireErc20DeploymentApproval = bool(v != 0)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedClaims", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExtendedClaims = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+			}
+			m.LastObservedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, &Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetConfirms = append(m.ValsetConfirms, &MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, &OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCalls = append(m.LogicCalls, &OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, &OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedValset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedValset == nil {
+				m.LastObservedValset = &Valset{}
+			}
+			if err := m.LastObservedValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventNonces = append(m.LastEventNonces, LastEventNonceByValidator{})
+			if err := m.LastEventNonces[len(m.LastEventNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthSignatureCheckpoints", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PastEthSignatureCheckpoints = append(m.PastEthSignatureCheckpoints, make([]byte, postIndex-iNdEx))
+			copy(m.PastEthSignatureCheckpoints[len(m.PastEthSignatureCheckpoints)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestValsetNonce", wireType)
+			}
+			m.LatestValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedValsetNonce", wireType)
+			}
+			m.LastSlashedValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedBatchBlock", wireType)
+			}
+			m.LastSlashedBatchBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedBatchBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedLogicCallBlock", wireType)
+			}
+			m.LastSlashedLogicCallBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1192,30 +3311,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.LastSlashedLogicCallBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnBondingBlockHeight", wireType)
 			}
-			m.UnbondSlashingValsetsWindow = 0
+			m.LastUnBondingBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1225,16 +3330,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				m.LastUnBondingBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTxPoolId", wireType)
 			}
-			var byteLen int
+			m.NextTxPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1244,28 +3349,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.NextTxPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOutgoingBatchId", wireType)
 			}
-			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextOutgoingBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOutgoingBatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 17:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthSignatureCheckpointInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1292,66 +3402,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PastEthSignatureCheckpointInfos = append(m.PastEthSignatureCheckpointInfos, PastEthSignatureCheckpoint{})
+			if err := m.PastEthSignatureCheckpointInfos[len(m.PastEthSignatureCheckpointInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSigningInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1378,18 +3436,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgeSigningInfos = append(m.BridgeSigningInfos, ValidatorBridgeSigningInfo{})
+			if err := m.BridgeSigningInfos[len(m.BridgeSigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 26:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEthAddressChangeHeight", wireType)
 			}
-			m.LastObservedNonce = 0
+			m.LastEthAddressChangeHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1399,14 +3455,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedNonce |= uint64(b&0x7F) << shift
+				m.LastEthAddressChangeHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1433,14 +3489,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, &Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20DeploymentApprovals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1467,14 +3523,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValsetConfirms = append(m.ValsetConfirms, &MsgValsetConfirm{})
-			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20DeploymentApprovals = append(m.Erc20DeploymentApprovals, ERC20DeploymentApproval{})
+			if err := m.Erc20DeploymentApprovals[len(m.Erc20DeploymentApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1501,14 +3557,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, &OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldDeposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1535,16 +3590,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
-			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HeldDeposits = append(m.HeldDeposits, HeldDeposit{})
+			if err := m.HeldDeposits[len(m.HeldDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeldDepositId", wireType)
 			}
-			var msglen int
+			m.NextHeldDepositId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1554,29 +3609,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextHeldDepositId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LogicCalls = append(m.LogicCalls, &OutgoingLogicCall{})
-			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenScalings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1603,14 +3643,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
-			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenScalings = append(m.TokenScalings, TokenScaling{})
+			if err := m.TokenScalings[len(m.TokenScalings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1637,14 +3677,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Dust = append(m.Dust, ERC20Token{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1671,16 +3711,69 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomTraces = append(m.DenomTraces, DenomTrace{})
+			if err := m.DenomTraces[len(m.DenomTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastEventNonceByValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastEventNonceByValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastEventNonceByValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1690,31 +3783,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var msglen int
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1724,26 +3815,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, &OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
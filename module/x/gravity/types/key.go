@@ -16,6 +16,17 @@ const (
 
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
+
+	// ValsetRewardPoolName is the name of the module account Ethereum originated valset rewards are burned from,
+	// it has to be funded with the vouchers of the reward token for those rewards to be paid
+	ValsetRewardPoolName = "gravity_valset_reward_pool"
+
+	// DustPoolName is the module account deposit dust is credited to once it makes up whole Cosmos units
+	DustPoolName = "gravity_dust_pool"
+
+	// ConsensusVersion is the version of the gravity store layout, it must be bumped
+	// along with every migration registered in keeper.NewMigrator
+	ConsensusVersion = 14
 )
 
 var (
@@ -86,6 +97,9 @@ var (
 	// KeyLastOutgoingBatchID indexes the lastBatchID
 	KeyLastOutgoingBatchID = append(SequenceKeyPrefix, []byte("lastBatchId")...)
 
+	// KeyLastHeldDepositID indexes the lastHeldDepositID
+	KeyLastHeldDepositID = append(SequenceKeyPrefix, []byte("lastHeldDepositId")...)
+
 	// KeyOrchestratorAddress indexes the validator keys for an orchestrator
 	KeyOrchestratorAddress = []byte{0x11}
 
@@ -127,6 +141,48 @@ var (
 
 	// PastEthSignatureCheckpointKey indexes eth signature checkpoints that have existed
 	PastEthSignatureCheckpointKey = []byte{0x1b}
+
+	// StoreVersionKey indexes the version of the store layout, a store without one is at version 1
+	StoreVersionKey = []byte{0x1c}
+
+	// PastEthSignatureCheckpointInfoKey indexes the valset, batch or logic call each past checkpoint was produced from
+	PastEthSignatureCheckpointInfoKey = []byte{0x1d}
+
+	// ValidatorBridgeSigningInfoKey indexes how often each validator missed bridge confirms and claims
+	ValidatorBridgeSigningInfoKey = []byte{0x1e}
+
+	// LastEthAddressChangeHeightKey indexes the last block a validator registered an Ethereum address at
+	LastEthAddressChangeHeightKey = []byte{0x1f}
+
+	// RelayerStatsKey indexes what each Ethereum relayer was observed relaying
+	RelayerStatsKey = []byte{0x21}
+
+	// ERC20DeploymentApprovalKey indexes the denoms governance approved for ERC20 deployments
+	ERC20DeploymentApprovalKey = []byte{0x22}
+
+	// TokenPolicyKey indexes the policy for which Ethereum originated tokens are bridged to Cosmos
+	TokenPolicyKey = []byte{0x23}
+
+	// HeldDepositKey indexes the deposits held by the token policy
+	HeldDepositKey = []byte{0x24}
+
+	// TokenScalingKey indexes the decimal scaling between ERC20s and their Cosmos denoms
+	TokenScalingKey = []byte{0x25}
+
+	// DustKey indexes the ERC20 units of deposits that did not make up a Cosmos unit yet
+	DustKey = []byte{0x26}
+
+	// DenomTraceKey indexes the traces of voucher denoms by denom
+	DenomTraceKey = []byte{0x27}
+
+	// DenomTraceByERC20Key indexes the voucher denoms by the checksummed address of their ERC20
+	DenomTraceByERC20Key = []byte{0x28}
+
+	// ValsetRewardEscrowKey indexes the Ethereum originated reward each valset that can still be relayed escrows
+	ValsetRewardEscrowKey = []byte{0x29}
+
+	// EscrowedValsetRewardsKey indexes the total of the escrowed valset rewards by denom
+	EscrowedValsetRewardsKey = []byte{0x2a}
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -146,8 +202,8 @@ func GetEthAddressByValidatorKey(validator sdk.ValAddress) []byte {
 // GetValidatorByEthAddressKey returns the following key format
 // prefix              cosmos-validator
 // [0xf9][0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B]
-func GetValidatorByEthAddressKey(ethAddress string) []byte {
-	return append(ValidatorByEthAddressKey, []byte(ethAddress)...)
+func GetValidatorByEthAddressKey(ethAddress EthAddress) []byte {
+	return append(ValidatorByEthAddressKey, []byte(ethAddress.GetAddress())...)
 }
 
 // GetValsetKey returns the following key format
@@ -229,8 +285,8 @@ func GetOutgoingTxPoolKey(id uint64) []byte {
 // GetOutgoingTxBatchKey returns the following key format
 // prefix     nonce                     eth-contract-address
 // [0xa][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
-func GetOutgoingTxBatchKey(tokenContract string, nonce uint64) []byte {
-	return append(append(OutgoingTXBatchKey, []byte(tokenContract)...), UInt64Bytes(nonce)...)
+func GetOutgoingTxBatchKey(tokenContract EthAddress, nonce uint64) []byte {
+	return append(append(OutgoingTXBatchKey, []byte(tokenContract.GetAddress())...), UInt64Bytes(nonce)...)
 }
 
 // GetOutgoingTxBatchBlockKey returns the following key format
@@ -244,9 +300,9 @@ func GetOutgoingTxBatchBlockKey(block uint64) []byte {
 // prefix           eth-contract-address                BatchNonce                       Validator-address
 // [0xe1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
 // TODO this should be a sdk.ValAddress
-func GetBatchConfirmKey(tokenContract string, batchNonce uint64, validator sdk.AccAddress) []byte {
+func GetBatchConfirmKey(tokenContract EthAddress, batchNonce uint64, validator sdk.AccAddress) []byte {
 	a := append(UInt64Bytes(batchNonce), validator.Bytes()...)
-	b := append([]byte(tokenContract), a...)
+	b := append([]byte(tokenContract.GetAddress()), a...)
 	c := append(BatchConfirmKey, b...)
 	return c
 }
@@ -254,7 +310,7 @@ func GetBatchConfirmKey(tokenContract string, batchNonce uint64, validator sdk.A
 // GetFeeSecondIndexKey returns the following key format
 // prefix            eth-contract-address            fee_amount
 // [0x9][0xc783df8a850f42e7F7e57013759C285caa701eB6][1000000000]
-func GetFeeSecondIndexKey(fee ERC20Token) []byte {
+func GetFeeSecondIndexKey(tokenContract EthAddress, fee ERC20Token) []byte {
 	r := make([]byte, 1+ETHContractAddressLen+32)
 	// sdkInts have a size limit of 255 bits or 32 bytes
 	// therefore this will never panic and is always safe
@@ -262,8 +318,8 @@ func GetFeeSecondIndexKey(fee ERC20Token) []byte {
 	amount = fee.Amount.BigInt().FillBytes(amount)
 	// TODO this won't ever work fix it
 	copy(r[0:], SecondIndexOutgoingTXFeeKey)
-	copy(r[len(SecondIndexOutgoingTXFeeKey):], []byte(fee.Contract))
-	copy(r[len(SecondIndexOutgoingTXFeeKey)+len(fee.Contract):], amount)
+	copy(r[len(SecondIndexOutgoingTXFeeKey):], []byte(tokenContract.GetAddress()))
+	copy(r[len(SecondIndexOutgoingTXFeeKey)+len(tokenContract.GetAddress()):], amount)
 	return r
 }
 
@@ -279,8 +335,8 @@ func GetDenomToERC20Key(denom string) []byte {
 	return append(DenomToERC20Key, []byte(denom)...)
 }
 
-func GetERC20ToDenomKey(erc20 string) []byte {
-	return append(ERC20ToDenomKey, []byte(erc20)...)
+func GetERC20ToDenomKey(erc20 EthAddress) []byte {
+	return append(ERC20ToDenomKey, []byte(erc20.GetAddress())...)
 }
 
 func GetOutgoingLogicCallKey(invalidationId []byte, invalidationNonce uint64) []byte {
@@ -300,3 +356,80 @@ func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validat
 func GetPastEthSignatureCheckpointKey(checkpoint []byte) []byte {
 	return append(PastEthSignatureCheckpointKey, checkpoint...)
 }
+
+// GetPastEthSignatureCheckpointInfoKey returns the following key format
+// prefix    checkpoint
+// [0x0][ checkpoint bytes ]
+func GetPastEthSignatureCheckpointInfoKey(checkpoint []byte) []byte {
+	return append(PastEthSignatureCheckpointInfoKey, checkpoint...)
+}
+
+// GetValidatorBridgeSigningInfoKey returns the following key format
+// prefix    cosmos-validator
+// [0x0][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetValidatorBridgeSigningInfoKey(validator sdk.ValAddress) []byte {
+	return append(ValidatorBridgeSigningInfoKey, validator.Bytes()...)
+}
+
+// GetRelayerStatsKey returns the following key format
+// prefix    eth-address
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetRelayerStatsKey(relayer EthAddress) []byte {
+	return append(RelayerStatsKey, []byte(relayer.GetAddress())...)
+}
+
+// GetERC20DeploymentApprovalKey returns the following key format
+// prefix    denom
+// [0x0][ugraviton]
+func GetERC20DeploymentApprovalKey(denom string) []byte {
+	return append(ERC20DeploymentApprovalKey, []byte(denom)...)
+}
+
+// GetHeldDepositKey returns the following key format
+// prefix    id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetHeldDepositKey(id uint64) []byte {
+	return append(HeldDepositKey, UInt64Bytes(id)...)
+}
+
+// GetTokenScalingKey returns the following key format
+// prefix    eth-address
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetTokenScalingKey(tokenContract EthAddress) []byte {
+	return append(TokenScalingKey, []byte(tokenContract.GetAddress())...)
+}
+
+// GetDustKey returns the following key format
+// prefix    eth-address
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetDustKey(tokenContract EthAddress) []byte {
+	return append(DustKey, []byte(tokenContract.GetAddress())...)
+}
+
+// GetDenomTraceKey returns the following key format
+// prefix    denom
+// [0x0][gravity/09BE2566E4015EE434B381799D4A16ABFCB0656A8ADB7FE55774C47A107EE034]
+func GetDenomTraceKey(denom string) []byte {
+	return append(DenomTraceKey, []byte(denom)...)
+}
+
+// GetDenomTraceByERC20Key returns the following key format
+// prefix    eth-address
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetDenomTraceByERC20Key(tokenContract EthAddress) []byte {
+	return append(DenomTraceByERC20Key, []byte(tokenContract.GetAddress())...)
+}
+
+// GetValsetRewardEscrowKey returns the following key format
+// prefix    nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetValsetRewardEscrowKey(nonce uint64) []byte {
+	return append(ValsetRewardEscrowKey, UInt64Bytes(nonce)...)
+}
+
+// GetEscrowedValsetRewardsKey returns the following key format
+// prefix    denom
+// [0x0][gravity/09BE2566E4015EE434B381799D4A16ABFCB0656A8ADB7FE55774C47A107EE034]
+func GetEscrowedValsetRewardsKey(denom string) []byte {
+	return append(EscrowedValsetRewardsKey, []byte(denom)...)
+}
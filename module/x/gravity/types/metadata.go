@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// MaxERC20Decimals is the largest number of decimals an ERC20 can report, the field is a uint8
+	MaxERC20Decimals = math.MaxUint8
+
+	// MaxERC20MetadataLen is the longest name or symbol accepted for an ERC20
+	MaxERC20MetadataLen = 128
+)
+
+// ValidateERC20Metadata checks the name, symbol and decimals reported for an ERC20
+func ValidateERC20Metadata(name, symbol string, decimals uint64) error {
+	if strings.TrimSpace(symbol) == "" {
+		return sdkerrors.Wrap(ErrEmpty, "symbol")
+	}
+	if len(symbol) > MaxERC20MetadataLen {
+		return sdkerrors.Wrapf(ErrInvalid, "symbol longer than %d", MaxERC20MetadataLen)
+	}
+	if len(name) > MaxERC20MetadataLen {
+		return sdkerrors.Wrapf(ErrInvalid, "name longer than %d", MaxERC20MetadataLen)
+	}
+	if decimals > MaxERC20Decimals {
+		return sdkerrors.Wrapf(ErrInvalid, "decimals %d above %d", decimals, MaxERC20Decimals)
+	}
+	return nil
+}
+
+// ERC20DenomMetadata returns the bank denom metadata of the voucher denom of an Ethereum originated ERC20.
+// The voucher is the base unit, the symbol is displayed with the decimals of the voucher
+func ERC20DenomMetadata(base, name, symbol string, decimals uint64) bank.Metadata {
+	return bank.Metadata{
+		Description: name,
+		DenomUnits: []*bank.DenomUnit{
+			{Denom: base, Exponent: 0},
+			{Denom: symbol, Exponent: uint32(decimals)},
+		},
+		Base:    base,
+		Display: symbol,
+	}
+}
+
+// ClaimedERC20DenomMetadata returns the bank denom metadata of a voucher from the ERC20 metadata reported
+// by claims. Anybody can deploy an ERC20 with the symbol of another token, so the voucher is displayed as
+// its ERC20 and the reported name and symbol are only described until governance confirms them
+func ClaimedERC20DenomMetadata(base, tokenContract, name, symbol string, decimals uint64) bank.Metadata {
+	return ERC20DenomMetadata(base, strings.TrimSpace(fmt.Sprintf("%s (%s)", name, symbol)), tokenContract, decimals)
+}
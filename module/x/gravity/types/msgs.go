@@ -4,8 +4,10 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
@@ -14,6 +16,7 @@ var (
 	_ sdk.Msg = &MsgValsetConfirm{}
 	_ sdk.Msg = &MsgSendToEth{}
 	_ sdk.Msg = &MsgCancelSendToEth{}
+	_ sdk.Msg = &MsgReclaimHeldDeposit{}
 	_ sdk.Msg = &MsgRequestBatch{}
 	_ sdk.Msg = &MsgConfirmBatch{}
 	_ sdk.Msg = &MsgERC20DeployedClaim{}
@@ -23,8 +26,16 @@ var (
 	_ sdk.Msg = &MsgBatchSendToEthClaim{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgSubmitClaims{}
+	_ sdk.Msg = &MsgSubmitConfirms{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitClaims{}
 )
 
+// MaxSubmitEntries is the most claims, or confirms, that can be sent in a single
+// MsgSubmitClaims or MsgSubmitConfirms
+const MaxSubmitEntries = 100
+
 // NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
 func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth string) *MsgSetOrchestratorAddress {
 	return &MsgSetOrchestratorAddress{
@@ -48,7 +59,7 @@ func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
 	if _, err = sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
 	}
-	if err := ValidateEthAddress(msg.EthAddress); err != nil {
+	if _, err := NewEthAddress(msg.EthAddress); err != nil {
 		return sdkerrors.Wrap(err, "ethereum address")
 	}
 	return nil
@@ -94,7 +105,7 @@ func (msg *MsgValsetConfirm) ValidateBasic() (err error) {
 	if _, err = sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
 	}
-	if err := ValidateEthAddress(msg.EthAddress); err != nil {
+	if _, err := NewEthAddress(msg.EthAddress); err != nil {
 		return sdkerrors.Wrap(err, "ethereum address")
 	}
 	return nil
@@ -219,10 +230,10 @@ func (msg MsgConfirmBatch) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
 	}
-	if err := ValidateEthAddress(msg.EthSigner); err != nil {
+	if _, err := NewEthAddress(msg.EthSigner); err != nil {
 		return sdkerrors.Wrap(err, "eth signer")
 	}
-	if err := ValidateEthAddress(msg.TokenContract); err != nil {
+	if _, err := NewEthAddress(msg.TokenContract); err != nil {
 		return sdkerrors.Wrap(err, "token contract")
 	}
 	_, err := hex.DecodeString(msg.Signature)
@@ -257,7 +268,7 @@ func (msg MsgConfirmLogicCall) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
 	}
-	if err := ValidateEthAddress(msg.EthSigner); err != nil {
+	if _, err := NewEthAddress(msg.EthSigner); err != nil {
 		return sdkerrors.Wrap(err, "eth signer")
 	}
 	_, err := hex.DecodeString(msg.Signature)
@@ -310,11 +321,25 @@ type EthereumClaim interface {
 	ClaimHash() []byte
 }
 
+// ExtendedClaim is a claim with fields older orchestrators don't report, see Params.ExtendedClaims
+type ExtendedClaim interface {
+	EthereumClaim
+	// DropExtendedFields clears the fields older orchestrators don't report, which gives the claim the
+	// hash older orchestrators vote on
+	DropExtendedFields()
+}
+
 var (
 	_ EthereumClaim = &MsgSendToCosmosClaim{}
 	_ EthereumClaim = &MsgBatchSendToEthClaim{}
 	_ EthereumClaim = &MsgERC20DeployedClaim{}
 	_ EthereumClaim = &MsgLogicCallExecutedClaim{}
+
+	_ ExtendedClaim = &MsgSendToCosmosClaim{}
+	_ ExtendedClaim = &MsgBatchSendToEthClaim{}
+	_ ExtendedClaim = &MsgERC20DeployedClaim{}
+	_ ExtendedClaim = &MsgLogicCallExecutedClaim{}
+	_ ExtendedClaim = &MsgValsetUpdatedClaim{}
 )
 
 // GetType returns the type of the claim
@@ -339,9 +364,19 @@ func (msg *MsgSendToCosmosClaim) ValidateBasic() error {
 	if msg.EventNonce == 0 {
 		return fmt.Errorf("nonce == 0")
 	}
+	if msg.HasTokenMetadata() {
+		if err := ValidateERC20Metadata(msg.TokenName, msg.TokenSymbol, msg.TokenDecimals); err != nil {
+			return sdkerrors.Wrap(err, "token metadata")
+		}
+	}
 	return nil
 }
 
+// HasTokenMetadata returns true if the claim reports the ERC20 metadata of the token
+func (msg *MsgSendToCosmosClaim) HasTokenMetadata() bool {
+	return msg.TokenName != "" || msg.TokenSymbol != "" || msg.TokenDecimals != 0
+}
+
 // GetSignBytes encodes the message for signing
 func (msg MsgSendToCosmosClaim) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -387,7 +422,10 @@ const (
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
 func (msg *MsgSendToCosmosClaim) ClaimHash() []byte {
-	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s", msg.EventNonce, msg.BlockHeight, msg.TokenContract, msg.Amount.String(), string(msg.EthereumSender), msg.CosmosReceiver)
+	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s", msg.EventNonce, msg.BlockHeight, claimEthAddress(msg.TokenContract), msg.Amount.String(), claimEthAddress(msg.EthereumSender), msg.CosmosReceiver)
+	if msg.HasTokenMetadata() {
+		path = fmt.Sprintf("%s/%s/%s/%d", path, msg.TokenName, msg.TokenSymbol, msg.TokenDecimals)
+	}
 	return tmhash.Sum([]byte(path))
 }
 
@@ -410,13 +448,17 @@ func (e *MsgBatchSendToEthClaim) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
 	}
+	if err := validateRelayer(e.Relayer); err != nil {
+		return err
+	}
 	return nil
 }
 
 // Hash implements WithdrawBatch.Hash
 func (msg *MsgBatchSendToEthClaim) ClaimHash() []byte {
-	path := fmt.Sprintf("%s/%d/%d/%s", msg.TokenContract, msg.BatchNonce, msg.EventNonce, msg.TokenContract)
-	return tmhash.Sum([]byte(path))
+	tokenContract := claimEthAddress(msg.TokenContract)
+	path := fmt.Sprintf("%s/%d/%d/%s", tokenContract, msg.BatchNonce, msg.EventNonce, tokenContract)
+	return tmhash.Sum([]byte(withOptional(path, claimEthAddress(msg.Relayer))))
 }
 
 // GetSignBytes encodes the message for signing
@@ -472,6 +514,11 @@ func (e *MsgERC20DeployedClaim) ValidateBasic() error {
 	if e.EventNonce == 0 {
 		return fmt.Errorf("nonce == 0")
 	}
+	if e.Deployer != "" {
+		if err := ValidateEthAddress(e.Deployer); err != nil {
+			return sdkerrors.Wrap(err, "deployer")
+		}
+	}
 	return nil
 }
 
@@ -512,8 +559,8 @@ func (msg MsgERC20DeployedClaim) Route() string { return RouterKey }
 // note that the Orchestrator is the only field excluded from this hash, this is because that value is used higher up in the store
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
 func (b *MsgERC20DeployedClaim) ClaimHash() []byte {
-	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s/%d", b.EventNonce, b.BlockHeight, b.CosmosDenom, b.TokenContract, b.Name, b.Symbol, b.Decimals)
-	return tmhash.Sum([]byte(path))
+	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s/%d", b.EventNonce, b.BlockHeight, b.CosmosDenom, claimEthAddress(b.TokenContract), b.Name, b.Symbol, b.Decimals)
+	return tmhash.Sum([]byte(withOptional(path, claimEthAddress(b.Deployer))))
 }
 
 // EthereumClaim implementation for MsgLogicCallExecutedClaim
@@ -532,7 +579,7 @@ func (e *MsgLogicCallExecutedClaim) ValidateBasic() error {
 	if e.EventNonce == 0 {
 		return fmt.Errorf("nonce == 0")
 	}
-	return nil
+	return validateRelayer(e.Relayer)
 }
 
 // GetSignBytes encodes the message for signing
@@ -573,7 +620,7 @@ func (msg MsgLogicCallExecutedClaim) Route() string { return RouterKey }
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
 func (b *MsgLogicCallExecutedClaim) ClaimHash() []byte {
 	path := fmt.Sprintf("%d,%d,%s/%d/", b.EventNonce, b.BlockHeight, b.InvalidationId, b.InvalidationNonce)
-	return tmhash.Sum([]byte(path))
+	return tmhash.Sum([]byte(withOptional(path, claimEthAddress(b.Relayer))))
 }
 
 // EthereumClaim implementation for MsgValsetUpdatedClaim
@@ -605,7 +652,7 @@ func (e *MsgValsetUpdatedClaim) ValidateBasic() error {
 		}
 	}
 
-	return nil
+	return validateRelayer(e.Relayer)
 }
 
 // GetSignBytes encodes the message for signing
@@ -647,8 +694,61 @@ func (msg MsgValsetUpdatedClaim) Route() string { return RouterKey }
 func (b *MsgValsetUpdatedClaim) ClaimHash() []byte {
 	var members BridgeValidators = b.Members
 	members.Sort()
-	path := fmt.Sprintf("%d/%d/%d/%s/%s/%s", b.EventNonce, b.ValsetNonce, b.BlockHeight, members, b.RewardAmount.String(), b.RewardToken)
-	return tmhash.Sum([]byte(path))
+	path := fmt.Sprintf("%d/%d/%d/%s/%s/%s", b.EventNonce, b.ValsetNonce, b.BlockHeight, members, b.RewardAmount.String(), claimEthAddress(b.RewardToken))
+	return tmhash.Sum([]byte(withOptional(path, claimEthAddress(b.Relayer))))
+}
+
+// DropExtendedFields implements ExtendedClaim
+func (msg *MsgSendToCosmosClaim) DropExtendedFields() {
+	msg.TokenName = ""
+	msg.TokenSymbol = ""
+	msg.TokenDecimals = 0
+}
+
+// DropExtendedFields implements ExtendedClaim
+func (msg *MsgBatchSendToEthClaim) DropExtendedFields() {
+	msg.Relayer = ""
+}
+
+// DropExtendedFields implements ExtendedClaim
+func (b *MsgERC20DeployedClaim) DropExtendedFields() {
+	b.Deployer = ""
+}
+
+// DropExtendedFields implements ExtendedClaim
+func (e *MsgLogicCallExecutedClaim) DropExtendedFields() {
+	e.Relayer = ""
+}
+
+// DropExtendedFields implements ExtendedClaim
+func (e *MsgValsetUpdatedClaim) DropExtendedFields() {
+	e.Relayer = ""
+}
+
+// validateRelayer checks the optional relayer of a claim
+func validateRelayer(relayer string) error {
+	if relayer == "" {
+		return nil
+	}
+	return sdkerrors.Wrap(ValidateEthAddress(relayer), "relayer")
+}
+
+// claimEthAddress returns the checksummed form of an ethereum address in a claim for its hash, so that claims
+// reporting the address in different casings get the same hash, anything that isn't an address is left as is
+func claimEthAddress(address string) string {
+	if ethAddress, err := NewEthAddress(address); err == nil {
+		return ethAddress.GetAddress()
+	}
+	return address
+}
+
+// withOptional adds an optional field like the relayer to the hashed path of a claim, claims without
+// it keep the hash they had before the field was reported so pending attestations still match
+func withOptional(path string, value string) string {
+	if value == "" {
+		return path
+	}
+	return fmt.Sprintf("%s/%s", path, value)
 }
 
 // NewMsgCancelSendToEth returns a new msgSetOrchestratorAddress
@@ -688,6 +788,50 @@ func (msg *MsgCancelSendToEth) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgReclaimHeldDeposit returns a new MsgReclaimHeldDeposit
+func NewMsgReclaimHeldDeposit(receiver sdk.AccAddress, id uint64, returnFee sdk.Int) *MsgReclaimHeldDeposit {
+	return &MsgReclaimHeldDeposit{
+		Sender:    receiver.String(),
+		DepositId: id,
+		ReturnFee: returnFee,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgReclaimHeldDeposit) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgReclaimHeldDeposit) Type() string { return "reclaim_held_deposit" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgReclaimHeldDeposit) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.DepositId == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "deposit id")
+	}
+	// messages from before the return fee existed leave it unset
+	if !msg.ReturnFee.IsNil() && msg.ReturnFee.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "return fee")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgReclaimHeldDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgReclaimHeldDeposit) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
 // MsgSubmitBadSignatureEvidence
 // ======================================================
 
@@ -719,3 +863,158 @@ func (msg MsgSubmitBadSignatureEvidence) Type() string { return "Submit_Bad_Sign
 
 // Route should return the name of the module
 func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// MsgSubmitClaims
+// ======================================================
+
+// NewMsgSubmitClaims packs the provided claims into a new MsgSubmitClaims
+func NewMsgSubmitClaims(orchestrator sdk.AccAddress, claims []EthereumClaim) (*MsgSubmitClaims, error) {
+	msg := &MsgSubmitClaims{Orchestrator: orchestrator.String()}
+	for _, claim := range claims {
+		pb, ok := claim.(proto.Message)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "can't pack claim of type %T", claim)
+		}
+		any, err := codectypes.NewAnyWithValue(pb)
+		if err != nil {
+			return nil, err
+		}
+		msg.Claims = append(msg.Claims, any)
+	}
+	return msg, nil
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitClaims) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitClaims) Type() string { return "submit_claims" }
+
+// ValidateBasic performs stateless checks, every claim must be valid and made by the orchestrator
+func (msg *MsgSubmitClaims) ValidateBasic() error {
+	orchestrator, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	if len(msg.Claims) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "claims")
+	}
+	if len(msg.Claims) > MaxSubmitEntries {
+		return sdkerrors.Wrapf(ErrInvalid, "more than %d claims", MaxSubmitEntries)
+	}
+	for i, any := range msg.Claims {
+		claim, ok := any.GetCachedValue().(EthereumClaim)
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "claim %d is not an ethereum claim", i)
+		}
+		if err := claim.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "claim %d", i)
+		}
+		if !claim.GetClaimer().Equals(orchestrator) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "claim %d is not from the orchestrator", i)
+		}
+	}
+	return nil
+}
+
+// UnpackClaims returns the unpacked claims, in the order they were submitted
+func (msg *MsgSubmitClaims) UnpackClaims() ([]EthereumClaim, error) {
+	claims := make([]EthereumClaim, len(msg.Claims))
+	for i, any := range msg.Claims {
+		claim, ok := any.GetCachedValue().(EthereumClaim)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "claim %d is not an ethereum claim", i)
+		}
+		claims[i] = claim
+	}
+	return claims, nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitClaims) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitClaims) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgSubmitClaims) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Claims {
+		var claim EthereumClaim
+		if err := unpacker.UnpackAny(any, &claim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MsgSubmitConfirms
+// ======================================================
+
+// Route should return the name of the module
+func (msg *MsgSubmitConfirms) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitConfirms) Type() string { return "submit_confirms" }
+
+// ValidateBasic performs stateless checks, every confirm must be valid and made by the orchestrator
+func (msg *MsgSubmitConfirms) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	total := len(msg.ValsetConfirms) + len(msg.BatchConfirms) + len(msg.LogicCallConfirms)
+	if total == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "confirms")
+	}
+	if total > MaxSubmitEntries {
+		return sdkerrors.Wrapf(ErrInvalid, "more than %d confirms", MaxSubmitEntries)
+	}
+	for i := range msg.ValsetConfirms {
+		if err := msg.validateConfirm(&msg.ValsetConfirms[i], msg.ValsetConfirms[i].Orchestrator); err != nil {
+			return sdkerrors.Wrapf(err, "valset confirm %d", i)
+		}
+	}
+	for i := range msg.BatchConfirms {
+		if err := msg.validateConfirm(&msg.BatchConfirms[i], msg.BatchConfirms[i].Orchestrator); err != nil {
+			return sdkerrors.Wrapf(err, "batch confirm %d", i)
+		}
+	}
+	for i := range msg.LogicCallConfirms {
+		if err := msg.validateConfirm(&msg.LogicCallConfirms[i], msg.LogicCallConfirms[i].Orchestrator); err != nil {
+			return sdkerrors.Wrapf(err, "logic call confirm %d", i)
+		}
+	}
+	return nil
+}
+
+// validateConfirm checks a single confirm is valid and was made by the orchestrator
+func (msg *MsgSubmitConfirms) validateConfirm(confirm sdk.Msg, orchestrator string) error {
+	if err := confirm.ValidateBasic(); err != nil {
+		return err
+	}
+	if orchestrator != msg.Orchestrator {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not from the orchestrator")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitConfirms) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitConfirms) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
@@ -718,6 +718,13 @@ var xxx_messageInfo_MsgConfirmLogicCallResponse proto.InternalMessageInfo
 // claimed to have seen the deposit enter the ethereum blockchain coins are
 // issued to the Cosmos address in question
 // -------------
+// TOKEN_NAME, TOKEN_SYMBOL, TOKEN_DECIMALS:
+// The optional ERC20 metadata of an Ethereum originated token, the first
+// observed deposit that reports it sets the bank denom metadata of the voucher
+// unless the voucher already has metadata. Anybody can deploy an ERC20 with the
+// symbol of another token, so the voucher is displayed as its ERC20 address
+// until a governance ERC20MetadataProposal confirms the symbol. Dropped until
+// Params.extended_claims is set
 type MsgSendToCosmosClaim struct {
 	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
 	BlockHeight    uint64                                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
@@ -726,6 +733,9 @@ type MsgSendToCosmosClaim struct {
 	EthereumSender string                                 `protobuf:"bytes,5,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
 	CosmosReceiver string                                 `protobuf:"bytes,6,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
 	Orchestrator   string                                 `protobuf:"bytes,7,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	TokenName      string                                 `protobuf:"bytes,8,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
+	TokenSymbol    string                                 `protobuf:"bytes,9,opt,name=token_symbol,json=tokenSymbol,proto3" json:"token_symbol,omitempty"`
+	TokenDecimals  uint64                                 `protobuf:"varint,10,opt,name=token_decimals,json=tokenDecimals,proto3" json:"token_decimals,omitempty"`
 }
 
 func (m *MsgSendToCosmosClaim) Reset()         { *m = MsgSendToCosmosClaim{} }
@@ -803,6 +813,27 @@ func (m *MsgSendToCosmosClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgSendToCosmosClaim) GetTokenName() string {
+	if m != nil {
+		return m.TokenName
+	}
+	return ""
+}
+
+func (m *MsgSendToCosmosClaim) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *MsgSendToCosmosClaim) GetTokenDecimals() uint64 {
+	if m != nil {
+		return m.TokenDecimals
+	}
+	return 0
+}
+
 type MsgSendToCosmosClaimResponse struct {
 }
 
@@ -841,12 +872,15 @@ var xxx_messageInfo_MsgSendToCosmosClaimResponse proto.InternalMessageInfo
 
 // BatchSendToEthClaim claims that a batch of send to eth
 // operations on the bridge contract was executed.
+// relayer is the optional Ethereum address that submitted the batch, the fees
+// it collected are taken from the batch and added to its relayer stats
 type MsgBatchSendToEthClaim struct {
 	EventNonce    uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
 	BlockHeight   uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	BatchNonce    uint64 `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
 	TokenContract string `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	Orchestrator  string `protobuf:"bytes,5,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	Relayer       string `protobuf:"bytes,6,opt,name=relayer,proto3" json:"relayer,omitempty"`
 }
 
 func (m *MsgBatchSendToEthClaim) Reset()         { *m = MsgBatchSendToEthClaim{} }
@@ -917,6 +951,13 @@ func (m *MsgBatchSendToEthClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgBatchSendToEthClaim) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
 type MsgBatchSendToEthClaimResponse struct {
 }
 
@@ -955,7 +996,9 @@ var xxx_messageInfo_MsgBatchSendToEthClaimResponse proto.InternalMessageInfo
 
 // ERC20DeployedClaim allows the Cosmos module
 // to learn about an ERC20 that someone deployed
-// to represent a Cosmos asset
+// to represent a Cosmos asset. deployer is the optional
+// Ethereum address that deployed it, checked against
+// the ERC20 deployment approval of the denom
 type MsgERC20DeployedClaim struct {
 	EventNonce    uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
 	BlockHeight   uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
@@ -965,6 +1008,7 @@ type MsgERC20DeployedClaim struct {
 	Symbol        string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Decimals      uint64 `protobuf:"varint,7,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	Orchestrator  string `protobuf:"bytes,8,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	Deployer      string `protobuf:"bytes,9,opt,name=deployer,proto3" json:"deployer,omitempty"`
 }
 
 func (m *MsgERC20DeployedClaim) Reset()         { *m = MsgERC20DeployedClaim{} }
@@ -1056,6 +1100,13 @@ func (m *MsgERC20DeployedClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgERC20DeployedClaim) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
 type MsgERC20DeployedClaimResponse struct {
 }
 
@@ -1093,13 +1144,15 @@ func (m *MsgERC20DeployedClaimResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgERC20DeployedClaimResponse proto.InternalMessageInfo
 
 // This informs the Cosmos module that a logic
-// call has been executed
+// call has been executed, the fees of the call are added
+// to the relayer stats of the optional relayer
 type MsgLogicCallExecutedClaim struct {
 	EventNonce        uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
 	BlockHeight       uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	InvalidationId    []byte `protobuf:"bytes,3,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
 	InvalidationNonce uint64 `protobuf:"varint,4,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
 	Orchestrator      string `protobuf:"bytes,5,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	Relayer           string `protobuf:"bytes,6,opt,name=relayer,proto3" json:"relayer,omitempty"`
 }
 
 func (m *MsgLogicCallExecutedClaim) Reset()         { *m = MsgLogicCallExecutedClaim{} }
@@ -1170,6 +1223,13 @@ func (m *MsgLogicCallExecutedClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgLogicCallExecutedClaim) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
 type MsgLogicCallExecutedClaimResponse struct {
 }
 
@@ -1207,7 +1267,8 @@ func (m *MsgLogicCallExecutedClaimResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgLogicCallExecutedClaimResponse proto.InternalMessageInfo
 
 // This informs the Cosmos module that a validator
-// set has been updated.
+// set has been updated. The reward is added to the relayer
+// stats of the optional relayer
 type MsgValsetUpdatedClaim struct {
 	EventNonce   uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
 	ValsetNonce  uint64                                 `protobuf:"varint,2,opt,name=valset_nonce,json=valsetNonce,proto3" json:"valset_nonce,omitempty"`
@@ -1216,6 +1277,7 @@ type MsgValsetUpdatedClaim struct {
 	RewardAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=reward_amount,json=rewardAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward_amount"`
 	RewardToken  string                                 `protobuf:"bytes,6,opt,name=reward_token,json=rewardToken,proto3" json:"reward_token,omitempty"`
 	Orchestrator string                                 `protobuf:"bytes,7,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	Relayer      string                                 `protobuf:"bytes,8,opt,name=relayer,proto3" json:"relayer,omitempty"`
 }
 
 func (m *MsgValsetUpdatedClaim) Reset()         { *m = MsgValsetUpdatedClaim{} }
@@ -1293,6 +1355,13 @@ func (m *MsgValsetUpdatedClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgValsetUpdatedClaim) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
 type MsgValsetUpdatedClaimResponse struct {
 }
 
@@ -1420,6 +1489,100 @@ func (m *MsgCancelSendToEthResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSendToEthResponse proto.InternalMessageInfo
 
+// This call allows the cosmos receiver (and only the receiver) of a deposit
+// held by the token policy to reclaim it. If the token is allowed by now the
+// vouchers are released to the receiver, otherwise the tokens are sent back
+// to the ethereum sender. The return_fee is taken out of the returned tokens
+// and paid as the batch fee of the return, so that relayers batch it
+type MsgReclaimHeldDeposit struct {
+	DepositId uint64                                 `protobuf:"varint,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	Sender    string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	ReturnFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=return_fee,json=returnFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"return_fee"`
+}
+
+func (m *MsgReclaimHeldDeposit) Reset()         { *m = MsgReclaimHeldDeposit{} }
+func (m *MsgReclaimHeldDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimHeldDeposit) ProtoMessage()    {}
+func (*MsgReclaimHeldDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
+}
+func (m *MsgReclaimHeldDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimHeldDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimHeldDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReclaimHeldDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimHeldDeposit.Merge(m, src)
+}
+func (m *MsgReclaimHeldDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimHeldDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimHeldDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReclaimHeldDeposit proto.InternalMessageInfo
+
+func (m *MsgReclaimHeldDeposit) GetDepositId() uint64 {
+	if m != nil {
+		return m.DepositId
+	}
+	return 0
+}
+
+func (m *MsgReclaimHeldDeposit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type MsgReclaimHeldDepositResponse struct {
+}
+
+func (m *MsgReclaimHeldDepositResponse) Reset()         { *m = MsgReclaimHeldDepositResponse{} }
+func (m *MsgReclaimHeldDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimHeldDepositResponse) ProtoMessage()    {}
+func (*MsgReclaimHeldDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
+}
+func (m *MsgReclaimHeldDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimHeldDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimHeldDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReclaimHeldDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimHeldDepositResponse.Merge(m, src)
+}
+func (m *MsgReclaimHeldDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimHeldDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimHeldDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReclaimHeldDepositResponse proto.InternalMessageInfo
+
 // This call allows anyone to submit evidence that a
 // validator has signed a valset, batch, or logic call that never
 // existed. Subject contains the batch, valset, or logic call.
@@ -1433,7 +1596,7 @@ func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSigna
 func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{24}
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
 func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1490,7 +1653,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmit
 func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{25}
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
 }
 func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1519,6 +1682,303 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse proto.InternalMessageInfo
 
+// MsgSubmitClaims
+// This allows an orchestrator to submit many Ethereum claims in a single message,
+// for example when catching up on events after downtime, rather than sending one
+// transaction per event. Each claim is processed exactly as if it had been sent in
+// its own message, but a claim that fails does not revert the claims around it
+// -------------
+// CLAIMS:
+// The packed EthereumClaims to submit in ascending event nonce order, every claim
+// must have been made by the orchestrator
+// ORCHESTRATOR:
+// The orchestrator submitting the claims and signing this message
+type MsgSubmitClaims struct {
+	Claims       []*types1.Any `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	Orchestrator string        `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+}
+
+func (m *MsgSubmitClaims) Reset()         { *m = MsgSubmitClaims{} }
+func (m *MsgSubmitClaims) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitClaims) ProtoMessage()    {}
+func (*MsgSubmitClaims) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
+}
+func (m *MsgSubmitClaims) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitClaims) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitClaims.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitClaims) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitClaims.Merge(m, src)
+}
+func (m *MsgSubmitClaims) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitClaims) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitClaims.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitClaims proto.InternalMessageInfo
+
+func (m *MsgSubmitClaims) GetClaims() []*types1.Any {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+func (m *MsgSubmitClaims) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+// The results of each claim in MsgSubmitClaims, in the same order
+type MsgSubmitClaimsResponse struct {
+	Results []SubmitResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgSubmitClaimsResponse) Reset()         { *m = MsgSubmitClaimsResponse{} }
+func (m *MsgSubmitClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitClaimsResponse) ProtoMessage()    {}
+func (*MsgSubmitClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
+}
+func (m *MsgSubmitClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitClaimsResponse.Merge(m, src)
+}
+func (m *MsgSubmitClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitClaimsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitClaimsResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitClaimsResponse) GetResults() []SubmitResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// MsgSubmitConfirms
+// This allows an orchestrator to submit many valset, batch and logic call
+// signatures in a single message. Each confirm is processed exactly as if it had
+// been sent in its own message, but a confirm that fails does not revert the
+// confirms around it. Every confirm must have been made by the orchestrator
+// -------------
+type MsgSubmitConfirms struct {
+	ValsetConfirms    []MsgValsetConfirm    `protobuf:"bytes,1,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
+	BatchConfirms     []MsgConfirmBatch     `protobuf:"bytes,2,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCallConfirms []MsgConfirmLogicCall `protobuf:"bytes,3,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Orchestrator      string                `protobuf:"bytes,4,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+}
+
+func (m *MsgSubmitConfirms) Reset()         { *m = MsgSubmitConfirms{} }
+func (m *MsgSubmitConfirms) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitConfirms) ProtoMessage()    {}
+func (*MsgSubmitConfirms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
+}
+func (m *MsgSubmitConfirms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitConfirms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitConfirms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitConfirms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitConfirms.Merge(m, src)
+}
+func (m *MsgSubmitConfirms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitConfirms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitConfirms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitConfirms proto.InternalMessageInfo
+
+func (m *MsgSubmitConfirms) GetValsetConfirms() []MsgValsetConfirm {
+	if m != nil {
+		return m.ValsetConfirms
+	}
+	return nil
+}
+
+func (m *MsgSubmitConfirms) GetBatchConfirms() []MsgConfirmBatch {
+	if m != nil {
+		return m.BatchConfirms
+	}
+	return nil
+}
+
+func (m *MsgSubmitConfirms) GetLogicCallConfirms() []MsgConfirmLogicCall {
+	if m != nil {
+		return m.LogicCallConfirms
+	}
+	return nil
+}
+
+func (m *MsgSubmitConfirms) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+// The results of each confirm in MsgSubmitConfirms, in the same order
+type MsgSubmitConfirmsResponse struct {
+	ValsetResults    []SubmitResult `protobuf:"bytes,1,rep,name=valset_results,json=valsetResults,proto3" json:"valset_results"`
+	BatchResults     []SubmitResult `protobuf:"bytes,2,rep,name=batch_results,json=batchResults,proto3" json:"batch_results"`
+	LogicCallResults []SubmitResult `protobuf:"bytes,3,rep,name=logic_call_results,json=logicCallResults,proto3" json:"logic_call_results"`
+}
+
+func (m *MsgSubmitConfirmsResponse) Reset()         { *m = MsgSubmitConfirmsResponse{} }
+func (m *MsgSubmitConfirmsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitConfirmsResponse) ProtoMessage()    {}
+func (*MsgSubmitConfirmsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{31}
+}
+func (m *MsgSubmitConfirmsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitConfirmsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitConfirmsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitConfirmsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitConfirmsResponse.Merge(m, src)
+}
+func (m *MsgSubmitConfirmsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitConfirmsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitConfirmsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitConfirmsResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitConfirmsResponse) GetValsetResults() []SubmitResult {
+	if m != nil {
+		return m.ValsetResults
+	}
+	return nil
+}
+
+func (m *MsgSubmitConfirmsResponse) GetBatchResults() []SubmitResult {
+	if m != nil {
+		return m.BatchResults
+	}
+	return nil
+}
+
+func (m *MsgSubmitConfirmsResponse) GetLogicCallResults() []SubmitResult {
+	if m != nil {
+		return m.LogicCallResults
+	}
+	return nil
+}
+
+// SubmitResult is the outcome of a single claim or confirm submitted in a
+// MsgSubmitClaims or MsgSubmitConfirms, ERROR is empty if it was accepted
+type SubmitResult struct {
+	Accepted bool   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Error    string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SubmitResult) Reset()         { *m = SubmitResult{} }
+func (m *SubmitResult) String() string { return proto.CompactTextString(m) }
+func (*SubmitResult) ProtoMessage()    {}
+func (*SubmitResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{32}
+}
+func (m *SubmitResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitResult.Merge(m, src)
+}
+func (m *SubmitResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitResult proto.InternalMessageInfo
+
+func (m *SubmitResult) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *SubmitResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
 	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
@@ -1544,111 +2004,143 @@ func init() {
 	proto.RegisterType((*MsgValsetUpdatedClaimResponse)(nil), "gravity.v1.MsgValsetUpdatedClaimResponse")
 	proto.RegisterType((*MsgCancelSendToEth)(nil), "gravity.v1.MsgCancelSendToEth")
 	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "gravity.v1.MsgCancelSendToEthResponse")
+	proto.RegisterType((*MsgReclaimHeldDeposit)(nil), "gravity.v1.MsgReclaimHeldDeposit")
+	proto.RegisterType((*MsgReclaimHeldDepositResponse)(nil), "gravity.v1.MsgReclaimHeldDepositResponse")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
+	proto.RegisterType((*MsgSubmitClaims)(nil), "gravity.v1.MsgSubmitClaims")
+	proto.RegisterType((*MsgSubmitClaimsResponse)(nil), "gravity.v1.MsgSubmitClaimsResponse")
+	proto.RegisterType((*MsgSubmitConfirms)(nil), "gravity.v1.MsgSubmitConfirms")
+	proto.RegisterType((*MsgSubmitConfirmsResponse)(nil), "gravity.v1.MsgSubmitConfirmsResponse")
+	proto.RegisterType((*SubmitResult)(nil), "gravity.v1.SubmitResult")
 }
 
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xdb, 0xc6,
-	0x12, 0x37, 0x6d, 0xd9, 0x8e, 0x47, 0xfe, 0x48, 0x18, 0xc7, 0x91, 0x69, 0x47, 0xb6, 0x99, 0xf8,
-	0x23, 0x2f, 0xcf, 0x52, 0xec, 0x87, 0x87, 0x77, 0x7b, 0x45, 0xec, 0x38, 0x68, 0x80, 0x3a, 0x05,
-	0xe4, 0x34, 0x87, 0xa2, 0x00, 0xb1, 0x22, 0x37, 0x14, 0x1b, 0x92, 0xeb, 0x72, 0x57, 0x4a, 0x7c,
-	0x09, 0xd0, 0x16, 0x3d, 0x14, 0xe9, 0xa1, 0x1f, 0xd7, 0xf6, 0x4f, 0x28, 0x7a, 0xef, 0xa5, 0xd7,
-	0x9c, 0x8a, 0x14, 0xbd, 0x14, 0x2d, 0x10, 0x14, 0x49, 0xff, 0x90, 0x82, 0xbb, 0xab, 0xf5, 0x8a,
-	0xa4, 0x6c, 0xb5, 0x70, 0x4f, 0xd2, 0xce, 0xce, 0xce, 0xfc, 0xe6, 0x37, 0xb3, 0x3b, 0x03, 0xc2,
-	0x25, 0x3f, 0x41, 0x9d, 0x80, 0x1d, 0xd5, 0x3b, 0x5b, 0xf5, 0x88, 0xfa, 0xb4, 0x76, 0x98, 0x10,
-	0x46, 0x4c, 0x90, 0xe2, 0x5a, 0x67, 0xcb, 0xaa, 0xba, 0x84, 0x46, 0x84, 0xd6, 0x9b, 0x88, 0xe2,
-	0x7a, 0x67, 0xab, 0x89, 0x19, 0xda, 0xaa, 0xbb, 0x24, 0x88, 0x85, 0xae, 0x35, 0xeb, 0x13, 0x9f,
-	0xf0, 0xbf, 0xf5, 0xf4, 0x9f, 0x94, 0x2e, 0xfa, 0x84, 0xf8, 0x21, 0xae, 0xa3, 0xc3, 0xa0, 0x8e,
-	0xe2, 0x98, 0x30, 0xc4, 0x02, 0x12, 0x4b, 0xfb, 0xd6, 0x9c, 0xe6, 0x96, 0x1d, 0x1d, 0xe2, 0xae,
-	0x7c, 0x5e, 0x9e, 0xe2, 0xab, 0x66, 0xfb, 0x61, 0x1d, 0xc5, 0x47, 0x62, 0xcb, 0x7e, 0x0a, 0xf3,
-	0xfb, 0xd4, 0x3f, 0xc0, 0xec, 0xed, 0xc4, 0x6d, 0x61, 0xca, 0x12, 0xc4, 0x48, 0x72, 0xcb, 0xf3,
-	0x12, 0x4c, 0xa9, 0xb9, 0x08, 0x13, 0x1d, 0x14, 0x06, 0x5e, 0x2a, 0xab, 0x18, 0xcb, 0xc6, 0xc6,
-	0x44, 0xe3, 0x58, 0x60, 0xda, 0x30, 0x49, 0xb4, 0x43, 0x95, 0x61, 0xae, 0xd0, 0x23, 0x33, 0x97,
-	0xa0, 0x8c, 0x59, 0xcb, 0x41, 0xc2, 0x60, 0x65, 0x84, 0xab, 0x00, 0x66, 0x2d, 0xe9, 0xc2, 0xbe,
-	0x0a, 0x2b, 0x7d, 0xfd, 0x37, 0x30, 0x3d, 0x24, 0x31, 0xc5, 0xf6, 0x33, 0x03, 0xce, 0xef, 0x53,
-	0xff, 0x01, 0x0a, 0x29, 0x66, 0xbb, 0x24, 0x7e, 0x18, 0x24, 0x91, 0x39, 0x0b, 0xa3, 0x31, 0x89,
-	0x5d, 0xcc, 0x81, 0x95, 0x1a, 0x62, 0x71, 0x26, 0xa0, 0xd2, 0xb8, 0x69, 0xe0, 0xc7, 0x88, 0xb5,
-	0x13, 0x5c, 0x29, 0x89, 0xb8, 0x95, 0xc0, 0xb6, 0xa0, 0x92, 0x05, 0xa3, 0x90, 0x7e, 0x6f, 0xc0,
-	0x24, 0x8f, 0x27, 0xf6, 0xee, 0x93, 0x3d, 0xd6, 0x32, 0xe7, 0x60, 0x8c, 0xe2, 0xd8, 0xc3, 0x5d,
-	0xfe, 0xe4, 0xca, 0x9c, 0x87, 0x73, 0x29, 0x06, 0x0f, 0x53, 0x26, 0x31, 0x8e, 0x63, 0xd6, 0xba,
-	0x8d, 0x29, 0x33, 0xff, 0x07, 0x63, 0x28, 0x22, 0xed, 0x98, 0x71, 0x64, 0xe5, 0xed, 0xf9, 0x9a,
-	0x28, 0x95, 0x5a, 0x5a, 0x2a, 0x35, 0x59, 0x2a, 0xb5, 0x5d, 0x12, 0xc4, 0x3b, 0xa5, 0xe7, 0x2f,
-	0x97, 0x86, 0x1a, 0x52, 0xdd, 0xfc, 0x3f, 0x40, 0x33, 0x09, 0x3c, 0x1f, 0x3b, 0x0f, 0xb1, 0xc0,
-	0x3d, 0xc0, 0xe1, 0x09, 0x71, 0xe4, 0x0e, 0xc6, 0xf6, 0x1c, 0xcc, 0xea, 0xd8, 0x55, 0x50, 0x6f,
-	0xc0, 0xcc, 0x3e, 0xf5, 0x1b, 0xf8, 0x83, 0x36, 0xa6, 0x6c, 0x07, 0x31, 0xb7, 0x7f, 0x58, 0xb3,
-	0x30, 0xea, 0xe1, 0x98, 0x44, 0x32, 0x26, 0xb1, 0xb0, 0xe7, 0xe1, 0x72, 0xc6, 0x80, 0xb2, 0xfd,
-	0x9d, 0xc1, 0x8d, 0x4b, 0x1e, 0x85, 0xf1, 0xe2, 0xcc, 0xae, 0xc2, 0x34, 0x23, 0x8f, 0x70, 0xec,
-	0xb8, 0x24, 0x66, 0x09, 0x72, 0xbb, 0xbc, 0x4d, 0x71, 0xe9, 0xae, 0x14, 0x9a, 0x57, 0x20, 0xcd,
-	0xa4, 0x93, 0xa6, 0x0b, 0x27, 0x32, 0xb7, 0x13, 0x98, 0xb5, 0x0e, 0xb8, 0x20, 0x57, 0x1f, 0xa5,
-	0x82, 0xfa, 0xe8, 0x49, 0xff, 0x68, 0x36, 0xfd, 0x22, 0x18, 0x1d, 0xb0, 0x0a, 0xe6, 0x47, 0x03,
-	0x2e, 0x1e, 0xef, 0xbd, 0x45, 0xfc, 0xc0, 0xdd, 0x45, 0x61, 0x68, 0xae, 0xc3, 0x4c, 0x10, 0xcb,
-	0x8b, 0x13, 0x90, 0xd8, 0x09, 0x3c, 0x49, 0xdb, 0xb4, 0x2e, 0xbe, 0xeb, 0x99, 0x9b, 0x60, 0xf6,
-	0x28, 0x0a, 0x1a, 0x86, 0x39, 0x0d, 0x17, 0xf4, 0x9d, 0x7b, 0x9c, 0x92, 0x7f, 0x3c, 0xd6, 0x2b,
-	0xb0, 0x50, 0x10, 0x8f, 0x8a, 0xf7, 0x87, 0x61, 0xad, 0x62, 0x76, 0x79, 0x9d, 0xed, 0x86, 0x28,
-	0x88, 0xf8, 0x0d, 0xeb, 0xe0, 0x98, 0x39, 0x7a, 0x1e, 0x81, 0x8b, 0x04, 0xf2, 0x15, 0x98, 0x6c,
-	0x86, 0xc4, 0x7d, 0xe4, 0xb4, 0x70, 0xe0, 0xb7, 0x98, 0x0c, 0xb1, 0xcc, 0x65, 0x6f, 0x72, 0x51,
-	0x41, 0xbe, 0x47, 0x8a, 0xf2, 0x7d, 0x47, 0xdd, 0x16, 0x1e, 0xde, 0x4e, 0x2d, 0xad, 0xea, 0x5f,
-	0x5f, 0x2e, 0xad, 0xf9, 0x01, 0x6b, 0xb5, 0x9b, 0x35, 0x97, 0x44, 0x75, 0xf9, 0xd4, 0x8a, 0x9f,
-	0x4d, 0xea, 0x3d, 0x92, 0xaf, 0xe3, 0xdd, 0x98, 0xa9, 0xcb, 0xb3, 0x0e, 0x33, 0x98, 0xb5, 0x70,
-	0x82, 0xdb, 0x91, 0x23, 0x4b, 0x5b, 0xd0, 0x31, 0xdd, 0x15, 0x1f, 0x88, 0x12, 0x5f, 0x87, 0x19,
-	0x61, 0xc8, 0x49, 0xb0, 0x8b, 0x83, 0x0e, 0x4e, 0x2a, 0x63, 0x42, 0x51, 0x88, 0x1b, 0x52, 0x9a,
-	0xa3, 0x7f, 0x3c, 0x4f, 0xbf, 0x5d, 0x85, 0xc5, 0x22, 0x02, 0x15, 0xc3, 0xcf, 0x0d, 0x98, 0xdb,
-	0xa7, 0x3e, 0x2f, 0x33, 0x75, 0x31, 0xcf, 0x8e, 0xe3, 0x25, 0x28, 0x37, 0x53, 0xd3, 0xd2, 0xc6,
-	0x88, 0xb0, 0xc1, 0x45, 0xf7, 0xfa, 0x5c, 0xba, 0x52, 0x51, 0x12, 0xb2, 0xa1, 0x8e, 0x16, 0x84,
-	0xba, 0x0c, 0xd5, 0xe2, 0x48, 0x54, 0xb0, 0x5f, 0x0c, 0xc3, 0xa5, 0x7d, 0xea, 0xef, 0x35, 0x76,
-	0xb7, 0x6f, 0xde, 0xc6, 0x87, 0x21, 0x39, 0xc2, 0xde, 0xd9, 0xc5, 0xba, 0x02, 0x93, 0x32, 0x6f,
-	0xe2, 0x85, 0x12, 0xd5, 0x54, 0x16, 0xb2, 0xdb, 0xa9, 0x68, 0xd0, 0x68, 0x4d, 0x28, 0xc5, 0x28,
-	0xea, 0x5e, 0x17, 0xfe, 0x9f, 0x3f, 0x88, 0x47, 0x51, 0x93, 0x84, 0xb2, 0x18, 0xe4, 0xca, 0xb4,
-	0xe0, 0x9c, 0x87, 0xdd, 0x20, 0x42, 0x21, 0xe5, 0x05, 0x50, 0x6a, 0xa8, 0x75, 0x8e, 0xb5, 0x73,
-	0x05, 0xac, 0x2d, 0xc1, 0x95, 0x42, 0x4a, 0x14, 0x69, 0xbf, 0x19, 0xbc, 0x83, 0xab, 0xcb, 0xb9,
-	0xf7, 0x04, 0xbb, 0x6d, 0x76, 0x96, 0xc4, 0x15, 0xbc, 0x5e, 0x29, 0x77, 0x93, 0x03, 0xbe, 0x5e,
-	0xa5, 0x7e, 0xaf, 0xd7, 0x20, 0x45, 0x23, 0xc6, 0x83, 0xe2, 0xe0, 0x14, 0x05, 0x3f, 0x89, 0xba,
-	0x11, 0x1d, 0xf9, 0x9d, 0x43, 0x0f, 0xfd, 0xa5, 0xf0, 0x3b, 0xfc, 0x58, 0xcf, 0x53, 0x5b, 0x16,
-	0xb2, 0x62, 0x86, 0x46, 0xf2, 0x0c, 0xfd, 0x17, 0xc6, 0x23, 0x1c, 0x35, 0x71, 0x42, 0x2b, 0xa5,
-	0xe5, 0x91, 0x8d, 0xf2, 0xf6, 0x42, 0xed, 0x78, 0xd2, 0xab, 0xed, 0xf0, 0x06, 0xfb, 0xa0, 0x3b,
-	0x37, 0x35, 0xba, 0xba, 0xe6, 0x01, 0x4c, 0x25, 0xf8, 0x31, 0x4a, 0x3c, 0x47, 0xbe, 0x60, 0xa3,
-	0x7f, 0xeb, 0x05, 0x9b, 0x14, 0x46, 0x6e, 0x89, 0x77, 0x6c, 0x05, 0xe4, 0xda, 0xe1, 0x45, 0x2b,
-	0xcb, 0xb1, 0x2c, 0x64, 0xf7, 0x53, 0xd1, 0x40, 0x0f, 0x93, 0xa8, 0xbb, 0x3c, 0xa5, 0x8a, 0xf4,
-	0x03, 0x30, 0xd3, 0xd6, 0x80, 0x62, 0x17, 0x87, 0xc7, 0xe3, 0x4e, 0x7a, 0x83, 0x12, 0x14, 0x53,
-	0xe4, 0xea, 0x8d, 0xae, 0xd4, 0x98, 0xd2, 0xa4, 0x77, 0x3d, 0x6d, 0x7c, 0x18, 0xd6, 0xc7, 0x07,
-	0x7b, 0x11, 0xac, 0xbc, 0x51, 0xe5, 0xf2, 0x13, 0x83, 0x83, 0x3a, 0x68, 0x37, 0xa3, 0x80, 0xed,
-	0x20, 0xef, 0xa0, 0xdb, 0xa7, 0xf6, 0x3a, 0x81, 0x87, 0xd3, 0x5c, 0xd5, 0x60, 0x9c, 0xb6, 0x9b,
-	0xef, 0x63, 0x97, 0x71, 0xbf, 0xe5, 0xed, 0xd9, 0x9a, 0x18, 0x7d, 0x6b, 0xdd, 0xd1, 0xb7, 0x76,
-	0x2b, 0x3e, 0x6a, 0x74, 0x95, 0x7a, 0xbb, 0xdf, 0x70, 0xa6, 0xfb, 0x69, 0x28, 0x47, 0x7a, 0x50,
-	0xae, 0xc3, 0xea, 0x89, 0x30, 0xba, 0x80, 0xb7, 0x3f, 0x9e, 0x86, 0x91, 0x7d, 0xea, 0x9b, 0x8f,
-	0x61, 0xaa, 0x77, 0x76, 0x5d, 0xd4, 0xeb, 0x23, 0x3b, 0x4c, 0x5a, 0xd7, 0x4e, 0xda, 0x55, 0x6c,
-	0xd8, 0x1f, 0xfd, 0xfc, 0xc7, 0x57, 0xc3, 0x8b, 0xb6, 0x55, 0xd7, 0xa6, 0x7e, 0x59, 0xcc, 0xae,
-	0xf4, 0xd3, 0x82, 0x89, 0xe3, 0xdc, 0x54, 0x32, 0x66, 0xd5, 0x8e, 0xb5, 0xdc, 0x6f, 0x47, 0x39,
-	0x5b, 0xe2, 0xce, 0xe6, 0xed, 0xcb, 0xba, 0xb3, 0x94, 0x0e, 0x87, 0x11, 0x07, 0xb3, 0x96, 0x49,
-	0x61, 0xb2, 0x67, 0x40, 0x5c, 0xc8, 0x98, 0xd4, 0x37, 0xad, 0xab, 0x27, 0x6c, 0x2a, 0x97, 0x2b,
-	0xdc, 0xe5, 0x82, 0x3d, 0xaf, 0xbb, 0x4c, 0x84, 0xa6, 0xc3, 0x5b, 0x54, 0xea, 0xb4, 0x67, 0x70,
-	0xcc, 0x3a, 0xd5, 0x37, 0x73, 0x4e, 0x0b, 0x27, 0xb8, 0x42, 0xa7, 0x92, 0x4d, 0xe9, 0xf4, 0x29,
-	0x9c, 0xcf, 0x0d, 0x78, 0x4b, 0xc5, 0xb6, 0x95, 0x82, 0xb5, 0x7e, 0x8a, 0x82, 0x02, 0xb0, 0xcc,
-	0x01, 0x58, 0x76, 0x25, 0x07, 0x20, 0x72, 0xc2, 0x54, 0xdb, 0xfc, 0xd4, 0x80, 0x0b, 0xf9, 0x89,
-	0xab, 0x38, 0x85, 0x9a, 0x86, 0xb5, 0x71, 0x9a, 0x86, 0xc2, 0xb0, 0xc1, 0x31, 0xd8, 0xf6, 0x72,
-	0x51, 0xb2, 0x65, 0x0f, 0x75, 0xb9, 0xd7, 0x2f, 0x0d, 0xb8, 0x58, 0x34, 0x9b, 0xd8, 0x19, 0x5f,
-	0x05, 0x3a, 0xd6, 0xbf, 0x4e, 0xd7, 0x51, 0x88, 0x6e, 0x70, 0x44, 0xab, 0xf6, 0x55, 0x1d, 0x91,
-	0x98, 0x5c, 0xb4, 0x22, 0x94, 0xa0, 0x9e, 0x19, 0x70, 0x41, 0x7f, 0xb8, 0x04, 0xa4, 0x95, 0xc2,
-	0x4b, 0xa5, 0x3f, 0x6d, 0xd6, 0xf5, 0x53, 0x55, 0x4e, 0xa6, 0x48, 0x5e, 0xbe, 0xb6, 0x38, 0x20,
-	0xd1, 0x7c, 0x66, 0x80, 0x59, 0x30, 0xd1, 0x64, 0xe1, 0xe4, 0x55, 0x72, 0x70, 0x4e, 0x18, 0x02,
-	0x0a, 0xe1, 0xe0, 0xc4, 0xdd, 0xbe, 0xe9, 0x78, 0xf2, 0x80, 0x84, 0xf3, 0x8d, 0x01, 0x73, 0x7d,
-	0x66, 0x85, 0xd5, 0x8c, 0xbf, 0x62, 0x35, 0x6b, 0x73, 0x20, 0x35, 0x05, 0x6d, 0x93, 0x43, 0x5b,
-	0xb7, 0x57, 0x75, 0x68, 0xbc, 0x92, 0x1d, 0x17, 0x85, 0xa1, 0x83, 0xe5, 0x29, 0x89, 0xef, 0x6b,
-	0x03, 0xe6, 0xfa, 0x7c, 0x8d, 0x58, 0xcd, 0x15, 0x70, 0x91, 0x5a, 0x0e, 0xdf, 0x29, 0xdf, 0x16,
-	0xfe, 0xcd, 0xf1, 0xad, 0xd9, 0xd7, 0x7a, 0x8b, 0x9d, 0x39, 0x7a, 0x3b, 0xec, 0x7e, 0x2b, 0x30,
-	0x3f, 0x34, 0x60, 0x26, 0xdb, 0xf3, 0xaa, 0xd9, 0xbb, 0xdd, 0xbb, 0x6f, 0xad, 0x9d, 0xbc, 0xaf,
-	0x90, 0xac, 0x71, 0x24, 0xcb, 0x76, 0xb5, 0xe7, 0xea, 0x73, 0x65, 0xbd, 0xca, 0xcd, 0x6f, 0x0d,
-	0xb0, 0x4e, 0xe8, 0x81, 0xd9, 0xb2, 0xe9, 0xaf, 0x6a, 0x6d, 0x0d, 0xac, 0xaa, 0x40, 0x6e, 0x71,
-	0x90, 0x37, 0xec, 0xeb, 0x3d, 0x74, 0xf1, 0x73, 0x4e, 0x13, 0x79, 0x8e, 0xea, 0x9e, 0x0e, 0x96,
-	0x47, 0x77, 0xde, 0x7b, 0xfe, 0xaa, 0x6a, 0xbc, 0x78, 0x55, 0x35, 0x7e, 0x7f, 0x55, 0x35, 0x3e,
-	0x7f, 0x5d, 0x1d, 0x7a, 0xf1, 0xba, 0x3a, 0xf4, 0xcb, 0xeb, 0xea, 0xd0, 0xbb, 0x3b, 0xda, 0x84,
-	0x83, 0x42, 0xd6, 0xc2, 0x68, 0x33, 0xc6, 0xac, 0x3b, 0xe5, 0x48, 0x07, 0x9b, 0xe2, 0x03, 0x45,
-	0x3d, 0x22, 0x5e, 0x3b, 0xc4, 0xf5, 0x27, 0xca, 0x31, 0x9f, 0x80, 0x9a, 0x63, 0xbc, 0xb3, 0xff,
-	0xe7, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x61, 0xcd, 0x85, 0x2a, 0x73, 0x13, 0x00, 0x00,
+	// 1944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x9f, 0xb6, 0xf3, 0xf9, 0xec, 0x24, 0x93, 0x9e, 0x6c, 0xc6, 0xe9, 0x24, 0x4e, 0xd2, 0xd9,
+	0x4c, 0x66, 0xd8, 0x8d, 0xbd, 0x09, 0x42, 0x70, 0x02, 0x26, 0x1f, 0xab, 0x19, 0xb1, 0x19, 0x24,
+	0x7b, 0x77, 0x0f, 0x08, 0xa9, 0xd5, 0xee, 0xae, 0x69, 0x37, 0xd3, 0x1f, 0xa1, 0xab, 0xec, 0xdd,
+	0x08, 0x69, 0x25, 0x10, 0x1c, 0xd0, 0x72, 0x40, 0x70, 0x65, 0x8f, 0x48, 0x5c, 0x56, 0xdc, 0xf9,
+	0x0b, 0xf6, 0x84, 0x56, 0xe2, 0x82, 0x38, 0xac, 0xd0, 0x0c, 0x37, 0xce, 0xdc, 0x51, 0xbf, 0xaa,
+	0x2e, 0x97, 0xdb, 0x6d, 0xc7, 0x3b, 0x84, 0x53, 0x52, 0xaf, 0x5e, 0xbd, 0xf7, 0x7b, 0xbf, 0xf7,
+	0xea, 0xf5, 0x2b, 0xc3, 0x1b, 0x5e, 0x62, 0xf7, 0x7d, 0x76, 0xdd, 0xec, 0x1f, 0x37, 0x43, 0xea,
+	0xd1, 0xc6, 0x55, 0x12, 0xb3, 0x58, 0x07, 0x21, 0x6e, 0xf4, 0x8f, 0x8d, 0xba, 0x13, 0xd3, 0x30,
+	0xa6, 0xcd, 0x8e, 0x4d, 0x49, 0xb3, 0x7f, 0xdc, 0x21, 0xcc, 0x3e, 0x6e, 0x3a, 0xb1, 0x1f, 0x71,
+	0x5d, 0x63, 0xcd, 0x8b, 0xbd, 0x18, 0xff, 0x6d, 0xa6, 0xff, 0x09, 0xe9, 0x96, 0x17, 0xc7, 0x5e,
+	0x40, 0x9a, 0xf6, 0x95, 0xdf, 0xb4, 0xa3, 0x28, 0x66, 0x36, 0xf3, 0xe3, 0x48, 0xd8, 0x37, 0xd6,
+	0x15, 0xb7, 0xec, 0xfa, 0x8a, 0x64, 0xf2, 0x0d, 0x71, 0x0a, 0x57, 0x9d, 0xde, 0xf3, 0xa6, 0x1d,
+	0x5d, 0xf3, 0x2d, 0xf3, 0x13, 0xd8, 0xb8, 0xa4, 0x5e, 0x9b, 0xb0, 0x1f, 0x26, 0x4e, 0x97, 0x50,
+	0x96, 0xd8, 0x2c, 0x4e, 0x1e, 0xbb, 0x6e, 0x42, 0x28, 0xd5, 0xb7, 0x60, 0xb1, 0x6f, 0x07, 0xbe,
+	0x9b, 0xca, 0x6a, 0xda, 0xae, 0xf6, 0x70, 0xb1, 0x35, 0x10, 0xe8, 0x26, 0x54, 0x63, 0xe5, 0x50,
+	0xad, 0x84, 0x0a, 0x43, 0x32, 0x7d, 0x07, 0x2a, 0x84, 0x75, 0x2d, 0x9b, 0x1b, 0xac, 0x95, 0x51,
+	0x05, 0x08, 0xeb, 0x0a, 0x17, 0xe6, 0x3e, 0xec, 0x8d, 0xf5, 0xdf, 0x22, 0xf4, 0x2a, 0x8e, 0x28,
+	0x31, 0x3f, 0xd5, 0xe0, 0xee, 0x25, 0xf5, 0x3e, 0xb4, 0x03, 0x4a, 0xd8, 0x59, 0x1c, 0x3d, 0xf7,
+	0x93, 0x50, 0x5f, 0x83, 0xd9, 0x28, 0x8e, 0x1c, 0x82, 0xc0, 0x66, 0x5a, 0x7c, 0x71, 0x2b, 0xa0,
+	0xd2, 0xb8, 0xa9, 0xef, 0x45, 0x36, 0xeb, 0x25, 0xa4, 0x36, 0xc3, 0xe3, 0x96, 0x02, 0xd3, 0x80,
+	0x5a, 0x1e, 0x8c, 0x44, 0xfa, 0x17, 0x0d, 0xaa, 0x18, 0x4f, 0xe4, 0xbe, 0x1f, 0x5f, 0xb0, 0xae,
+	0xbe, 0x0e, 0x73, 0x94, 0x44, 0x2e, 0xc9, 0xf8, 0x13, 0x2b, 0x7d, 0x03, 0x16, 0x52, 0x0c, 0x2e,
+	0xa1, 0x4c, 0x60, 0x9c, 0x27, 0xac, 0x7b, 0x4e, 0x28, 0xd3, 0xbf, 0x0d, 0x73, 0x76, 0x18, 0xf7,
+	0x22, 0x86, 0xc8, 0x2a, 0x27, 0x1b, 0x0d, 0x5e, 0x2a, 0x8d, 0xb4, 0x54, 0x1a, 0xa2, 0x54, 0x1a,
+	0x67, 0xb1, 0x1f, 0x9d, 0xce, 0x7c, 0xf1, 0xd5, 0xce, 0x9d, 0x96, 0x50, 0xd7, 0xbf, 0x0b, 0xd0,
+	0x49, 0x7c, 0xd7, 0x23, 0xd6, 0x73, 0xc2, 0x71, 0x4f, 0x71, 0x78, 0x91, 0x1f, 0x79, 0x97, 0x10,
+	0x73, 0x1d, 0xd6, 0x54, 0xec, 0x32, 0xa8, 0xef, 0xc1, 0xca, 0x25, 0xf5, 0x5a, 0xe4, 0xa7, 0x3d,
+	0x42, 0xd9, 0xa9, 0xcd, 0x9c, 0xf1, 0x61, 0xad, 0xc1, 0xac, 0x4b, 0xa2, 0x38, 0x14, 0x31, 0xf1,
+	0x85, 0xb9, 0x01, 0xf7, 0x73, 0x06, 0xa4, 0xed, 0x3f, 0x6b, 0x68, 0x5c, 0xf0, 0xc8, 0x8d, 0x17,
+	0x67, 0xf6, 0x00, 0x96, 0x59, 0xfc, 0x82, 0x44, 0x96, 0x13, 0x47, 0x2c, 0xb1, 0x9d, 0x8c, 0xb7,
+	0x25, 0x94, 0x9e, 0x09, 0xa1, 0xbe, 0x0d, 0x69, 0x26, 0xad, 0x34, 0x5d, 0x24, 0x11, 0xb9, 0x5d,
+	0x24, 0xac, 0xdb, 0x46, 0xc1, 0x48, 0x7d, 0xcc, 0x14, 0xd4, 0xc7, 0x50, 0xfa, 0x67, 0xf3, 0xe9,
+	0xe7, 0xc1, 0xa8, 0x80, 0x65, 0x30, 0x7f, 0xd5, 0xe0, 0xde, 0x60, 0xef, 0xbd, 0xd8, 0xf3, 0x9d,
+	0x33, 0x3b, 0x08, 0xf4, 0x43, 0x58, 0xf1, 0x23, 0x71, 0x71, 0xfc, 0x38, 0xb2, 0x7c, 0x57, 0xd0,
+	0xb6, 0xac, 0x8a, 0x9f, 0xba, 0xfa, 0x11, 0xe8, 0x43, 0x8a, 0x9c, 0x86, 0x12, 0xd2, 0xb0, 0xaa,
+	0xee, 0x3c, 0x43, 0x4a, 0xfe, 0xef, 0xb1, 0x6e, 0xc3, 0x66, 0x41, 0x3c, 0x32, 0xde, 0x3f, 0x95,
+	0x95, 0x8a, 0x39, 0xc3, 0x3a, 0x3b, 0x0b, 0x6c, 0x3f, 0xc4, 0x1b, 0xd6, 0x27, 0x11, 0xb3, 0xd4,
+	0x3c, 0x02, 0x8a, 0x38, 0xf2, 0x3d, 0xa8, 0x76, 0x82, 0xd8, 0x79, 0x61, 0x75, 0x89, 0xef, 0x75,
+	0x99, 0x08, 0xb1, 0x82, 0xb2, 0x27, 0x28, 0x2a, 0xc8, 0x77, 0xb9, 0x28, 0xdf, 0xef, 0xca, 0xdb,
+	0x82, 0xe1, 0x9d, 0x36, 0xd2, 0xaa, 0xfe, 0xc7, 0x57, 0x3b, 0x0f, 0x3c, 0x9f, 0x75, 0x7b, 0x9d,
+	0x86, 0x13, 0x87, 0x4d, 0xd1, 0x6a, 0xf9, 0x9f, 0x23, 0xea, 0xbe, 0x10, 0xdd, 0xf1, 0x69, 0xc4,
+	0xe4, 0xe5, 0x39, 0x84, 0x15, 0xc2, 0xba, 0x24, 0x21, 0xbd, 0xd0, 0x12, 0xa5, 0xcd, 0xe9, 0x58,
+	0xce, 0xc4, 0x6d, 0x5e, 0xe2, 0x87, 0xb0, 0xc2, 0x0d, 0x59, 0x09, 0x71, 0x88, 0xdf, 0x27, 0x49,
+	0x6d, 0x8e, 0x2b, 0x72, 0x71, 0x4b, 0x48, 0x47, 0xe8, 0x9f, 0x2f, 0xa0, 0x7f, 0x1b, 0x80, 0x07,
+	0x19, 0xd9, 0x21, 0xa9, 0x2d, 0x70, 0xfe, 0x51, 0xf2, 0xcc, 0x0e, 0x91, 0x26, 0xbe, 0x4d, 0xaf,
+	0xc3, 0x4e, 0x1c, 0xd4, 0x16, 0x51, 0xa1, 0x82, 0xb2, 0x36, 0x8a, 0x06, 0x34, 0xb9, 0xc4, 0xf1,
+	0x43, 0x3b, 0xa0, 0x35, 0x40, 0x2e, 0x39, 0x4d, 0xe7, 0x42, 0x68, 0xd6, 0x61, 0xab, 0x28, 0x53,
+	0x32, 0x95, 0x2f, 0x35, 0x58, 0xbf, 0xa4, 0x1e, 0xd6, 0xb3, 0xec, 0x00, 0xb7, 0x97, 0xcc, 0x1d,
+	0xa8, 0x74, 0x52, 0xd3, 0xc2, 0x46, 0x99, 0xdb, 0x40, 0xd1, 0xb3, 0x31, 0xb7, 0x7b, 0xa6, 0x28,
+	0xdb, 0x79, 0x4e, 0x67, 0x0b, 0x38, 0xad, 0xc1, 0x7c, 0x42, 0x02, 0xfb, 0x5a, 0x26, 0x26, 0x5b,
+	0x9a, 0xbb, 0x50, 0x2f, 0x8e, 0x51, 0xd2, 0xf0, 0x79, 0x09, 0xde, 0xb8, 0xa4, 0xde, 0x45, 0xeb,
+	0xec, 0xe4, 0x9d, 0x73, 0x72, 0x15, 0xc4, 0xd7, 0xc4, 0xbd, 0x3d, 0x16, 0xf6, 0xa0, 0x2a, 0x4a,
+	0x87, 0x37, 0x49, 0x5e, 0xd0, 0x15, 0x2e, 0x3b, 0x4f, 0x45, 0xd3, 0xf2, 0xa0, 0xc3, 0x0c, 0x56,
+	0x0c, 0x8f, 0x1f, 0xff, 0xc7, 0x9e, 0xcc, 0xcb, 0x64, 0x4e, 0xf4, 0x64, 0x5c, 0xe9, 0x06, 0x2c,
+	0xc8, 0xda, 0x98, 0x47, 0x50, 0x72, 0x3d, 0xc2, 0xe7, 0x42, 0x01, 0x9f, 0x78, 0x1e, 0xa9, 0x48,
+	0x44, 0x01, 0xca, 0xb5, 0xb9, 0x03, 0xdb, 0x85, 0x74, 0x49, 0x42, 0xff, 0xa3, 0xe1, 0x80, 0x21,
+	0x7b, 0xc7, 0xc5, 0xc7, 0xc4, 0xe9, 0xb1, 0xdb, 0x24, 0xb5, 0xa0, 0xb9, 0xa6, 0xbc, 0x56, 0xa7,
+	0x6c, 0xae, 0x33, 0xe3, 0x9a, 0xeb, 0xff, 0x56, 0x6a, 0x7c, 0xae, 0x29, 0x0e, 0x5b, 0x92, 0xf3,
+	0x6f, 0x5e, 0x6d, 0x7c, 0x94, 0xf8, 0xe0, 0xca, 0xb5, 0xbf, 0x16, 0x31, 0x7d, 0x3c, 0x36, 0xf4,
+	0x8d, 0xa8, 0x70, 0x59, 0x31, 0x77, 0xe5, 0x51, 0xee, 0xbe, 0x05, 0xf3, 0x21, 0x09, 0x3b, 0x24,
+	0xa1, 0xb5, 0x99, 0xdd, 0xf2, 0xc3, 0xca, 0xc9, 0x66, 0x63, 0x30, 0xa2, 0x36, 0x4e, 0x71, 0x32,
+	0xf8, 0x30, 0x1b, 0xf8, 0x5a, 0x99, 0xae, 0xde, 0x86, 0xa5, 0x84, 0x7c, 0x64, 0x27, 0xae, 0x25,
+	0x5a, 0xef, 0xec, 0x6b, 0xb5, 0xde, 0x2a, 0x37, 0xf2, 0x98, 0x37, 0xe0, 0x3d, 0x10, 0x6b, 0x0b,
+	0x4b, 0x5d, 0x10, 0x5a, 0xe1, 0xb2, 0xf7, 0x53, 0xd1, 0x54, 0x1d, 0x55, 0x49, 0xc9, 0xc2, 0x70,
+	0x4a, 0x78, 0xad, 0x8e, 0x92, 0x2d, 0xd3, 0xd1, 0x06, 0x3d, 0xfd, 0xda, 0xd9, 0x91, 0x43, 0x82,
+	0xc1, 0x04, 0x97, 0xde, 0xc8, 0xc4, 0x8e, 0xa8, 0xed, 0xa8, 0xdf, 0xee, 0xb4, 0xc1, 0x0e, 0xa4,
+	0x4f, 0x5d, 0x65, 0x22, 0x2a, 0xa9, 0x13, 0x91, 0xb9, 0x05, 0xc6, 0xa8, 0x51, 0xe9, 0xf2, 0x33,
+	0x0d, 0x2b, 0xa0, 0x45, 0x9c, 0x14, 0xc9, 0x13, 0x12, 0xb8, 0xe7, 0xe4, 0x2a, 0xa6, 0x3e, 0xce,
+	0x31, 0x2e, 0xff, 0x77, 0xe0, 0x72, 0x51, 0x48, 0xc6, 0xbb, 0xd3, 0x2f, 0x01, 0x12, 0xc2, 0x7a,
+	0x49, 0x84, 0x33, 0x60, 0xf9, 0xb5, 0xf2, 0xb2, 0xc8, 0x2d, 0xa4, 0x23, 0x21, 0xe7, 0x6c, 0x14,
+	0x9e, 0x0c, 0xe0, 0x57, 0x1a, 0x6a, 0xb4, 0x7b, 0x9d, 0xd0, 0x67, 0xa7, 0xb6, 0xdb, 0xce, 0x66,
+	0x87, 0x8b, 0xbe, 0xef, 0x92, 0xb4, 0x0c, 0x1b, 0x30, 0x4f, 0x7b, 0x9d, 0x9f, 0x10, 0x87, 0x61,
+	0x14, 0x95, 0x93, 0xb5, 0x06, 0x7f, 0x8e, 0x34, 0xb2, 0xe7, 0x48, 0xe3, 0x71, 0x74, 0xdd, 0xca,
+	0x94, 0x86, 0x27, 0x92, 0x52, 0x6e, 0x22, 0x51, 0xe2, 0x2e, 0x0f, 0xd1, 0x7c, 0x08, 0x07, 0x13,
+	0x61, 0x48, 0xc0, 0x0e, 0xac, 0x48, 0x45, 0x4c, 0x3f, 0xd5, 0xdf, 0x86, 0x39, 0x8c, 0x8f, 0xd6,
+	0xb4, 0xdd, 0xf2, 0x58, 0x80, 0x42, 0x67, 0x9a, 0x17, 0x86, 0xd9, 0x86, 0xfb, 0x39, 0x27, 0x99,
+	0x7f, 0xfd, 0x3b, 0x69, 0x7d, 0xd2, 0x5e, 0xc0, 0x32, 0x6f, 0x35, 0xf5, 0xca, 0xf1, 0x23, 0x2d,
+	0x54, 0x10, 0x03, 0x7a, 0xa6, 0x6e, 0xfe, 0xb1, 0x04, 0xab, 0x03, 0xab, 0x7c, 0x26, 0xa3, 0xfa,
+	0x0f, 0x60, 0x45, 0x34, 0x02, 0x47, 0x88, 0x84, 0xdd, 0x2d, 0xd5, 0x6e, 0xfe, 0xc1, 0x22, 0x6c,
+	0x2f, 0xf7, 0x55, 0x21, 0xd5, 0x9f, 0xc0, 0x32, 0xff, 0x4c, 0x4b, 0x5b, 0xa5, 0xd1, 0xb6, 0x90,
+	0x9b, 0x7e, 0x85, 0xa9, 0x25, 0x3c, 0x28, 0x2d, 0x7d, 0x00, 0xf7, 0x82, 0xb4, 0xf9, 0x59, 0x8e,
+	0x1d, 0x04, 0x03, 0x73, 0x65, 0x34, 0xb7, 0x53, 0x6c, 0x4e, 0x76, 0x4b, 0x61, 0x72, 0x35, 0xc8,
+	0x04, 0xd2, 0xec, 0x14, 0x23, 0x6d, 0xf6, 0xc9, 0x19, 0xe6, 0x49, 0xf2, 0x7f, 0x01, 0x22, 0x68,
+	0xeb, 0xeb, 0xa5, 0x61, 0x89, 0x9f, 0xe2, 0x32, 0xaa, 0x9f, 0x01, 0x0f, 0x58, 0x5a, 0x29, 0x4d,
+	0x65, 0xa5, 0xda, 0x11, 0x0f, 0x06, 0x34, 0xf2, 0x1e, 0xe8, 0x0a, 0x49, 0x99, 0xa5, 0xf2, 0x54,
+	0x96, 0xee, 0x06, 0xca, 0x38, 0x8e, 0xf5, 0xf1, 0x7d, 0xa8, 0xaa, 0x7a, 0xe9, 0x77, 0xdb, 0x76,
+	0x1c, 0x72, 0xc5, 0x08, 0xef, 0x1f, 0x0b, 0x2d, 0xb9, 0x4e, 0x9f, 0x58, 0x24, 0x49, 0x64, 0xf5,
+	0xf2, 0xc5, 0xc9, 0x2f, 0x57, 0xa1, 0x7c, 0x49, 0x3d, 0xfd, 0x23, 0x58, 0x1a, 0x7e, 0x6b, 0x4f,
+	0xac, 0x25, 0xe3, 0xcd, 0x49, 0xbb, 0xf2, 0xe2, 0x99, 0xbf, 0xf8, 0xdb, 0xbf, 0x7e, 0x5f, 0xda,
+	0x32, 0x8d, 0xa6, 0xf2, 0x2b, 0xc5, 0x70, 0xe9, 0xea, 0x5d, 0x58, 0x1c, 0x34, 0xde, 0x5a, 0xce,
+	0xac, 0xdc, 0x31, 0x76, 0xc7, 0xed, 0x48, 0x67, 0x3b, 0xe8, 0x6c, 0xc3, 0xbc, 0xaf, 0x3a, 0x4b,
+	0x5b, 0x85, 0xc5, 0x62, 0x8b, 0xb0, 0xae, 0x4e, 0xa1, 0x3a, 0xf4, 0xa0, 0xcd, 0x57, 0xb8, 0xba,
+	0x69, 0xec, 0x4f, 0xd8, 0x94, 0x2e, 0xf7, 0xd0, 0xe5, 0xa6, 0xb9, 0xa1, 0xba, 0x4c, 0xb8, 0xa6,
+	0x85, 0x69, 0x4f, 0x9d, 0x0e, 0x3d, 0x74, 0x27, 0x5d, 0x2b, 0x63, 0x7f, 0xc2, 0xe6, 0x64, 0xa7,
+	0x82, 0x4d, 0xe1, 0xf4, 0x13, 0xb8, 0x3b, 0xf2, 0x20, 0xbd, 0xe9, 0x02, 0x1a, 0x87, 0x37, 0x28,
+	0x48, 0x00, 0xbb, 0x08, 0xc0, 0x30, 0x6b, 0x23, 0x00, 0x42, 0x0b, 0x2b, 0x54, 0xff, 0xb5, 0x06,
+	0xab, 0xa3, 0x2f, 0xc4, 0xe2, 0x14, 0x2a, 0x1a, 0xc6, 0xc3, 0x9b, 0x34, 0x24, 0x86, 0x87, 0x88,
+	0xc1, 0x34, 0x77, 0x8b, 0x92, 0x2d, 0x06, 0x6e, 0x6c, 0xde, 0xfa, 0xef, 0x34, 0xb8, 0x57, 0xf4,
+	0xc4, 0x31, 0x73, 0xbe, 0x0a, 0x74, 0x8c, 0x6f, 0xdc, 0xac, 0x23, 0x11, 0xbd, 0x85, 0x88, 0x0e,
+	0xcc, 0x7d, 0x15, 0x11, 0xef, 0x17, 0x4a, 0x11, 0x0a, 0x50, 0x9f, 0x6a, 0xb0, 0xaa, 0x4e, 0x25,
+	0x1c, 0xd2, 0x5e, 0xe1, 0xa5, 0x52, 0xe7, 0x16, 0xe3, 0xd1, 0x8d, 0x2a, 0x93, 0x29, 0x12, 0x97,
+	0xaf, 0xc7, 0x0f, 0x08, 0x34, 0xbf, 0xd1, 0x40, 0x2f, 0x78, 0xfe, 0xe4, 0xe1, 0x8c, 0xaa, 0x18,
+	0x8f, 0x6e, 0x54, 0x99, 0x0c, 0x87, 0x24, 0xce, 0xc9, 0x3b, 0x96, 0x78, 0x5a, 0x64, 0x70, 0x3e,
+	0xd3, 0x60, 0x7d, 0xcc, 0xe3, 0xe1, 0x20, 0xe7, 0xaf, 0x58, 0xcd, 0x38, 0x9a, 0x4a, 0x4d, 0x42,
+	0x3b, 0x42, 0x68, 0x87, 0xe6, 0x81, 0x0a, 0x4d, 0xe9, 0xd2, 0x44, 0x9c, 0x12, 0xf8, 0xfe, 0xa0,
+	0xc1, 0xfa, 0x98, 0x5f, 0x4f, 0x0f, 0x46, 0x0a, 0xb8, 0x48, 0xcd, 0x38, 0x9a, 0x4a, 0x4d, 0xe2,
+	0x7b, 0x1b, 0xf1, 0x3d, 0x30, 0xdf, 0x1c, 0x2e, 0x76, 0x66, 0xa9, 0xdf, 0xc0, 0xec, 0xb7, 0x4d,
+	0xfd, 0xe7, 0x1a, 0xac, 0xe4, 0x07, 0xda, 0x7a, 0xfe, 0x6e, 0x0f, 0xef, 0x1b, 0x0f, 0x26, 0xef,
+	0x4b, 0x24, 0x0f, 0x10, 0xc9, 0xae, 0x59, 0x1f, 0xba, 0xfa, 0xa8, 0xac, 0x56, 0xb9, 0xfe, 0xb9,
+	0x06, 0xc6, 0x84, 0xf9, 0x30, 0x5f, 0x36, 0xe3, 0x55, 0x8d, 0xe3, 0xa9, 0x55, 0x25, 0xc8, 0x63,
+	0x04, 0xf9, 0x96, 0xf9, 0x68, 0x88, 0x2e, 0x3c, 0x67, 0x75, 0x6c, 0xd7, 0x92, 0x93, 0xa5, 0x45,
+	0x32, 0x40, 0x34, 0xfb, 0x8e, 0x8a, 0xf1, 0x70, 0xb3, 0xd0, 0x2b, 0xdf, 0x34, 0xf6, 0x27, 0x6c,
+	0x4e, 0xee, 0xd2, 0x02, 0x84, 0x98, 0x2a, 0x7f, 0x06, 0xcb, 0xb9, 0xc1, 0x6e, 0xbb, 0xd8, 0xb2,
+	0xd8, 0x36, 0x0e, 0x26, 0x6e, 0x4b, 0xd7, 0xfb, 0xe8, 0x7a, 0xdb, 0xdc, 0x2c, 0x72, 0x9d, 0xb9,
+	0x4a, 0xef, 0x7c, 0xc1, 0x13, 0x64, 0x6f, 0xe4, 0xb3, 0x97, 0x57, 0x31, 0x1e, 0xdd, 0xa8, 0x32,
+	0xf9, 0xce, 0x27, 0x5c, 0xdf, 0xea, 0x92, 0xc0, 0xb5, 0xc4, 0xeb, 0xe6, 0xf4, 0xc7, 0x5f, 0xbc,
+	0xac, 0x6b, 0x5f, 0xbe, 0xac, 0x6b, 0xff, 0x7c, 0x59, 0xd7, 0x7e, 0xfb, 0xaa, 0x7e, 0xe7, 0xcb,
+	0x57, 0xf5, 0x3b, 0x7f, 0x7f, 0x55, 0xbf, 0xf3, 0xa3, 0x53, 0xe5, 0x05, 0x63, 0x07, 0xac, 0x4b,
+	0xec, 0xa3, 0x88, 0xb0, 0xec, 0x15, 0x23, 0xec, 0x1e, 0xf1, 0x5f, 0xb4, 0x9b, 0x61, 0xec, 0xf6,
+	0x02, 0xd2, 0xfc, 0x58, 0xfa, 0xc3, 0x17, 0x4e, 0x67, 0x0e, 0xa7, 0xfa, 0x6f, 0xfe, 0x77, 0x00,
+	0x73, 0x5c, 0x45, 0xf7, 0xa4, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1676,6 +2168,9 @@ type MsgClient interface {
 	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	SubmitClaims(ctx context.Context, in *MsgSubmitClaims, opts ...grpc.CallOption) (*MsgSubmitClaimsResponse, error)
+	SubmitConfirms(ctx context.Context, in *MsgSubmitConfirms, opts ...grpc.CallOption) (*MsgSubmitConfirmsResponse, error)
+	ReclaimHeldDeposit(ctx context.Context, in *MsgReclaimHeldDeposit, opts ...grpc.CallOption) (*MsgReclaimHeldDepositResponse, error)
 }
 
 type msgClient struct {
@@ -1803,7 +2298,34 @@ func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmi
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
+func (c *msgClient) SubmitClaims(ctx context.Context, in *MsgSubmitClaims, opts ...grpc.CallOption) (*MsgSubmitClaimsResponse, error) {
+	out := new(MsgSubmitClaimsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitConfirms(ctx context.Context, in *MsgSubmitConfirms, opts ...grpc.CallOption) (*MsgSubmitConfirmsResponse, error) {
+	out := new(MsgSubmitConfirmsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitConfirms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReclaimHeldDeposit(ctx context.Context, in *MsgReclaimHeldDeposit, opts ...grpc.CallOption) (*MsgReclaimHeldDepositResponse, error) {
+	out := new(MsgReclaimHeldDepositResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/ReclaimHeldDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
 	SendToEth(context.Context, *MsgSendToEth) (*MsgSendToEthResponse, error)
@@ -1818,6 +2340,9 @@ type MsgServer interface {
 	SetOrchestratorAddress(context.Context, *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	SubmitClaims(context.Context, *MsgSubmitClaims) (*MsgSubmitClaimsResponse, error)
+	SubmitConfirms(context.Context, *MsgSubmitConfirms) (*MsgSubmitConfirmsResponse, error)
+	ReclaimHeldDeposit(context.Context, *MsgReclaimHeldDeposit) (*MsgReclaimHeldDepositResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1863,6 +2388,15 @@ func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCanc
 func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
 }
+func (*UnimplementedMsgServer) SubmitClaims(ctx context.Context, req *MsgSubmitClaims) (*MsgSubmitClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitClaims not implemented")
+}
+func (*UnimplementedMsgServer) SubmitConfirms(ctx context.Context, req *MsgSubmitConfirms) (*MsgSubmitConfirmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitConfirms not implemented")
+}
+func (*UnimplementedMsgServer) ReclaimHeldDeposit(ctx context.Context, req *MsgReclaimHeldDeposit) (*MsgReclaimHeldDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimHeldDeposit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2102,6 +2636,60 @@ func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitClaims)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitClaims(ctx, req.(*MsgSubmitClaims))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitConfirms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitConfirms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitConfirms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitConfirms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitConfirms(ctx, req.(*MsgSubmitConfirms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReclaimHeldDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimHeldDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimHeldDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/ReclaimHeldDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimHeldDeposit(ctx, req.(*MsgReclaimHeldDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2158,6 +2746,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitBadSignatureEvidence",
 			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
 		},
+		{
+			MethodName: "SubmitClaims",
+			Handler:    _Msg_SubmitClaims_Handler,
+		},
+		{
+			MethodName: "SubmitConfirms",
+			Handler:    _Msg_SubmitConfirms_Handler,
+		},
+		{
+			MethodName: "ReclaimHeldDeposit",
+			Handler:    _Msg_ReclaimHeldDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -2620,6 +3220,25 @@ func (m *MsgSendToCosmosClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TokenDecimals != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TokenDecimals))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenSymbol) > 0 {
+		i -= len(m.TokenSymbol)
+		copy(dAtA[i:], m.TokenSymbol)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenSymbol)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TokenName) > 0 {
+		i -= len(m.TokenName)
+		copy(dAtA[i:], m.TokenName)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenName)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -2714,6 +3333,13 @@ func (m *MsgBatchSendToEthClaim) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -2789,6 +3415,13 @@ func (m *MsgERC20DeployedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Deployer) > 0 {
+		i -= len(m.Deployer)
+		copy(dAtA[i:], m.Deployer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Deployer)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -2885,6 +3518,13 @@ func (m *MsgLogicCallExecutedClaim) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -2960,6 +3600,13 @@ func (m *MsgValsetUpdatedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -3097,6 +3744,74 @@ func (m *MsgCancelSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgReclaimHeldDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimHeldDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimHeldDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReturnFee.Size()
+		i -= size
+		if _, err := m.ReturnFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DepositId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.DepositId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimHeldDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimHeldDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimHeldDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3169,81 +3884,339 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSubmitClaims) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetOrchestratorAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSubmitClaims) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitClaims) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgValsetConfirm) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovMsgs(uint64(m.Nonce))
-	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitConfirms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSendToEth) Size() (n int) {
+func (m *MsgSubmitConfirms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitConfirms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitConfirmsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitConfirmsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitConfirmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogicCallResults) > 0 {
+		for iNdEx := len(m.LogicCallResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BatchResults) > 0 {
+		for iNdEx := len(m.BatchResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValsetResults) > 0 {
+		for iNdEx := len(m.ValsetResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetOrchestratorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgValsetConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValsetConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendToEth) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3403,6 +4376,17 @@ func (m *MsgSendToCosmosClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.TokenName)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenSymbol)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.TokenDecimals != 0 {
+		n += 1 + sovMsgs(uint64(m.TokenDecimals))
+	}
 	return n
 }
 
@@ -3438,6 +4422,10 @@ func (m *MsgBatchSendToEthClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -3485,6 +4473,10 @@ func (m *MsgERC20DeployedClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.Deployer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -3520,6 +4512,10 @@ func (m *MsgLogicCallExecutedClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -3563,6 +4559,10 @@ func (m *MsgValsetUpdatedClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -3600,6 +4600,33 @@ func (m *MsgCancelSendToEthResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgReclaimHeldDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositId != 0 {
+		n += 1 + sovMsgs(uint64(m.DepositId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.ReturnFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgReclaimHeldDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3630,20 +4657,128 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
 	return n
 }
 
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *MsgSubmitClaims) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitConfirms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValsetConfirms) > 0 {
+		for _, e := range m.ValsetConfirms {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for _, e := range m.BatchConfirms {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for _, e := range m.LogicCallConfirms {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitConfirmsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValsetResults) > 0 {
+		for _, e := range m.ValsetResults {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.BatchResults) > 0 {
+		for _, e := range m.BatchResults {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.LogicCallResults) > 0 {
+		for _, e := range m.LogicCallResults {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubmitResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowMsgs
 			}
 			if iNdEx >= l {
@@ -5200,6 +6335,89 @@ func (m *MsgSendToCosmosClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDecimals", wireType)
+			}
+			m.TokenDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5427,6 +6645,38 @@ func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5750,6 +7000,38 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deployer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5979,13 +7261,45 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if (iNdEx + skippy) < 0 {
@@ -6274,6 +7588,38 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6508,6 +7854,197 @@ func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgReclaimHeldDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimHeldDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimHeldDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositId", wireType)
+			}
+			m.DepositId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReturnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReclaimHeldDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimHeldDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimHeldDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6714,6 +8251,659 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitClaims) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitClaims: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitClaims: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, &types1.Any{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitClaimsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitClaimsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, SubmitResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitConfirms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitConfirms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitConfirms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitConfirmsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitConfirmsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitConfirmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetResults = append(m.ValsetResults, SubmitResult{})
+			if err := m.ValsetResults[len(m.ValsetResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchResults = append(m.BatchResults, SubmitResult{})
+			if err := m.BatchResults[len(m.BatchResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallResults = append(m.LogicCallResults, SubmitResult{})
+			if err := m.LogicCallResults[len(m.LogicCallResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
@@ -73,3 +74,84 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 	}
 
 }
+
+func TestValidateClaimRelayer(t *testing.T) {
+	var cosmosAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+	specs := map[string]struct {
+		relayer string
+		expErr  bool
+	}{
+		"no relayer":      {},
+		"relayer":         {relayer: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"},
+		"invalid relayer": {relayer: "invalid", expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			claim := MsgBatchSendToEthClaim{
+				EventNonce:    1,
+				BatchNonce:    1,
+				TokenContract: "0x2a24af0501a534fca004ee1bd667b783f205a546",
+				Orchestrator:  cosmosAddress.String(),
+				Relayer:       spec.relayer,
+			}
+			err := claim.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateClaimTokenMetadata(t *testing.T) {
+	var cosmosAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+	specs := map[string]struct {
+		name, symbol string
+		decimals     uint64
+		expErr       bool
+	}{
+		"no metadata":       {},
+		"metadata":          {name: "Dai Stablecoin", symbol: "DAI", decimals: 18},
+		"no decimals":       {name: "Points", symbol: "PTS"},
+		"missing symbol":    {name: "Dai Stablecoin", decimals: 18, expErr: true},
+		"too many decimals": {name: "Dai Stablecoin", symbol: "DAI", decimals: 256, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			claim := MsgSendToCosmosClaim{
+				EventNonce:     1,
+				TokenContract:  "0x2a24af0501a534fca004ee1bd667b783f205a546",
+				Amount:         sdk.NewInt(1),
+				EthereumSender: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+				CosmosReceiver: cosmosAddress.String(),
+				Orchestrator:   cosmosAddress.String(),
+				TokenName:      spec.name,
+				TokenSymbol:    spec.symbol,
+				TokenDecimals:  spec.decimals,
+			}
+			err := claim.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	// claims without metadata keep the hash they had before it was reported
+	claim := MsgSendToCosmosClaim{EventNonce: 1, TokenContract: "0x2a24af0501a534fca004ee1bd667b783f205a546", Amount: sdk.NewInt(1)}
+	checksummed := CanonicalEthAddress(claim.TokenContract)
+	assert.Equal(t, tmhash.Sum([]byte("1/0/"+checksummed+"/1//")), claim.ClaimHash())
+	// the address is hashed in its checksummed form whatever casing the claim reports
+	recased := claim
+	recased.TokenContract = checksummed
+	assert.Equal(t, claim.ClaimHash(), recased.ClaimHash())
+	withMetadata := claim
+	withMetadata.TokenSymbol = "DAI"
+	assert.NotEqual(t, claim.ClaimHash(), withMetadata.ClaimHash())
+
+	// until extended claims are enabled the metadata is dropped, giving the hash of older orchestrators
+	withMetadata.DropExtendedFields()
+	assert.Equal(t, claim.ClaimHash(), withMetadata.ClaimHash())
+}
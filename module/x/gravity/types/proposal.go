@@ -0,0 +1,263 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeERC20Whitelist defines the type for an ERC20WhitelistProposal
+	ProposalTypeERC20Whitelist = "ERC20Whitelist"
+	// ProposalTypeERC20Remap defines the type for an ERC20RemapProposal
+	ProposalTypeERC20Remap = "ERC20Remap"
+	// ProposalTypeTokenPolicy defines the type for a TokenPolicyProposal
+	ProposalTypeTokenPolicy = "TokenPolicy"
+	// ProposalTypeERC20Metadata defines the type for an ERC20MetadataProposal
+	ProposalTypeERC20Metadata = "ERC20Metadata"
+	// ProposalTypeTokenScaling defines the type for a TokenScalingProposal
+	ProposalTypeTokenScaling = "TokenScaling"
+)
+
+var (
+	_ govtypes.Content = &ERC20WhitelistProposal{}
+	_ govtypes.Content = &ERC20RemapProposal{}
+	_ govtypes.Content = &TokenPolicyProposal{}
+	_ govtypes.Content = &ERC20MetadataProposal{}
+	_ govtypes.Content = &TokenScalingProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeERC20Whitelist)
+	govtypes.RegisterProposalTypeCodec(&ERC20WhitelistProposal{}, "gravity/ERC20WhitelistProposal")
+	govtypes.RegisterProposalType(ProposalTypeERC20Remap)
+	govtypes.RegisterProposalTypeCodec(&ERC20RemapProposal{}, "gravity/ERC20RemapProposal")
+	govtypes.RegisterProposalType(ProposalTypeTokenPolicy)
+	govtypes.RegisterProposalTypeCodec(&TokenPolicyProposal{}, "gravity/TokenPolicyProposal")
+	govtypes.RegisterProposalType(ProposalTypeERC20Metadata)
+	govtypes.RegisterProposalTypeCodec(&ERC20MetadataProposal{}, "gravity/ERC20MetadataProposal")
+	govtypes.RegisterProposalType(ProposalTypeTokenScaling)
+	govtypes.RegisterProposalTypeCodec(&TokenScalingProposal{}, "gravity/TokenScalingProposal")
+}
+
+// ValidateBasic checks the denom and the optional ERC20 and deployer of the approval
+func (a ERC20DeploymentApproval) ValidateBasic() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if a.Erc20 != "" {
+		if err := ValidateEthAddress(a.Erc20); err != nil {
+			return sdkerrors.Wrap(err, "erc20")
+		}
+	}
+	if a.Deployer != "" {
+		if err := ValidateEthAddress(a.Deployer); err != nil {
+			return sdkerrors.Wrap(err, "deployer")
+		}
+	}
+	return validateScalingExponent(a.Exponent)
+}
+
+// NewERC20WhitelistProposal creates a new ERC20 whitelist proposal
+func NewERC20WhitelistProposal(title, description string, approvals []ERC20DeploymentApproval) *ERC20WhitelistProposal {
+	return &ERC20WhitelistProposal{title, description, approvals}
+}
+
+// GetTitle returns the title of an ERC20 whitelist proposal
+func (p *ERC20WhitelistProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an ERC20 whitelist proposal
+func (p *ERC20WhitelistProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an ERC20 whitelist proposal
+func (p *ERC20WhitelistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an ERC20 whitelist proposal
+func (p *ERC20WhitelistProposal) ProposalType() string { return ProposalTypeERC20Whitelist }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ERC20WhitelistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Approvals) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "approvals")
+	}
+	denoms := make(map[string]bool, len(p.Approvals))
+	for _, approval := range p.Approvals {
+		if err := approval.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, approval.Denom)
+		}
+		if denoms[approval.Denom] {
+			return sdkerrors.Wrap(ErrDuplicate, approval.Denom)
+		}
+		denoms[approval.Denom] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p ERC20WhitelistProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`ERC20 Whitelist Proposal:
+  Title:       %s
+  Description: %s
+  Approvals:
+`, p.Title, p.Description))
+	for _, approval := range p.Approvals {
+		b.WriteString(fmt.Sprintf("    %s: erc20 %q deployer %q\n", approval.Denom, approval.Erc20, approval.Deployer))
+	}
+	return b.String()
+}
+
+// NewERC20RemapProposal creates a new ERC20 remap proposal
+func NewERC20RemapProposal(title, description, denom, erc20 string, exponent int64) *ERC20RemapProposal {
+	return &ERC20RemapProposal{title, description, denom, erc20, exponent}
+}
+
+// GetTitle returns the title of an ERC20 remap proposal
+func (p *ERC20RemapProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an ERC20 remap proposal
+func (p *ERC20RemapProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an ERC20 remap proposal
+func (p *ERC20RemapProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an ERC20 remap proposal
+func (p *ERC20RemapProposal) ProposalType() string { return ProposalTypeERC20Remap }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ERC20RemapProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return TokenScaling{TokenContract: p.Erc20, Exponent: p.Exponent}.ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (p ERC20RemapProposal) String() string {
+	return fmt.Sprintf(`ERC20 Remap Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  ERC20:       %s
+  Exponent:    %d
+`, p.Title, p.Description, p.Denom, p.Erc20, p.Exponent)
+}
+
+// NewTokenPolicyProposal creates a new token policy proposal
+func NewTokenPolicyProposal(title, description string, policy TokenPolicy) *TokenPolicyProposal {
+	return &TokenPolicyProposal{title, description, policy}
+}
+
+// GetTitle returns the title of a token policy proposal
+func (p *TokenPolicyProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a token policy proposal
+func (p *TokenPolicyProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a token policy proposal
+func (p *TokenPolicyProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a token policy proposal
+func (p *TokenPolicyProposal) ProposalType() string { return ProposalTypeTokenPolicy }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *TokenPolicyProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.Policy.ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (p TokenPolicyProposal) String() string {
+	return fmt.Sprintf(`Token Policy Proposal:
+  Title:       %s
+  Description: %s
+  Mode:        %s
+  Tokens:      %s
+`, p.Title, p.Description, p.Policy.Mode, strings.Join(p.Policy.TokenContracts, ", "))
+}
+
+// NewERC20MetadataProposal creates a new ERC20 metadata proposal
+func NewERC20MetadataProposal(title, description, tokenContract, name, symbol string, decimals uint64) *ERC20MetadataProposal {
+	return &ERC20MetadataProposal{title, description, tokenContract, name, symbol, decimals}
+}
+
+// GetTitle returns the title of an ERC20 metadata proposal
+func (p *ERC20MetadataProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an ERC20 metadata proposal
+func (p *ERC20MetadataProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an ERC20 metadata proposal
+func (p *ERC20MetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an ERC20 metadata proposal
+func (p *ERC20MetadataProposal) ProposalType() string { return ProposalTypeERC20Metadata }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ERC20MetadataProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(p.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	return ValidateERC20Metadata(p.Name, p.Symbol, p.Decimals)
+}
+
+// String implements the Stringer interface
+func (p ERC20MetadataProposal) String() string {
+	return fmt.Sprintf(`ERC20 Metadata Proposal:
+  Title:          %s
+  Description:    %s
+  Token Contract: %s
+  Name:           %s
+  Symbol:         %s
+  Decimals:       %d
+`, p.Title, p.Description, p.TokenContract, p.Name, p.Symbol, p.Decimals)
+}
+
+// NewTokenScalingProposal creates a new token scaling proposal
+func NewTokenScalingProposal(title, description, tokenContract string, exponent int64) *TokenScalingProposal {
+	return &TokenScalingProposal{title, description, tokenContract, exponent}
+}
+
+// GetTitle returns the title of a token scaling proposal
+func (p *TokenScalingProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a token scaling proposal
+func (p *TokenScalingProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a token scaling proposal
+func (p *TokenScalingProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a token scaling proposal
+func (p *TokenScalingProposal) ProposalType() string { return ProposalTypeTokenScaling }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *TokenScalingProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return TokenScaling{TokenContract: p.TokenContract, Exponent: p.Exponent}.ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (p TokenScalingProposal) String() string {
+	return fmt.Sprintf(`Token Scaling Proposal:
+  Title:          %s
+  Description:    %s
+  Token Contract: %s
+  Exponent:       %d
+`, p.Title, p.Description, p.TokenContract, p.Exponent)
+}
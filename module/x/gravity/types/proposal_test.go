@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateERC20WhitelistProposal(t *testing.T) {
+	erc20 := "0x2a24af0501a534fca004ee1bd667b783f205a546"
+	specs := map[string]struct {
+		approvals []ERC20DeploymentApproval
+		expErr    bool
+	}{
+		"denom only":         {approvals: []ERC20DeploymentApproval{{Denom: "stake"}}},
+		"erc20 and deployer": {approvals: []ERC20DeploymentApproval{{Denom: "stake", Erc20: erc20, Deployer: erc20}}},
+		"no approvals":       {expErr: true},
+		"invalid denom":      {approvals: []ERC20DeploymentApproval{{Denom: "1"}}, expErr: true},
+		"invalid erc20":      {approvals: []ERC20DeploymentApproval{{Denom: "stake", Erc20: "invalid"}}, expErr: true},
+		"invalid deployer":   {approvals: []ERC20DeploymentApproval{{Denom: "stake", Deployer: "invalid"}}, expErr: true},
+		"duplicate denom":    {approvals: []ERC20DeploymentApproval{{Denom: "stake"}, {Denom: "stake", Erc20: erc20}}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := NewERC20WhitelistProposal("title", "description", spec.approvals).ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	assert.NoError(t, NewERC20RemapProposal("title", "description", "stake", erc20, 0).ValidateBasic())
+	assert.Error(t, NewERC20RemapProposal("title", "description", "stake", "", 0).ValidateBasic())
+}
+
+func TestValidateERC20MetadataProposal(t *testing.T) {
+	erc20 := "0x2a24af0501a534fca004ee1bd667b783f205a546"
+	assert.NoError(t, NewERC20MetadataProposal("title", "description", erc20, "Dai Stablecoin", "DAI", 18).ValidateBasic())
+	assert.Error(t, NewERC20MetadataProposal("title", "description", "invalid", "Dai Stablecoin", "DAI", 18).ValidateBasic())
+	assert.Error(t, NewERC20MetadataProposal("title", "description", erc20, "Dai Stablecoin", " ", 18).ValidateBasic())
+	assert.Error(t, NewERC20MetadataProposal("title", "description", erc20, "Dai Stablecoin", "DAI", 300).ValidateBasic())
+}
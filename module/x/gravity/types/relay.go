@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// EthereumPowerThreshold is the power threshold the Gravity contract is deployed with, 66% of the
+// math.MaxUint32 total power of a valset. Signatures relayed to the contract must add up to more
+// power than this
+const EthereumPowerThreshold = 2834678415
+
+// NewRelayBundle lines up the hex encoded signatures, keyed by the Ethereum address of the signer,
+// with the members of the current valset. Signatures from addresses that aren't members are left
+// out and members without a signature get an empty one
+func NewRelayBundle(currentValset *Valset, signatures map[string]string) RelayBundle {
+	byAddress := make(map[gethcommon.Address]string, len(signatures))
+	for address, signature := range signatures {
+		byAddress[gethcommon.HexToAddress(address)] = signature
+	}
+
+	bundle := RelayBundle{
+		CurrentValset:       currentValset,
+		Signatures:          make([]RelaySignature, len(currentValset.Members)),
+		SignedPowerFraction: sdk.ZeroDec(),
+	}
+	var signedPower, totalPower uint64
+	for i, member := range currentValset.Members {
+		bundle.Signatures[i] = RelaySignature{EthereumAddress: member.EthereumAddress, Power: member.Power}
+		totalPower += member.Power
+		if !gethcommon.IsHexAddress(member.EthereumAddress) {
+			continue
+		}
+		signature, err := hex.DecodeString(byAddress[gethcommon.HexToAddress(member.EthereumAddress)])
+		if err != nil || len(signature) != 65 {
+			continue
+		}
+		// the contract takes v as 27 or 28, signatures may come with either format
+		v := signature[64]
+		if v < 27 {
+			v += 27
+		}
+		bundle.Signatures[i].V = uint32(v)
+		bundle.Signatures[i].R = "0x" + hex.EncodeToString(signature[:32])
+		bundle.Signatures[i].S = "0x" + hex.EncodeToString(signature[32:64])
+		signedPower += member.Power
+	}
+
+	if totalPower != 0 {
+		bundle.SignedPowerFraction = sdk.NewDecFromBigInt(new(big.Int).SetUint64(signedPower)).
+			Quo(sdk.NewDecFromBigInt(new(big.Int).SetUint64(totalPower)))
+	}
+	bundle.ThresholdMet = signedPower > EthereumPowerThreshold
+	return bundle
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/hex"
+	"math"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRelayBundle(t *testing.T) {
+	checkpoint := crypto.Keccak256([]byte("checkpoint"))
+	var members BridgeValidators
+	signatures := make(map[string]string)
+	for i, power := range []uint64{math.MaxUint32 / 2, math.MaxUint32 / 4, math.MaxUint32 / 4} {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+		// member addresses don't have to match the case of the signer address
+		members = append(members, &BridgeValidator{Power: power, EthereumAddress: strings.ToLower(address)})
+		if i == 2 {
+			continue
+		}
+		signature, err := NewEthereumSignature(checkpoint, key)
+		require.NoError(t, err)
+		signatures[address] = hex.EncodeToString(signature)
+	}
+	// signatures of addresses outside the valset are ignored
+	signatures["0xc783df8a850f42e7F7e57013759C285caa701eB6"] = hex.EncodeToString(make([]byte, 65))
+	valset := &Valset{Nonce: 1, Members: members, RewardAmount: sdk.ZeroInt()}
+
+	bundle := NewRelayBundle(valset, signatures)
+	require.Len(t, bundle.Signatures, 3)
+	for i, signature := range bundle.Signatures[:2] {
+		assert.Equal(t, members[i].EthereumAddress, signature.EthereumAddress)
+		assert.Contains(t, []uint32{27, 28}, signature.V)
+		sig, err := hex.DecodeString(signature.R[2:] + signature.S[2:] + hex.EncodeToString([]byte{byte(signature.V)}))
+		require.NoError(t, err)
+		signer, err := EthAddressFromSignature(checkpoint, sig)
+		require.NoError(t, err)
+		assert.True(t, strings.EqualFold(members[i].EthereumAddress, signer.GetAddress()), "signature %d", i)
+	}
+	assert.Equal(t, RelaySignature{EthereumAddress: members[2].EthereumAddress, Power: members[2].Power}, bundle.Signatures[2])
+	assert.True(t, bundle.SignedPowerFraction.Sub(sdk.NewDecWithPrec(75, 2)).Abs().LT(sdk.NewDecWithPrec(1, 6)))
+	assert.True(t, bundle.ThresholdMet)
+
+	// half the power is below the threshold
+	for address := range signatures {
+		if strings.EqualFold(address, members[1].EthereumAddress) {
+			delete(signatures, address)
+		}
+	}
+	bundle = NewRelayBundle(valset, signatures)
+	assert.False(t, bundle.ThresholdMet)
+	assert.Empty(t, bundle.Signatures[1].R)
+
+	bundle = NewRelayBundle(&Valset{}, signatures)
+	assert.Empty(t, bundle.Signatures)
+	assert.True(t, bundle.SignedPowerFraction.IsZero())
+}
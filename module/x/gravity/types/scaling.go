@@ -0,0 +1,66 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxTokenScalingExponent bounds the decimal scaling between an ERC20 and its Cosmos denom
+const MaxTokenScalingExponent = 36
+
+// ValidateBasic checks the token contract and the bounds of the exponent
+func (s TokenScaling) ValidateBasic() error {
+	if err := ValidateEthAddress(s.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	return validateScalingExponent(s.Exponent)
+}
+
+// validateScalingExponent checks the exponent of a decimal scaling
+func validateScalingExponent(exponent int64) error {
+	if exponent > MaxTokenScalingExponent || exponent < -MaxTokenScalingExponent {
+		return sdkerrors.Wrapf(ErrInvalid, "exponent %d beyond %d", exponent, MaxTokenScalingExponent)
+	}
+	return nil
+}
+
+// unit returns 10 to the absolute exponent, the number of units of the side with more decimals
+// that make up one unit of the other side
+func (s TokenScaling) unit() sdk.Int {
+	exponent := s.Exponent
+	if exponent < 0 {
+		exponent = -exponent
+	}
+	return sdk.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(exponent), nil))
+}
+
+// ToERC20 converts a Cosmos amount to ERC20 units. Amounts of a denom with more decimals than its
+// ERC20 that can't be represented on Ethereum without rounding are rejected
+func (s TokenScaling) ToERC20(amount sdk.Int) (sdk.Int, error) {
+	switch {
+	case s.Exponent > 0:
+		return amount.Mul(s.unit()), nil
+	case s.Exponent < 0:
+		if !amount.Mod(s.unit()).IsZero() {
+			return sdk.Int{}, sdkerrors.Wrapf(ErrInvalid, "amount %s is not a multiple of %s", amount, s.unit())
+		}
+		return amount.Quo(s.unit()), nil
+	default:
+		return amount, nil
+	}
+}
+
+// FromERC20 converts ERC20 units to a Cosmos amount, the ERC20 units that don't make up a Cosmos
+// unit are returned as dust
+func (s TokenScaling) FromERC20(amount sdk.Int) (cosmos sdk.Int, dust sdk.Int) {
+	switch {
+	case s.Exponent > 0:
+		return amount.Quo(s.unit()), amount.Mod(s.unit())
+	case s.Exponent < 0:
+		return amount.Mul(s.unit()), sdk.ZeroInt()
+	default:
+		return amount, sdk.ZeroInt()
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenScaling(t *testing.T) {
+	contract := "0x2a24af0501a534fca004ee1bd667b783f205a546"
+	specs := map[string]struct {
+		exponent int64
+		cosmos   int64
+		erc20    int64
+		dust     int64
+		expErr   bool
+	}{
+		"unscaled":                {exponent: 0, cosmos: 123, erc20: 123},
+		"erc20 more decimals":     {exponent: 3, cosmos: 12, erc20: 12000},
+		"erc20 with dust":         {exponent: 3, cosmos: 12, erc20: 12345, dust: 345},
+		"erc20 fewer decimals":    {exponent: -2, cosmos: 1200, erc20: 12},
+		"cosmos amount not exact": {exponent: -2, cosmos: 1234, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			scaling := TokenScaling{TokenContract: contract, Exponent: spec.exponent}
+			require.NoError(t, scaling.ValidateBasic())
+
+			erc20, err := scaling.ToERC20(sdk.NewInt(spec.cosmos))
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, sdk.NewInt(spec.erc20).Sub(sdk.NewInt(spec.dust)), erc20)
+
+			cosmos, dust := scaling.FromERC20(sdk.NewInt(spec.erc20))
+			assert.Equal(t, sdk.NewInt(spec.cosmos), cosmos)
+			assert.Equal(t, sdk.NewInt(spec.dust), dust)
+		})
+	}
+
+	assert.Error(t, TokenScaling{TokenContract: contract, Exponent: MaxTokenScalingExponent + 1}.ValidateBasic())
+	assert.Error(t, TokenScaling{TokenContract: contract, Exponent: -MaxTokenScalingExponent - 1}.ValidateBasic())
+	assert.Error(t, TokenScaling{TokenContract: "invalid"}.ValidateBasic())
+}
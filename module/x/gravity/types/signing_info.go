@@ -0,0 +1,47 @@
+package types
+
+// Record adds whether the validator missed the next valset, batch, logic call or claim to the
+// counter. Items older than the window are dropped from the missed counter as they are overwritten,
+// a change of the window starts the bit array over, total_missed is kept
+func (c *BridgeSigningCounter) Record(window uint64, missed bool) {
+	if window == 0 {
+		return
+	}
+	if c.Window != window || uint64(len(c.MissedBitArray))*8 < window {
+		c.Window = window
+		c.IndexOffset = 0
+		c.MissedCounter = 0
+		c.MissedBitArray = make([]byte, (window+7)/8)
+	}
+
+	index := c.IndexOffset % window
+	byteIndex, bit := index/8, byte(1)<<(index%8)
+	previous := c.MissedBitArray[byteIndex]&bit != 0
+	switch {
+	case missed && !previous:
+		c.MissedBitArray[byteIndex] |= bit
+		c.MissedCounter++
+	case !missed && previous:
+		c.MissedBitArray[byteIndex] &^= bit
+		c.MissedCounter--
+	}
+	if missed {
+		c.TotalMissed++
+	}
+	c.IndexOffset++
+}
+
+// StartOver starts the window of the counter over, total_missed is kept
+func (c *BridgeSigningCounter) StartOver() {
+	c.IndexOffset = 0
+	c.MissedCounter = 0
+	c.MissedBitArray = make([]byte, len(c.MissedBitArray))
+}
+
+// Recorded returns the number of items within the window the counter has a record of
+func (c BridgeSigningCounter) Recorded() uint64 {
+	if c.IndexOffset < c.Window {
+		return c.IndexOffset
+	}
+	return c.Window
+}
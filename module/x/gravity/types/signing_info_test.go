@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBridgeSigningCounterRecord(t *testing.T) {
+	var c BridgeSigningCounter
+	for _, missed := range []bool{true, false, true, true} {
+		c.Record(3, missed)
+	}
+	// the first miss fell out of the window of three
+	assert.Equal(t, uint64(2), c.MissedCounter)
+	assert.Equal(t, uint64(3), c.TotalMissed)
+	assert.Equal(t, uint64(4), c.IndexOffset)
+	assert.Equal(t, uint64(3), c.Recorded())
+
+	c.Record(3, false)
+	c.Record(3, false)
+	assert.Equal(t, uint64(1), c.MissedCounter)
+	assert.Equal(t, uint64(3), c.TotalMissed)
+
+	// a new window starts over but keeps the total
+	c.Record(20, true)
+	assert.Equal(t, uint64(1), c.MissedCounter)
+	assert.Equal(t, uint64(4), c.TotalMissed)
+	assert.Equal(t, uint64(1), c.Recorded())
+	assert.Len(t, c.MissedBitArray, 3)
+
+	c.StartOver()
+	assert.Equal(t, uint64(0), c.MissedCounter)
+	assert.Equal(t, uint64(0), c.Recorded())
+	assert.Equal(t, uint64(4), c.TotalMissed)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic checks the mode and the listed token contracts of the policy
+func (p TokenPolicy) ValidateBasic() error {
+	if _, ok := TokenPolicyMode_name[int32(p.Mode)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "token policy mode %d", p.Mode)
+	}
+	if p.Mode == TOKEN_POLICY_MODE_ALLOW_ALL && len(p.TokenContracts) != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "allow all policy lists tokens")
+	}
+	seen := make(map[EthAddress]bool, len(p.TokenContracts))
+	for _, contract := range p.TokenContracts {
+		ethAddress, err := NewEthAddress(contract)
+		if err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		if seen[*ethAddress] {
+			return sdkerrors.Wrap(ErrDuplicate, contract)
+		}
+		seen[*ethAddress] = true
+	}
+	return nil
+}
+
+// Allows returns true if deposits of the Ethereum originated token are bridged under the policy,
+// token contracts are compared as ethereum addresses so their casing doesn't matter
+func (p TokenPolicy) Allows(tokenContract string) bool {
+	listed := false
+	for _, contract := range p.TokenContracts {
+		if SameEthAddress(contract, tokenContract) {
+			listed = true
+			break
+		}
+	}
+	switch p.Mode {
+	case TOKEN_POLICY_MODE_ALLOW_LIST:
+		return listed
+	case TOKEN_POLICY_MODE_DENY_LIST:
+		return !listed
+	default:
+		return true
+	}
+}
+
+// ValidateBasic checks the token, amount and addresses of the held deposit
+func (d HeldDeposit) ValidateBasic() error {
+	if err := ValidateEthAddress(d.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	if d.Amount.IsNil() || !d.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalid, "amount")
+	}
+	if err := ValidateEthAddress(d.EthereumSender); err != nil {
+		return sdkerrors.Wrap(err, "ethereum sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(d.CosmosReceiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, d.CosmosReceiver)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenPolicy(t *testing.T) {
+	listed := "0x2a24af0501a534fca004ee1bd667b783f205a546"
+	other := "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+	specs := map[string]struct {
+		policy       TokenPolicy
+		expErr       bool
+		expListed    bool
+		expNotListed bool
+	}{
+		"allow all":          {policy: TokenPolicy{}, expListed: true, expNotListed: true},
+		"allow list":         {policy: TokenPolicy{Mode: TOKEN_POLICY_MODE_ALLOW_LIST, TokenContracts: []string{listed}}, expListed: true},
+		"empty allow list":   {policy: TokenPolicy{Mode: TOKEN_POLICY_MODE_ALLOW_LIST}},
+		"deny list":          {policy: TokenPolicy{Mode: TOKEN_POLICY_MODE_DENY_LIST, TokenContracts: []string{listed}}, expNotListed: true},
+		"allow all listing":  {policy: TokenPolicy{TokenContracts: []string{listed}}, expErr: true, expListed: true, expNotListed: true},
+		"unknown mode":       {policy: TokenPolicy{Mode: 3}, expErr: true, expListed: true, expNotListed: true},
+		"invalid contract":   {policy: TokenPolicy{Mode: TOKEN_POLICY_MODE_DENY_LIST, TokenContracts: []string{"invalid"}}, expErr: true, expListed: true, expNotListed: true},
+		"duplicate contract": {policy: TokenPolicy{Mode: TOKEN_POLICY_MODE_DENY_LIST, TokenContracts: []string{listed, "0x" + strings.ToUpper(listed[2:])}}, expErr: true, expNotListed: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := NewTokenPolicyProposal("title", "description", spec.policy).ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, spec.expListed, spec.policy.Allows("0x"+strings.ToUpper(listed[2:])))
+			assert.Equal(t, spec.expNotListed, spec.policy.Allows(other))
+		})
+	}
+}
@@ -78,6 +78,18 @@ func (b BridgeValidators) Sort() {
 // set, after all the validators retained their relative percentages during inflation and normalized Gravity bridge power
 // shows no difference.
 func (b BridgeValidators) PowerDiff(c BridgeValidators) float64 {
+	return math.Abs(float64(b.powerDelta(c)) / float64(math.MaxUint32))
+}
+
+// PowerDiffExceeds returns whether the PowerDiff of the two bridge validator sets is above the threshold,
+// computed without floats so every node comes to the same result
+func (b BridgeValidators) PowerDiffExceeds(c BridgeValidators, threshold sdk.Dec) bool {
+	delta := sdk.NewIntFromUint64(b.powerDelta(c)).ToDec()
+	return delta.GT(threshold.MulInt64(math.MaxUint32))
+}
+
+// powerDelta returns the sum of the absolute power changes between two bridge validator sets
+func (b BridgeValidators) powerDelta(c BridgeValidators) uint64 {
 	powers := map[string]int64{}
 	// loop over b and initialize the map with their powers
 	for _, bv := range b {
@@ -94,13 +106,15 @@ func (b BridgeValidators) PowerDiff(c BridgeValidators) float64 {
 		}
 	}
 
-	var delta float64
+	var delta uint64
 	for _, v := range powers {
 		// NOTE: we care about the absolute value of the changes
-		delta += math.Abs(float64(v))
+		if v < 0 {
+			v = -v
+		}
+		delta += uint64(v)
 	}
-
-	return math.Abs(delta / float64(math.MaxUint32))
+	return delta
 }
 
 // TotalPower returns the total power in the bridge validator set
@@ -229,6 +243,16 @@ func (v *Valset) WithoutEmptyMembers() *Valset {
 	return &r
 }
 
+// HasMember tells whether the valset has a member with the given Ethereum address
+func (v Valset) HasMember(ethAddress EthAddress) bool {
+	for _, member := range v.Members {
+		if addr, err := NewEthAddress(member.EthereumAddress); err == nil && *addr == ethAddress {
+			return true
+		}
+	}
+	return false
+}
+
 // Valsets is a collection of valset
 type Valsets []*Valset
 
@@ -25,6 +25,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CheckpointType is the kind of object an Ethereum signature checkpoint was
+// produced from
+type CheckpointType int32
+
+const (
+	CHECKPOINT_TYPE_UNSPECIFIED CheckpointType = 0
+	CHECKPOINT_TYPE_VALSET      CheckpointType = 1
+	CHECKPOINT_TYPE_BATCH       CheckpointType = 2
+	CHECKPOINT_TYPE_LOGIC_CALL  CheckpointType = 3
+)
+
+var CheckpointType_name = map[int32]string{
+	0: "CHECKPOINT_TYPE_UNSPECIFIED",
+	1: "CHECKPOINT_TYPE_VALSET",
+	2: "CHECKPOINT_TYPE_BATCH",
+	3: "CHECKPOINT_TYPE_LOGIC_CALL",
+}
+
+var CheckpointType_value = map[string]int32{
+	"CHECKPOINT_TYPE_UNSPECIFIED": 0,
+	"CHECKPOINT_TYPE_VALSET":      1,
+	"CHECKPOINT_TYPE_BATCH":       2,
+	"CHECKPOINT_TYPE_LOGIC_CALL":  3,
+}
+
+func (x CheckpointType) String() string {
+	return proto.EnumName(CheckpointType_name, int32(x))
+}
+
+func (CheckpointType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{0}
+}
+
+// TokenPolicyMode decides which Ethereum originated tokens are bridged to
+// Cosmos. Cosmos originated tokens are always bridged back
+type TokenPolicyMode int32
+
+const (
+	// every token is bridged
+	TOKEN_POLICY_MODE_ALLOW_ALL TokenPolicyMode = 0
+	// only the listed tokens are bridged
+	TOKEN_POLICY_MODE_ALLOW_LIST TokenPolicyMode = 1
+	// every token but the listed ones is bridged
+	TOKEN_POLICY_MODE_DENY_LIST TokenPolicyMode = 2
+)
+
+var TokenPolicyMode_name = map[int32]string{
+	0: "TOKEN_POLICY_MODE_ALLOW_ALL",
+	1: "TOKEN_POLICY_MODE_ALLOW_LIST",
+	2: "TOKEN_POLICY_MODE_DENY_LIST",
+}
+
+var TokenPolicyMode_value = map[string]int32{
+	"TOKEN_POLICY_MODE_ALLOW_ALL":  0,
+	"TOKEN_POLICY_MODE_ALLOW_LIST": 1,
+	"TOKEN_POLICY_MODE_DENY_LIST":  2,
+}
+
+func (x TokenPolicyMode) String() string {
+	return proto.EnumName(TokenPolicyMode_name, int32(x))
+}
+
+func (TokenPolicyMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{1}
+}
+
 // BridgeValidator represents a validator's ETH address and its power
 type BridgeValidator struct {
 	Power           uint64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
@@ -210,9 +276,13 @@ func (m *LastObservedEthereumBlockHeight) GetEthereumBlockHeight() uint64 {
 
 // This records the relationship between an ERC20 token and the denom
 // of the corresponding Cosmos originated asset
+// unlock_only
+// The ERC20 was remapped to a newer one for the denom, deposits of it still
+// unlock the denom but transfers out go through the newer ERC20
 type ERC20ToDenom struct {
-	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Erc20      string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	UnlockOnly bool   `protobuf:"varint,3,opt,name=unlock_only,json=unlockOnly,proto3" json:"unlock_only,omitempty"`
 }
 
 func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
@@ -262,310 +332,3074 @@ func (m *ERC20ToDenom) GetDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
-	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
-	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+func (m *ERC20ToDenom) GetUnlockOnly() bool {
+	if m != nil {
+		return m.UnlockOnly
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
-
-var fileDescriptor_163831c23fcc179f = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xfb, 0x11, 0xd4, 0x6d, 0x51, 0xc1, 0x2d, 0x95, 0x55, 0x24, 0xa7, 0xe4, 0x80, 0xc2,
-	0x21, 0xde, 0x26, 0x88, 0x0b, 0xb7, 0x1a, 0x2a, 0x81, 0x84, 0x84, 0x64, 0xaa, 0x1e, 0x10, 0x92,
-	0xb5, 0xb6, 0x47, 0xb6, 0x15, 0xdb, 0x13, 0xed, 0x6e, 0x5c, 0xfa, 0x03, 0xb8, 0xf3, 0xb3, 0x7a,
-	0xec, 0x11, 0x71, 0xa8, 0x50, 0x22, 0xfe, 0x07, 0xda, 0x0f, 0x43, 0x80, 0xd3, 0xee, 0x7b, 0x3b,
-	0xfb, 0x66, 0xe6, 0xcd, 0x90, 0xa3, 0x9c, 0xb3, 0xb6, 0x94, 0xd7, 0xb4, 0x9d, 0x50, 0x79, 0x3d,
-	0x07, 0x11, 0xcc, 0x39, 0x4a, 0x74, 0x89, 0xe5, 0x83, 0x76, 0x72, 0xec, 0xa7, 0x28, 0x6a, 0x14,
-	0x34, 0x61, 0x02, 0x68, 0x3b, 0x49, 0x40, 0xb2, 0x09, 0x4d, 0xb1, 0x6c, 0x4c, 0xec, 0xf1, 0x61,
-	0x8e, 0x39, 0xea, 0x2b, 0x55, 0x37, 0xc3, 0x0e, 0x23, 0xb2, 0x1f, 0xf2, 0x32, 0xcb, 0xe1, 0x92,
-	0x55, 0x65, 0xc6, 0x24, 0x72, 0xf7, 0x90, 0x6c, 0xcf, 0xf1, 0x0a, 0xb8, 0xe7, 0x9c, 0x38, 0xa3,
-	0xad, 0xc8, 0x00, 0xf7, 0x19, 0x79, 0x00, 0xb2, 0x00, 0x0e, 0x8b, 0x3a, 0x66, 0x59, 0xc6, 0x41,
-	0x08, 0x6f, 0xe3, 0xc4, 0x19, 0xed, 0x44, 0xfb, 0x1d, 0x7f, 0x66, 0xe8, 0xe1, 0x4f, 0x87, 0xf4,
-	0x2f, 0x59, 0x25, 0x40, 0x2a, 0xad, 0x06, 0x9b, 0x14, 0x3a, 0x2d, 0x0d, 0xdc, 0x17, 0xe4, 0x5e,
-	0x0d, 0x75, 0x02, 0x5c, 0x49, 0x6c, 0x8e, 0x76, 0xa7, 0x8f, 0x83, 0x3f, 0x8d, 0x04, 0xff, 0xd4,
-	0x13, 0x75, 0xb1, 0xee, 0x11, 0xe9, 0x17, 0x50, 0xe6, 0x85, 0xf4, 0x36, 0xb5, 0x9a, 0x45, 0xee,
-	0x07, 0x72, 0x9f, 0xc3, 0x15, 0xe3, 0x59, 0xcc, 0x6a, 0x5c, 0x34, 0xd2, 0xdb, 0x52, 0x75, 0x85,
-	0xc1, 0xcd, 0xdd, 0xa0, 0xf7, 0xfd, 0x6e, 0xf0, 0x34, 0x2f, 0x65, 0xb1, 0x48, 0x82, 0x14, 0x6b,
-	0x6a, 0x3d, 0x32, 0xc7, 0x58, 0x64, 0x33, 0x6b, 0xe7, 0xdb, 0x46, 0x46, 0x7b, 0x46, 0xe4, 0x4c,
-	0x6b, 0xb8, 0x4f, 0x88, 0xc5, 0xb1, 0xc4, 0x19, 0x34, 0xde, 0xb6, 0xee, 0x75, 0xd7, 0x70, 0x17,
-	0x8a, 0x1a, 0x7e, 0x71, 0xc8, 0xe0, 0x1d, 0x13, 0xf2, 0x7d, 0x22, 0x80, 0xb7, 0x90, 0x9d, 0x5b,
-	0x1f, 0xc2, 0x0a, 0xd3, 0xd9, 0x1b, 0x53, 0x5b, 0x40, 0x0e, 0x4c, 0xb2, 0x38, 0x51, 0x6c, 0x6c,
-	0x1b, 0x30, 0x76, 0x3c, 0x34, 0x4f, 0xeb, 0xf1, 0x53, 0xf2, 0xe8, 0xb7, 0xcd, 0x7f, 0xfd, 0xd8,
-	0xd0, 0x3f, 0x0e, 0xe0, 0xff, 0x1c, 0xc3, 0x97, 0x64, 0xef, 0x3c, 0x7a, 0x35, 0x3d, 0xbd, 0xc0,
-	0xd7, 0xd0, 0x60, 0xad, 0x4c, 0x07, 0x9e, 0x4e, 0x4f, 0x75, 0x96, 0x9d, 0xc8, 0x00, 0xc5, 0x66,
-	0xea, 0xd9, 0x4e, 0xcd, 0x80, 0xf0, 0xd3, 0xcd, 0xd2, 0x77, 0x6e, 0x97, 0xbe, 0xf3, 0x63, 0xe9,
-	0x3b, 0x5f, 0x57, 0x7e, 0xef, 0x76, 0xe5, 0xf7, 0xbe, 0xad, 0xfc, 0xde, 0xc7, 0x70, 0xcd, 0x36,
-	0x56, 0xc9, 0x02, 0xd8, 0xb8, 0x01, 0xd9, 0x59, 0x67, 0xe7, 0x35, 0x4e, 0xf4, 0xb0, 0x68, 0x8d,
-	0xd9, 0xa2, 0x02, 0xfa, 0x99, 0x76, 0x8b, 0xaa, 0x6d, 0x4d, 0xfa, 0x7a, 0xc9, 0x9e, 0xff, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0xef, 0xd3, 0xc6, 0xb8, 0xc0, 0x02, 0x00, 0x00,
+// PastEthSignatureCheckpoint records which valset, batch or logic call a
+// checkpoint signed by the validators was produced from. The nonce is the
+// valset nonce, the batch nonce or the logic call invalidation nonce, the
+// token contract is only set for batches and the invalidation id only for
+// logic calls
+type PastEthSignatureCheckpoint struct {
+	Checkpoint     []byte         `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Type           CheckpointType `protobuf:"varint,2,opt,name=type,proto3,enum=gravity.v1.CheckpointType" json:"type,omitempty"`
+	Nonce          uint64         `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TokenContract  string         `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	InvalidationId []byte         `protobuf:"bytes,5,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	// the cosmos block height the checkpoint was stored at
+	Height uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PastEthSignatureCheckpoint) Reset()         { *m = PastEthSignatureCheckpoint{} }
+func (m *PastEthSignatureCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*PastEthSignatureCheckpoint) ProtoMessage()    {}
+func (*PastEthSignatureCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{4}
+}
+func (m *PastEthSignatureCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PastEthSignatureCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PastEthSignatureCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PastEthSignatureCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PastEthSignatureCheckpoint.Merge(m, src)
+}
+func (m *PastEthSignatureCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *PastEthSignatureCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PastEthSignatureCheckpoint.DiscardUnknown(m)
 }
 
-func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Power != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_PastEthSignatureCheckpoint proto.InternalMessageInfo
+
+func (m *PastEthSignatureCheckpoint) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Valset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PastEthSignatureCheckpoint) GetType() CheckpointType {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return CHECKPOINT_TYPE_UNSPECIFIED
 }
 
-func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PastEthSignatureCheckpoint) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardToken) > 0 {
-		i -= len(m.RewardToken)
-		copy(dAtA[i:], m.RewardToken)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.RewardAmount.Size()
-		i -= size
-		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Height != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *PastEthSignatureCheckpoint) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *PastEthSignatureCheckpoint) GetInvalidationId() []byte {
+	if m != nil {
+		return m.InvalidationId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PastEthSignatureCheckpoint) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BridgeSigningCounter tracks how often a validator missed one kind of bridge
+// duty. The bit array holds one bit per item within the last window, set if
+// it was missed, and is indexed by index_offset modulo the window. The missed
+// counter is the number of bits set, total_missed counts every miss since the
+// record was started
+type BridgeSigningCounter struct {
+	IndexOffset    uint64 `protobuf:"varint,1,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	MissedCounter  uint64 `protobuf:"varint,2,opt,name=missed_counter,json=missedCounter,proto3" json:"missed_counter,omitempty"`
+	TotalMissed    uint64 `protobuf:"varint,3,opt,name=total_missed,json=totalMissed,proto3" json:"total_missed,omitempty"`
+	MissedBitArray []byte `protobuf:"bytes,4,opt,name=missed_bit_array,json=missedBitArray,proto3" json:"missed_bit_array,omitempty"`
+	// the window the bit array was sized for
+	Window uint64 `protobuf:"varint,5,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthereumBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CosmosBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *BridgeSigningCounter) Reset()         { *m = BridgeSigningCounter{} }
+func (m *BridgeSigningCounter) String() string { return proto.CompactTextString(m) }
+func (*BridgeSigningCounter) ProtoMessage()    {}
+func (*BridgeSigningCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{5}
+}
+func (m *BridgeSigningCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeSigningCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeSigningCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BridgeSigningCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeSigningCounter.Merge(m, src)
+}
+func (m *BridgeSigningCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeSigningCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeSigningCounter.DiscardUnknown(m)
 }
 
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BridgeSigningCounter proto.InternalMessageInfo
+
+func (m *BridgeSigningCounter) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BridgeSigningCounter) GetMissedCounter() uint64 {
+	if m != nil {
+		return m.MissedCounter
+	}
+	return 0
 }
 
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+func (m *BridgeSigningCounter) GetTotalMissed() uint64 {
+	if m != nil {
+		return m.TotalMissed
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BridgeSigningCounter) GetMissedBitArray() []byte {
+	if m != nil {
+		return m.MissedBitArray
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *BridgeValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Power != 0 {
-		n += 1 + sovTypes(uint64(m.Power))
-	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+func (m *BridgeSigningCounter) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	return n
+	return 0
 }
 
-func (m *Valset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+// ValidatorBridgeSigningInfo records how reliably a validator confirmed
+// valsets, batches and logic calls and voted on claims that were observed.
+// start_height is the block the record was started at, downtime_jailings
+// counts how often the validator was jailed by graduated bridge slashing and
+// jailed_valset_nonce is the latest valset nonce at the last of those
+type ValidatorBridgeSigningInfo struct {
+	ValidatorAddress  string               `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	StartHeight       int64                `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Valsets           BridgeSigningCounter `protobuf:"bytes,3,opt,name=valsets,proto3" json:"valsets"`
+	Batches           BridgeSigningCounter `protobuf:"bytes,4,opt,name=batches,proto3" json:"batches"`
+	LogicCalls        BridgeSigningCounter `protobuf:"bytes,5,opt,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
+	Claims            BridgeSigningCounter `protobuf:"bytes,6,opt,name=claims,proto3" json:"claims"`
+	DowntimeJailings  uint64               `protobuf:"varint,7,opt,name=downtime_jailings,json=downtimeJailings,proto3" json:"downtime_jailings,omitempty"`
+	JailedValsetNonce uint64               `protobuf:"varint,8,opt,name=jailed_valset_nonce,json=jailedValsetNonce,proto3" json:"jailed_valset_nonce,omitempty"`
+}
+
+func (m *ValidatorBridgeSigningInfo) Reset()         { *m = ValidatorBridgeSigningInfo{} }
+func (m *ValidatorBridgeSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBridgeSigningInfo) ProtoMessage()    {}
+func (*ValidatorBridgeSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{6}
+}
+func (m *ValidatorBridgeSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBridgeSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBridgeSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBridgeSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBridgeSigningInfo.Merge(m, src)
+}
+func (m *ValidatorBridgeSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBridgeSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBridgeSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBridgeSigningInfo proto.InternalMessageInfo
+
+func (m *ValidatorBridgeSigningInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorBridgeSigningInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ValidatorBridgeSigningInfo) GetValsets() BridgeSigningCounter {
+	if m != nil {
+		return m.Valsets
+	}
+	return BridgeSigningCounter{}
+}
+
+func (m *ValidatorBridgeSigningInfo) GetBatches() BridgeSigningCounter {
+	if m != nil {
+		return m.Batches
+	}
+	return BridgeSigningCounter{}
+}
+
+func (m *ValidatorBridgeSigningInfo) GetLogicCalls() BridgeSigningCounter {
+	if m != nil {
+		return m.LogicCalls
+	}
+	return BridgeSigningCounter{}
+}
+
+func (m *ValidatorBridgeSigningInfo) GetClaims() BridgeSigningCounter {
+	if m != nil {
+		return m.Claims
+	}
+	return BridgeSigningCounter{}
+}
+
+func (m *ValidatorBridgeSigningInfo) GetDowntimeJailings() uint64 {
+	if m != nil {
+		return m.DowntimeJailings
+	}
+	return 0
+}
+
+func (m *ValidatorBridgeSigningInfo) GetJailedValsetNonce() uint64 {
+	if m != nil {
+		return m.JailedValsetNonce
+	}
+	return 0
+}
+
+// RelayerStats accumulates what an Ethereum relayer was observed relaying,
+// fees holds the batch and logic call fees it collected per token contract,
+// valset_rewards the valset relaying rewards
+type RelayerStats struct {
+	Relayer           string       `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Batches           uint64       `protobuf:"varint,2,opt,name=batches,proto3" json:"batches,omitempty"`
+	Valsets           uint64       `protobuf:"varint,3,opt,name=valsets,proto3" json:"valsets,omitempty"`
+	LogicCalls        uint64       `protobuf:"varint,4,opt,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
+	Fees              []ERC20Token `protobuf:"bytes,5,rep,name=fees,proto3" json:"fees"`
+	ValsetRewards     []ERC20Token `protobuf:"bytes,6,rep,name=valset_rewards,json=valsetRewards,proto3" json:"valset_rewards"`
+	LastRelayedHeight uint64       `protobuf:"varint,7,opt,name=last_relayed_height,json=lastRelayedHeight,proto3" json:"last_relayed_height,omitempty"`
+}
+
+func (m *RelayerStats) Reset()         { *m = RelayerStats{} }
+func (m *RelayerStats) String() string { return proto.CompactTextString(m) }
+func (*RelayerStats) ProtoMessage()    {}
+func (*RelayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{7}
+}
+func (m *RelayerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerStats.Merge(m, src)
+}
+func (m *RelayerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerStats proto.InternalMessageInfo
+
+func (m *RelayerStats) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetBatches() uint64 {
+	if m != nil {
+		return m.Batches
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetValsets() uint64 {
+	if m != nil {
+		return m.Valsets
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetLogicCalls() uint64 {
+	if m != nil {
+		return m.LogicCalls
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetFees() []ERC20Token {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *RelayerStats) GetValsetRewards() []ERC20Token {
+	if m != nil {
+		return m.ValsetRewards
+	}
+	return nil
+}
+
+func (m *RelayerStats) GetLastRelayedHeight() uint64 {
+	if m != nil {
+		return m.LastRelayedHeight
+	}
+	return 0
+}
+
+// TokenPolicy is the governance managed policy for Ethereum originated
+// tokens, token_contracts is the allow or deny list of the mode
+type TokenPolicy struct {
+	Mode           TokenPolicyMode `protobuf:"varint,1,opt,name=mode,proto3,enum=gravity.v1.TokenPolicyMode" json:"mode,omitempty"`
+	TokenContracts []string        `protobuf:"bytes,2,rep,name=token_contracts,json=tokenContracts,proto3" json:"token_contracts,omitempty"`
+}
+
+func (m *TokenPolicy) Reset()         { *m = TokenPolicy{} }
+func (m *TokenPolicy) String() string { return proto.CompactTextString(m) }
+func (*TokenPolicy) ProtoMessage()    {}
+func (*TokenPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{8}
+}
+func (m *TokenPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPolicy.Merge(m, src)
+}
+func (m *TokenPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPolicy proto.InternalMessageInfo
+
+func (m *TokenPolicy) GetMode() TokenPolicyMode {
+	if m != nil {
+		return m.Mode
+	}
+	return TOKEN_POLICY_MODE_ALLOW_ALL
+}
+
+func (m *TokenPolicy) GetTokenContracts() []string {
+	if m != nil {
+		return m.TokenContracts
+	}
+	return nil
+}
+
+// HeldDeposit is a deposit of an Ethereum originated token the token policy
+// did not allow to be bridged. The cosmos receiver can reclaim it, which
+// releases the vouchers once the token is allowed or otherwise returns the
+// tokens to the ethereum sender
+type HeldDeposit struct {
+	Id             uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventNonce     uint64                                 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	TokenContract  string                                 `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	EthereumSender string                                 `protobuf:"bytes,5,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string                                 `protobuf:"bytes,6,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	// the cosmos block height the deposit was held at
+	HeldHeight uint64 `protobuf:"varint,7,opt,name=held_height,json=heldHeight,proto3" json:"held_height,omitempty"`
+}
+
+func (m *HeldDeposit) Reset()         { *m = HeldDeposit{} }
+func (m *HeldDeposit) String() string { return proto.CompactTextString(m) }
+func (*HeldDeposit) ProtoMessage()    {}
+func (*HeldDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{9}
+}
+func (m *HeldDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeldDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldDeposit.Merge(m, src)
+}
+func (m *HeldDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldDeposit proto.InternalMessageInfo
+
+func (m *HeldDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HeldDeposit) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *HeldDeposit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *HeldDeposit) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *HeldDeposit) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *HeldDeposit) GetHeldHeight() uint64 {
+	if m != nil {
+		return m.HeldHeight
+	}
+	return 0
+}
+
+// TokenScaling is the decimal scaling between the ERC20 amounts of a token
+// and the amounts of its Cosmos denom, an ERC20 amount is the Cosmos amount
+// times 10^exponent. A positive exponent gives the ERC20 more decimals, the
+// ERC20 units of deposits that don't make up a Cosmos unit are kept as dust
+type TokenScaling struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Exponent      int64  `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *TokenScaling) Reset()         { *m = TokenScaling{} }
+func (m *TokenScaling) String() string { return proto.CompactTextString(m) }
+func (*TokenScaling) ProtoMessage()    {}
+func (*TokenScaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{10}
+}
+func (m *TokenScaling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenScaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenScaling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenScaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenScaling.Merge(m, src)
+}
+func (m *TokenScaling) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenScaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenScaling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenScaling proto.InternalMessageInfo
+
+func (m *TokenScaling) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *TokenScaling) GetExponent() int64 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+// DenomTrace links the denom of the vouchers of an Ethereum originated ERC20
+// to the EIP-55 checksummed address of its contract. Vouchers are named
+// gravity/<hash of the contract>, vouchers of tokens bridged before denom
+// traces keep their earlier gravity0x... denom as an alias
+type DenomTrace struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+}
+
+func (m *DenomTrace) Reset()         { *m = DenomTrace{} }
+func (m *DenomTrace) String() string { return proto.CompactTextString(m) }
+func (*DenomTrace) ProtoMessage()    {}
+func (*DenomTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{11}
+}
+func (m *DenomTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTrace.Merge(m, src)
+}
+func (m *DenomTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTrace proto.InternalMessageInfo
+
+func (m *DenomTrace) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTrace) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("gravity.v1.CheckpointType", CheckpointType_name, CheckpointType_value)
+	proto.RegisterEnum("gravity.v1.TokenPolicyMode", TokenPolicyMode_name, TokenPolicyMode_value)
+	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
+	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
+	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*PastEthSignatureCheckpoint)(nil), "gravity.v1.PastEthSignatureCheckpoint")
+	proto.RegisterType((*BridgeSigningCounter)(nil), "gravity.v1.BridgeSigningCounter")
+	proto.RegisterType((*ValidatorBridgeSigningInfo)(nil), "gravity.v1.ValidatorBridgeSigningInfo")
+	proto.RegisterType((*RelayerStats)(nil), "gravity.v1.RelayerStats")
+	proto.RegisterType((*TokenPolicy)(nil), "gravity.v1.TokenPolicy")
+	proto.RegisterType((*HeldDeposit)(nil), "gravity.v1.HeldDeposit")
+	proto.RegisterType((*TokenScaling)(nil), "gravity.v1.TokenScaling")
+	proto.RegisterType((*DenomTrace)(nil), "gravity.v1.DenomTrace")
+}
+
+func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
+
+var fileDescriptor_163831c23fcc179f = []byte{
+	// 1285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdf, 0x6f, 0x1b, 0xc5,
+	0x13, 0xcf, 0xd9, 0xae, 0xd3, 0x8c, 0x1d, 0xc7, 0xbd, 0xb6, 0x91, 0xbf, 0x6e, 0xe5, 0xe4, 0x6b,
+	0x09, 0x08, 0x45, 0xb5, 0x9b, 0x20, 0x5e, 0x11, 0xb6, 0xe3, 0x36, 0xa6, 0x6e, 0x1c, 0xce, 0xa6,
+	0xa8, 0x80, 0x74, 0x5a, 0xdf, 0x4d, 0xec, 0x25, 0xe7, 0x5d, 0xeb, 0x6e, 0xe3, 0xd4, 0xe2, 0x19,
+	0x89, 0x27, 0xc4, 0x9f, 0x80, 0x04, 0xff, 0x0a, 0x52, 0x1f, 0xfb, 0x84, 0x10, 0x0f, 0x15, 0x6a,
+	0xc5, 0x0b, 0x7f, 0x05, 0xda, 0x1f, 0xe7, 0x9c, 0xd3, 0x54, 0xa8, 0xe2, 0x25, 0xb9, 0xf9, 0xcc,
+	0x8f, 0xdd, 0xf9, 0xcc, 0xec, 0x8c, 0x61, 0x73, 0x14, 0x92, 0x19, 0x15, 0xf3, 0xfa, 0x6c, 0xb7,
+	0x2e, 0xe6, 0x53, 0x8c, 0x6a, 0xd3, 0x90, 0x0b, 0x6e, 0x83, 0xc1, 0x6b, 0xb3, 0xdd, 0x72, 0xc5,
+	0xe3, 0xd1, 0x84, 0x47, 0xf5, 0x21, 0x89, 0xb0, 0x3e, 0xdb, 0x1d, 0xa2, 0x20, 0xbb, 0x75, 0x8f,
+	0x53, 0xa6, 0x6d, 0xcb, 0x37, 0x46, 0x7c, 0xc4, 0xd5, 0x67, 0x5d, 0x7e, 0x19, 0xf4, 0x76, 0x22,
+	0x32, 0x11, 0x02, 0x23, 0x41, 0x04, 0xe5, 0xc6, 0xa7, 0xea, 0xc0, 0x46, 0x33, 0xa4, 0xfe, 0x08,
+	0x1f, 0x93, 0x80, 0xfa, 0x44, 0xf0, 0xd0, 0xbe, 0x01, 0x57, 0xa6, 0xfc, 0x0c, 0xc3, 0x92, 0xb5,
+	0x6d, 0xed, 0x64, 0x1c, 0x2d, 0xd8, 0xef, 0x43, 0x11, 0xc5, 0x18, 0x43, 0x3c, 0x9d, 0xb8, 0xc4,
+	0xf7, 0x43, 0x8c, 0xa2, 0x52, 0x6a, 0xdb, 0xda, 0x59, 0x73, 0x36, 0x62, 0xbc, 0xa1, 0xe1, 0xea,
+	0x5f, 0x16, 0x64, 0x1f, 0x93, 0x20, 0x42, 0x21, 0x63, 0x31, 0xce, 0x3c, 0x8c, 0x63, 0x29, 0xc1,
+	0xfe, 0x08, 0x56, 0x27, 0x38, 0x19, 0x62, 0x28, 0x43, 0xa4, 0x77, 0x72, 0x7b, 0xb7, 0x6a, 0xe7,
+	0x69, 0xd6, 0x2e, 0xdc, 0xc7, 0x89, 0x6d, 0xed, 0x4d, 0xc8, 0x8e, 0x91, 0x8e, 0xc6, 0xa2, 0x94,
+	0x56, 0xd1, 0x8c, 0x64, 0xf7, 0x61, 0x3d, 0xc4, 0x33, 0x12, 0xfa, 0x2e, 0x99, 0xf0, 0x53, 0x26,
+	0x4a, 0x19, 0x79, 0xaf, 0x66, 0xed, 0xd9, 0x8b, 0xad, 0x95, 0x3f, 0x5e, 0x6c, 0xbd, 0x3b, 0xa2,
+	0x62, 0x7c, 0x3a, 0xac, 0x79, 0x7c, 0x52, 0x37, 0x0c, 0xea, 0x7f, 0x77, 0x23, 0xff, 0xc4, 0x90,
+	0xdd, 0x61, 0xc2, 0xc9, 0xeb, 0x20, 0x0d, 0x15, 0xc3, 0xfe, 0x3f, 0x18, 0xd9, 0x15, 0xfc, 0x04,
+	0x59, 0xe9, 0x8a, 0xca, 0x35, 0xa7, 0xb1, 0x81, 0x84, 0xaa, 0xdf, 0x59, 0xb0, 0xd5, 0x25, 0x91,
+	0xe8, 0x0d, 0x23, 0x0c, 0x67, 0xe8, 0xb7, 0x0d, 0x0f, 0xcd, 0x80, 0x7b, 0x27, 0x07, 0xfa, 0x6e,
+	0x35, 0xb8, 0xae, 0x0f, 0x73, 0x87, 0x12, 0x75, 0x4d, 0x02, 0x9a, 0x8e, 0x6b, 0x5a, 0x95, 0xb4,
+	0xdf, 0x83, 0x9b, 0x0b, 0x9a, 0x97, 0x3c, 0x52, 0xca, 0xe3, 0x3a, 0xbe, 0x7e, 0x46, 0xf5, 0x2b,
+	0xc8, 0xb7, 0x9d, 0xd6, 0xde, 0xbd, 0x01, 0xdf, 0x47, 0xc6, 0x27, 0x92, 0x74, 0x0c, 0xbd, 0xbd,
+	0x7b, 0xea, 0x94, 0x35, 0x47, 0x0b, 0x12, 0xf5, 0xa5, 0xda, 0x54, 0x4d, 0x0b, 0xf6, 0x16, 0xe4,
+	0x4e, 0x99, 0x3a, 0x87, 0xb3, 0x60, 0xae, 0x88, 0xbd, 0xea, 0x80, 0x86, 0x7a, 0x2c, 0x98, 0x57,
+	0xff, 0xb6, 0xa0, 0x7c, 0x44, 0x22, 0xd1, 0x16, 0xe3, 0x3e, 0x1d, 0x31, 0x22, 0x4e, 0x43, 0x6c,
+	0x8d, 0xd1, 0x3b, 0x99, 0x72, 0xca, 0x84, 0x5d, 0x01, 0xf0, 0x16, 0x92, 0x3a, 0x30, 0xef, 0x24,
+	0x10, 0xbb, 0x06, 0x19, 0xc9, 0xb0, 0x3a, 0xb4, 0xb0, 0x57, 0x4e, 0xd6, 0xf9, 0x3c, 0xca, 0x60,
+	0x3e, 0x45, 0x47, 0xd9, 0x9d, 0x37, 0x4c, 0x3a, 0xd9, 0x30, 0xef, 0x40, 0x41, 0x55, 0xc1, 0xf5,
+	0x38, 0x13, 0x21, 0xf1, 0x4c, 0x89, 0x9d, 0x75, 0x85, 0xb6, 0x0c, 0x68, 0xbf, 0x07, 0x1b, 0x94,
+	0xcd, 0x74, 0xe3, 0x50, 0xce, 0x5c, 0xea, 0xab, 0xb2, 0xe5, 0x9d, 0x42, 0x12, 0xee, 0xf8, 0x89,
+	0x4e, 0xca, 0x26, 0x3b, 0xa9, 0xfa, 0xab, 0x05, 0x37, 0x74, 0xfb, 0xc9, 0x5c, 0x29, 0x1b, 0xb5,
+	0x64, 0x2f, 0x60, 0x28, 0xbb, 0x81, 0x32, 0x1f, 0x9f, 0xba, 0xfc, 0xf8, 0x38, 0xc2, 0xb8, 0x7e,
+	0x39, 0x85, 0xf5, 0x14, 0x24, 0xef, 0x38, 0xa1, 0x51, 0x84, 0xbe, 0xeb, 0x69, 0x27, 0x53, 0xb2,
+	0x75, 0x8d, 0x26, 0x22, 0x09, 0x2e, 0x48, 0xe0, 0x6a, 0xd8, 0xe4, 0x99, 0x53, 0xd8, 0x23, 0x05,
+	0xd9, 0x3b, 0x50, 0x34, 0x91, 0x86, 0x54, 0xb8, 0x24, 0x0c, 0xc9, 0x5c, 0xe5, 0x9b, 0x77, 0xcc,
+	0x09, 0x4d, 0x2a, 0x1a, 0x12, 0x95, 0x79, 0x9c, 0x51, 0xe6, 0xf3, 0x33, 0x95, 0x67, 0xc6, 0x31,
+	0x52, 0xf5, 0xb7, 0x34, 0x94, 0x17, 0x0f, 0x68, 0x29, 0xa1, 0x0e, 0x3b, 0xe6, 0xf6, 0x07, 0x70,
+	0x6d, 0x16, 0x6b, 0x17, 0x8f, 0x59, 0x37, 0x4b, 0x71, 0xa1, 0x30, 0xaf, 0x59, 0x5e, 0x38, 0x12,
+	0x24, 0x14, 0xc9, 0x46, 0x4c, 0x3b, 0x39, 0x85, 0x99, 0xa6, 0xfd, 0x04, 0x56, 0x67, 0xea, 0xbd,
+	0x47, 0x2a, 0x9d, 0xdc, 0xde, 0xf6, 0xeb, 0xef, 0x79, 0x99, 0xd0, 0x66, 0x46, 0x3e, 0x4e, 0x27,
+	0x76, 0x93, 0x11, 0x86, 0x44, 0x78, 0x63, 0x8c, 0x4a, 0x99, 0xb7, 0x8b, 0x60, 0xdc, 0xec, 0x07,
+	0x90, 0x0b, 0xf8, 0x88, 0x7a, 0xae, 0x47, 0x82, 0x20, 0x2a, 0x5d, 0x79, 0xab, 0x28, 0xa0, 0x5c,
+	0x5b, 0xd2, 0xd3, 0xfe, 0x18, 0xb2, 0x5e, 0x40, 0xe8, 0x24, 0x2a, 0x65, 0xdf, 0x2a, 0x86, 0xf1,
+	0x92, 0xe4, 0xfa, 0xfc, 0x8c, 0x09, 0x3a, 0x41, 0xf7, 0x1b, 0x42, 0x03, 0xca, 0x46, 0x51, 0x69,
+	0x55, 0x95, 0xa7, 0x18, 0x2b, 0x3e, 0x35, 0xb8, 0x1c, 0x0f, 0xd2, 0x06, 0x7d, 0x57, 0x33, 0xe1,
+	0xea, 0xe6, 0xbf, 0xaa, 0xc7, 0x83, 0x56, 0xe9, 0x51, 0x7a, 0x28, 0x15, 0xd5, 0x5f, 0x52, 0x90,
+	0x77, 0x30, 0x20, 0x73, 0x0c, 0xfb, 0x82, 0x88, 0xc8, 0x2e, 0xc1, 0x6a, 0xa8, 0x65, 0x53, 0xc0,
+	0x58, 0x94, 0x9a, 0x98, 0x52, 0xdd, 0x88, 0x0b, 0xaa, 0x4a, 0xcb, 0xe5, 0xca, 0x9c, 0x97, 0x61,
+	0x6b, 0x99, 0xc4, 0x8c, 0xd2, 0x26, 0xc9, 0xb9, 0x07, 0x99, 0x63, 0x44, 0x49, 0xaf, 0x1c, 0xdb,
+	0x9b, 0x49, 0x6a, 0xcc, 0x08, 0x3a, 0x41, 0x66, 0x08, 0x51, 0x96, 0x76, 0x0b, 0x0a, 0x26, 0x35,
+	0x3d, 0x3a, 0x25, 0xad, 0xff, 0xee, 0xbb, 0xae, 0x7d, 0x1c, 0xed, 0x22, 0x69, 0x0a, 0x48, 0x24,
+	0x5c, 0x9d, 0x9b, 0x1f, 0xb7, 0xa2, 0x66, 0xf5, 0x9a, 0x54, 0x69, 0x52, 0x7c, 0x33, 0x11, 0x47,
+	0x90, 0x53, 0xd1, 0x8e, 0x78, 0x40, 0xbd, 0xb9, 0x5d, 0x87, 0xcc, 0x84, 0xfb, 0x7a, 0x09, 0x15,
+	0x96, 0x97, 0x4d, 0xc2, 0xec, 0x11, 0xf7, 0xd1, 0x51, 0x86, 0x72, 0x90, 0x2c, 0xcf, 0x1b, 0xbd,
+	0xa8, 0xd6, 0x9c, 0xc2, 0xd2, 0xc0, 0x89, 0xaa, 0x3f, 0xa5, 0x20, 0x77, 0x80, 0x81, 0xbf, 0x8f,
+	0x53, 0x1e, 0x51, 0x61, 0x17, 0x20, 0x45, 0x7d, 0x33, 0x1d, 0x52, 0xd4, 0x97, 0x84, 0xe2, 0x0c,
+	0x59, 0x5c, 0x57, 0x5d, 0x08, 0x50, 0xd0, 0xe1, 0x1b, 0x26, 0x5b, 0xfa, 0xb2, 0xc9, 0x76, 0x1f,
+	0xb2, 0xff, 0x69, 0xb7, 0x19, 0x6f, 0x99, 0xd8, 0x62, 0xbd, 0x44, 0xc8, 0x7c, 0x0c, 0xcd, 0x62,
+	0x2b, 0xc4, 0x70, 0x5f, 0xa1, 0xd2, 0xd0, 0xec, 0xad, 0x10, 0x3d, 0xa4, 0x33, 0x0c, 0xd5, 0x73,
+	0x58, 0x73, 0x0a, 0x1a, 0x76, 0x0c, 0x2a, 0x33, 0x1c, 0x63, 0x70, 0xa1, 0x24, 0x20, 0x21, 0x53,
+	0x8b, 0xcf, 0x20, 0xaf, 0x48, 0xee, 0x7b, 0x44, 0xf6, 0xfc, 0x25, 0x19, 0x5b, 0x97, 0x65, 0x5c,
+	0x86, 0xab, 0xf8, 0x74, 0xca, 0x19, 0xb2, 0x78, 0xe4, 0x2c, 0xe4, 0x6a, 0x07, 0x40, 0x6d, 0xba,
+	0x41, 0x48, 0x3c, 0x3c, 0x5f, 0x6c, 0x56, 0x72, 0xb1, 0xbd, 0x7e, 0x4c, 0xea, 0x92, 0x63, 0xee,
+	0xfc, 0x60, 0x41, 0x61, 0x79, 0x11, 0xd9, 0x5b, 0x70, 0xab, 0x75, 0xd0, 0x6e, 0x3d, 0x3c, 0xea,
+	0x75, 0x0e, 0x07, 0xee, 0xe0, 0xc9, 0x51, 0xdb, 0xfd, 0xfc, 0xb0, 0x7f, 0xd4, 0x6e, 0x75, 0xee,
+	0x77, 0xda, 0xfb, 0xc5, 0x15, 0xbb, 0x0c, 0x9b, 0x17, 0x0d, 0x1e, 0x37, 0xba, 0xfd, 0xf6, 0xa0,
+	0x68, 0xd9, 0xff, 0x83, 0x9b, 0x17, 0x75, 0xcd, 0xc6, 0xa0, 0x75, 0x50, 0x4c, 0xd9, 0x15, 0x28,
+	0x5f, 0x54, 0x75, 0x7b, 0x0f, 0x3a, 0x2d, 0xb7, 0xd5, 0xe8, 0x76, 0x8b, 0xe9, 0x72, 0xe6, 0xfb,
+	0x9f, 0x2b, 0x2b, 0x77, 0xbe, 0x85, 0x8d, 0x0b, 0x3d, 0x29, 0x2f, 0x34, 0xe8, 0x3d, 0x6c, 0x1f,
+	0xba, 0x47, 0xbd, 0x6e, 0xa7, 0xf5, 0xc4, 0x7d, 0xd4, 0xdb, 0x6f, 0xbb, 0x8d, 0x6e, 0xb7, 0xf7,
+	0x85, 0xfc, 0x5b, 0x5c, 0xb1, 0xb7, 0xe1, 0xf6, 0x9b, 0x0c, 0xba, 0x9d, 0xbe, 0xbc, 0xd6, 0xa5,
+	0x21, 0xf6, 0xdb, 0x87, 0x4f, 0xb4, 0x41, 0x4a, 0x1f, 0xde, 0xfc, 0xfa, 0xd9, 0xcb, 0x8a, 0xf5,
+	0xfc, 0x65, 0xc5, 0xfa, 0xf3, 0x65, 0xc5, 0xfa, 0xf1, 0x55, 0x65, 0xe5, 0xf9, 0xab, 0xca, 0xca,
+	0xef, 0xaf, 0x2a, 0x2b, 0x5f, 0x36, 0x13, 0x8d, 0x46, 0x02, 0x31, 0x46, 0x72, 0x97, 0xa1, 0x88,
+	0x9b, 0xcd, 0x3c, 0xa8, 0xbb, 0x43, 0x35, 0x1e, 0xeb, 0x13, 0xee, 0x9f, 0x06, 0x58, 0x7f, 0x5a,
+	0x37, 0xb8, 0x6e, 0xc4, 0x61, 0x56, 0xfd, 0xe4, 0xfc, 0xf0, 0x9f, 0x01, 0x00, 0xd2, 0x56, 0x9e,
+	0x27, 0xec, 0x0a, 0x00, 0x00,
+}
+
+func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Power != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Valset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardToken) > 0 {
+		i -= len(m.RewardToken)
+		copy(dAtA[i:], m.RewardToken)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockOnly {
+		i--
+		if m.UnlockOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PastEthSignatureCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PastEthSignatureCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PastEthSignatureCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checkpoint) > 0 {
+		i -= len(m.Checkpoint)
+		copy(dAtA[i:], m.Checkpoint)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Checkpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeSigningCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeSigningCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeSigningCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MissedBitArray) > 0 {
+		i -= len(m.MissedBitArray)
+		copy(dAtA[i:], m.MissedBitArray)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MissedBitArray)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalMissed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalMissed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBridgeSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBridgeSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBridgeSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedValsetNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.JailedValsetNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DowntimeJailings != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DowntimeJailings))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Claims.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.LogicCalls.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Batches.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Valsets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastRelayedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastRelayedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ValsetRewards) > 0 {
+		for iNdEx := len(m.ValsetRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LogicCalls != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LogicCalls))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Valsets != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Valsets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Batches != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Batches))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContracts) > 0 {
+		for iNdEx := len(m.TokenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenContracts[iNdEx])
+			copy(dAtA[i:], m.TokenContracts[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeldHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HeldHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenScaling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenScaling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenScaling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BridgeValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Valset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.RewardAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.RewardToken)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LastObservedEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.UnlockOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *PastEthSignatureCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checkpoint)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BridgeSigningCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexOffset != 0 {
+		n += 1 + sovTypes(uint64(m.IndexOffset))
+	}
+	if m.MissedCounter != 0 {
+		n += 1 + sovTypes(uint64(m.MissedCounter))
+	}
+	if m.TotalMissed != 0 {
+		n += 1 + sovTypes(uint64(m.TotalMissed))
+	}
+	l = len(m.MissedBitArray)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovTypes(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *ValidatorBridgeSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	l = m.Valsets.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Batches.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LogicCalls.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Claims.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.DowntimeJailings != 0 {
+		n += 1 + sovTypes(uint64(m.DowntimeJailings))
+	}
+	if m.JailedValsetNonce != 0 {
+		n += 1 + sovTypes(uint64(m.JailedValsetNonce))
+	}
+	return n
+}
+
+func (m *RelayerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Batches != 0 {
+		n += 1 + sovTypes(uint64(m.Batches))
+	}
+	if m.Valsets != 0 {
+		n += 1 + sovTypes(uint64(m.Valsets))
+	}
+	if m.LogicCalls != 0 {
+		n += 1 + sovTypes(uint64(m.LogicCalls))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ValsetRewards) > 0 {
+		for _, e := range m.ValsetRewards {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LastRelayedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastRelayedHeight))
+	}
+	return n
+}
+
+func (m *TokenPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
+	if m.Mode != 0 {
+		n += 1 + sovTypes(uint64(m.Mode))
+	}
+	if len(m.TokenContracts) > 0 {
+		for _, s := range m.TokenContracts {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HeldDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.HeldHeight != 0 {
+		n += 1 + sovTypes(uint64(m.HeldHeight))
+	}
+	return n
+}
+
+func (m *TokenScaling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovTypes(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *DenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Valset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnlockOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PastEthSignatureCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PastEthSignatureCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PastEthSignatureCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoint = append(m.Checkpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checkpoint == nil {
+				m.Checkpoint = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CheckpointType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeSigningCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeSigningCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeSigningCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCounter", wireType)
+			}
+			m.MissedCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMissed", wireType)
+			}
+			m.TotalMissed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMissed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBitArray", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBitArray = append(m.MissedBitArray[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedBitArray == nil {
+				m.MissedBitArray = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBridgeSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBridgeSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBridgeSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Valsets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogicCalls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claims.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailings", wireType)
+			}
+			m.DowntimeJailings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedValsetNonce", wireType)
+			}
+			m.JailedValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			m.Batches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Batches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			m.Valsets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Valsets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			}
+			m.LogicCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogicCalls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, ERC20Token{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetRewards = append(m.ValsetRewards, ERC20Token{})
+			if err := m.ValsetRewards[len(m.ValsetRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRelayedHeight", wireType)
+			}
+			m.LastRelayedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRelayedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
-	}
-	l = m.RewardAmount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.RewardToken)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
-
-func (m *LastObservedEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CosmosBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
-	}
-	if m.EthereumBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
-	}
-	return n
-}
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+func (m *TokenPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -588,17 +3422,17 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			m.Power = 0
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -608,14 +3442,14 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				m.Mode |= TokenPolicyMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContracts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -643,7 +3477,7 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.TokenContracts = append(m.TokenContracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -669,7 +3503,7 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Valset) Unmarshal(dAtA []byte) error {
+func (m *HeldDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -692,17 +3526,17 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeldDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeldDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Nonce = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -712,16 +3546,35 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -731,31 +3584,29 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -765,14 +3616,29 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -800,13 +3666,11 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -834,8 +3698,27 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldHeight", wireType)
+			}
+			m.HeldHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeldHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -860,7 +3743,7 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *TokenScaling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -883,17 +3766,17 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenScaling: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenScaling: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			m.CosmosBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -903,16 +3786,29 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 			}
-			m.EthereumBlockHeight = 0
+			m.Exponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -922,7 +3818,7 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				m.Exponent |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -951,7 +3847,7 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -974,15 +3870,15 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1010,11 +3906,11 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1042,7 +3938,7 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
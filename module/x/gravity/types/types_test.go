@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
 	mrand "math/rand"
 	"testing"
 
@@ -115,6 +116,25 @@ func TestValsetPowerDiff(t *testing.T) {
 	}
 }
 
+func TestValsetPowerDiffExceeds(t *testing.T) {
+	start := BridgeValidators{
+		{Power: 1 << 31, EthereumAddress: "0x479FFc856Cdfa0f5D1AE6Fa61915b01351A7773D"},
+		{Power: 1 << 31, EthereumAddress: "0x6db48cBBCeD754bDc760720e38E456144e83269b"},
+	}
+	// a tenth of the power moves from one validator to the other, the diff counts both changes
+	moved := uint64(math.MaxUint32 / 20)
+	diff := BridgeValidators{
+		{Power: 1<<31 - moved, EthereumAddress: "0x479FFc856Cdfa0f5D1AE6Fa61915b01351A7773D"},
+		{Power: 1<<31 + moved, EthereumAddress: "0x6db48cBBCeD754bDc760720e38E456144e83269b"},
+	}
+	exact := sdk.NewDec(int64(2 * moved)).QuoInt64(math.MaxUint32)
+	assert.True(t, start.PowerDiffExceeds(diff, sdk.NewDecWithPrec(5, 2)))
+	assert.True(t, start.PowerDiffExceeds(diff, exact.Sub(sdk.SmallestDec())))
+	assert.False(t, start.PowerDiffExceeds(diff, exact.Add(sdk.SmallestDec())))
+	assert.False(t, start.PowerDiffExceeds(diff, sdk.NewDecWithPrec(1, 1)))
+	assert.False(t, start.PowerDiffExceeds(start, sdk.ZeroDec()))
+}
+
 func TestValsetSort(t *testing.T) {
 	specs := map[string]struct {
 		src BridgeValidators